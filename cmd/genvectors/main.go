@@ -0,0 +1,365 @@
+//go:build ignore
+
+// genvectors - 互通测试向量生成工具
+// 运行 (仓库根目录): go run cmd/genvectors/main.go [-tables sudoku/codec_tables.json] [-o sudoku/testdata/vectors.json]
+// 生成 JSON 测试向量: 每条向量给出 session 配置 (加密类型、layout、网格规格、padding 策略)、密钥、
+// codec 表种子、明文、AEAD 帧 (sealed) 与新 session 首次 sealAndMask 的输出 (masked)
+// 本模块的 vectors_test.go 与官方 Go 客户端的测试读取同一文件，逐字节锁定两端的兼容性
+//
+// 本工具不链接模块代码: codec 表取自 gen_data.go 导出的 codec_tables.json，
+// mask 算法 (LCG、padding 插入、hint 排列) 按 sudoku 包的 main.go / codec_grid9.go 另行实现，
+// AES-128-GCM 帧由标准库计算；ChaCha20-Poly1305 / XChaCha20-Poly1305 标准库未提供，
+// 由 runSelfTest 的 RFC 8439 向量覆盖 (见 selftest.go)
+
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// 与模块常量一致 (sudoku 包的 crypto.go / main.go / codec_alphabet.go / codec_grid9.go)
+const (
+	cipherNone      = 0
+	cipherAES128GCM = 1
+	cipherAES256GCM = 4
+
+	layoutASCII       = 0
+	layoutBase64URL   = 2
+	layoutHeaderToken = 3
+
+	gridProfile4x4 = 0
+	gridProfile9x9 = 1
+
+	defaultPadThreshold = 19661
+	grid9HintHi         = 0xE0
+	grid9HintLo         = 0x80
+)
+
+var perm4 = [24][4]uint8{
+	{0, 1, 2, 3}, {0, 1, 3, 2}, {0, 2, 1, 3}, {0, 2, 3, 1},
+	{0, 3, 1, 2}, {0, 3, 2, 1}, {1, 0, 2, 3}, {1, 0, 3, 2},
+	{1, 2, 0, 3}, {1, 2, 3, 0}, {1, 3, 0, 2}, {1, 3, 2, 0},
+	{2, 0, 1, 3}, {2, 0, 3, 1}, {2, 1, 0, 3}, {2, 1, 3, 0},
+	{2, 3, 0, 1}, {2, 3, 1, 0}, {3, 0, 1, 2}, {3, 0, 2, 1},
+	{3, 1, 0, 2}, {3, 1, 2, 0}, {3, 2, 0, 1}, {3, 2, 1, 0},
+}
+
+// ============================================================================
+// codec 表 (codec_tables.json，格式见 gen_data.go 的 tableDump)
+// ============================================================================
+
+type tableDump struct {
+	Grid4 struct {
+		Seed   string     `json:"seed"`
+		Encode [][]string `json:"encode"`
+	} `json:"grid4"`
+	Grid9 struct {
+		Encode [][]string `json:"encode"`
+	} `json:"grid9"`
+}
+
+var (
+	tableSeed uint64
+	encode4   [256][][4]uint8
+	encode9   [256][][2]uint16
+)
+
+func loadTables(path string) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+	var d tableDump
+	if err := json.Unmarshal(raw, &d); err != nil {
+		panic(err)
+	}
+	tableSeed, err = strconv.ParseUint(d.Grid4.Seed, 0, 64)
+	if err != nil {
+		panic(err)
+	}
+	if len(d.Grid4.Encode) != 256 || len(d.Grid9.Encode) != 256 {
+		panic("codec tables: expected 256 entries per profile")
+	}
+	for b := 0; b < 256; b++ {
+		for _, s := range d.Grid4.Encode[b] {
+			var h [4]uint8
+			mustDecode(h[:], s)
+			encode4[b] = append(encode4[b], h)
+		}
+		for _, s := range d.Grid9.Encode[b] {
+			var p [4]uint8
+			mustDecode(p[:], s)
+			encode9[b] = append(encode9[b], [2]uint16{binary.BigEndian.Uint16(p[0:2]), binary.BigEndian.Uint16(p[2:4])})
+		}
+	}
+}
+
+func mustDecode(dst []byte, s string) {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != len(dst) {
+		panic("codec tables: bad hint group " + s)
+	}
+	copy(dst, b)
+}
+
+// ============================================================================
+// mask (与 maskBody / maskGroup4 / maskGroup9 / maskTail 一致)
+// ============================================================================
+
+type alphabet struct {
+	hints  [64]uint8
+	marker uint8
+}
+
+func newAlphabet(layout uint8) alphabet {
+	var a alphabet
+	switch layout {
+	case layoutBase64URL:
+		copy(a.hints[:], "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_")
+		a.marker = '.'
+	case layoutHeaderToken:
+		copy(a.hints[:], "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789!#")
+		a.marker = '$'
+	default:
+		for i := range a.hints {
+			a.hints[i] = 0x40 | uint8(i)
+		}
+		a.marker = 0x3F
+	}
+	return a
+}
+
+type masker struct {
+	rng       uint32
+	threshold uint32 // padding 阈值 << 16
+	pool      []uint8
+	alpha     alphabet
+	profile9  bool
+	out       []byte
+}
+
+func lcgNext(x uint32) uint32 {
+	return x*1664525 + 1013904223
+}
+
+func (m *masker) padding() {
+	if m.rng < m.threshold && len(m.pool) > 0 {
+		m.rng = lcgNext(m.rng)
+		m.out = append(m.out, m.pool[m.rng%uint32(len(m.pool))])
+	}
+}
+
+// innerPadding - hint 组内部不输出 padding 标记，顺延到池中下一个非标记字节
+func (m *masker) innerPadding() {
+	if m.rng < m.threshold && len(m.pool) > 0 {
+		m.rng = lcgNext(m.rng)
+		idx := m.rng % uint32(len(m.pool))
+		for k := 0; k < len(m.pool); k++ {
+			if m.pool[idx] != m.alpha.marker {
+				m.out = append(m.out, m.pool[idx])
+				return
+			}
+			idx = (idx + 1) % uint32(len(m.pool))
+		}
+	}
+}
+
+func (m *masker) group4(b uint8) {
+	m.padding()
+	m.rng = lcgNext(m.rng)
+	groups := encode4[b]
+	if len(groups) == 0 {
+		m.out = append(m.out, b)
+		return
+	}
+	hints := groups[m.rng%uint32(len(groups))]
+	m.rng = lcgNext(m.rng)
+	perm := perm4[m.rng%24]
+	m.rng = lcgNext(m.rng)
+	for j := 0; j < 4; j++ {
+		m.innerPadding()
+		m.rng = lcgNext(m.rng)
+		m.out = append(m.out, m.alpha.hints[hints[perm[j]]&0x3F])
+	}
+}
+
+func (m *masker) group9(b uint8) {
+	m.padding()
+	m.rng = lcgNext(m.rng)
+	groups := encode9[b]
+	syms := groups[m.rng%uint32(len(groups))]
+	m.rng = lcgNext(m.rng)
+	order := m.rng & 1
+	m.rng = lcgNext(m.rng)
+	for j := uint32(0); j < 2; j++ {
+		sym := syms[j^order]
+		m.innerPadding()
+		m.rng = lcgNext(m.rng)
+		m.out = append(m.out, grid9HintHi|uint8(sym>>6), grid9HintLo|uint8(sym&0x3F))
+	}
+}
+
+// mask - 一次 mask 调用 (含流末尾 padding)
+func (m *masker) mask(data []byte) []byte {
+	m.out = m.out[:0]
+	for _, b := range data {
+		if m.profile9 {
+			m.group9(b)
+		} else {
+			m.group4(b)
+		}
+	}
+	m.padding()
+	return append([]byte(nil), m.out...)
+}
+
+// ============================================================================
+// 向量
+// ============================================================================
+
+type vectorSpec struct {
+	name       string
+	cipherType uint8
+	layout     uint8
+	profile    uint8
+	key        []byte
+	padPercent uint32 // padPool 为空时不调用 setPaddingPolicy (默认池为空，不插入 padding)
+	padPool    []byte
+	plaintext  []byte
+}
+
+type vector struct {
+	Name        string `json:"name"`
+	CipherType  uint8  `json:"cipherType"`
+	LayoutType  uint8  `json:"layoutType"`
+	GridProfile uint8  `json:"gridProfile"`
+	Key         string `json:"key"`
+	Seed        string `json:"seed"`
+	PadPercent  uint32 `json:"padPercent"`
+	PadPool     string `json:"padPool"`
+	Plaintext   string `json:"plaintext"`
+	Sealed      string `json:"sealed"`
+	Masked      string `json:"masked"`
+}
+
+type vectorFile struct {
+	Comment string   `json:"comment"`
+	Vectors []vector `json:"vectors"`
+}
+
+// sealFrame - 新 session 的第一个 AEAD 帧: [nonce][密文][tag]，nonce = salt (全 0) || counter (大端序，从 1 开始)
+func sealFrame(s vectorSpec) []byte {
+	if s.cipherType == cipherNone {
+		return append([]byte(nil), s.plaintext...)
+	}
+	key := s.key[:16]
+	if s.cipherType == cipherAES256GCM {
+		key = s.key[:32]
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce[4:], 1)
+	return gcm.Seal(nonce, nonce, s.plaintext, nil)
+}
+
+func buildVector(s vectorSpec) vector {
+	m := masker{
+		threshold: uint32(defaultPadThreshold) << 16,
+		alpha:     newAlphabet(s.layout),
+		profile9:  s.profile == gridProfile9x9,
+	}
+	if len(s.padPool) > 0 {
+		t := s.padPercent * 65536 / 100
+		if t > 0xFFFF {
+			t = 0xFFFF
+		}
+		m.threshold = t << 16
+		m.pool = s.padPool
+	}
+	sealed := sealFrame(s)
+	return vector{
+		Name:        s.name,
+		CipherType:  s.cipherType,
+		LayoutType:  s.layout,
+		GridProfile: s.profile,
+		Key:         hex.EncodeToString(s.key),
+		Seed:        fmt.Sprintf("0x%X", tableSeed),
+		PadPercent:  s.padPercent,
+		PadPool:     hex.EncodeToString(s.padPool),
+		Plaintext:   hex.EncodeToString(s.plaintext),
+		Sealed:      hex.EncodeToString(sealed),
+		Masked:      hex.EncodeToString(m.mask(sealed)),
+	}
+}
+
+func pattern(n int, mul, add byte) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i)*mul + add
+	}
+	return b
+}
+
+func specs() []vectorSpec {
+	key32 := pattern(32, 1, 0)
+	key16 := pattern(16, 7, 3)
+	all := pattern(256, 1, 0)
+	text := []byte("GET / HTTP/1.1\r\nHost: example.com\r\n\r\n")
+	return []vectorSpec{
+		{name: "none-ascii-4x4", cipherType: cipherNone, layout: layoutASCII, profile: gridProfile4x4, key: key32, plaintext: []byte("hello, sudoku")},
+		{name: "none-ascii-4x4-allbytes", cipherType: cipherNone, layout: layoutASCII, profile: gridProfile4x4, key: key32, plaintext: all},
+		{name: "none-base64url-4x4", cipherType: cipherNone, layout: layoutBase64URL, profile: gridProfile4x4, key: key32, plaintext: text},
+		{name: "none-headertoken-4x4", cipherType: cipherNone, layout: layoutHeaderToken, profile: gridProfile4x4, key: key32, plaintext: text},
+		{name: "none-ascii-9x9-allbytes", cipherType: cipherNone, layout: layoutASCII, profile: gridProfile9x9, key: key32, plaintext: all},
+		{name: "none-ascii-4x4-padding", cipherType: cipherNone, layout: layoutASCII, profile: gridProfile4x4, key: key32,
+			padPercent: 40, padPool: []byte{0x3F, 0x20, 0x21, 0x2A, 0x0A}, plaintext: text},
+		{name: "none-base64url-4x4-padding", cipherType: cipherNone, layout: layoutBase64URL, profile: gridProfile4x4, key: key32,
+			padPercent: 50, padPool: []byte(".~"), plaintext: text},
+		{name: "aes128gcm-ascii-4x4", cipherType: cipherAES128GCM, layout: layoutASCII, profile: gridProfile4x4, key: key16, plaintext: pattern(64, 37, 11)},
+		{name: "aes128gcm-headertoken-4x4-padding", cipherType: cipherAES128GCM, layout: layoutHeaderToken, profile: gridProfile4x4, key: key16,
+			padPercent: 25, padPool: []byte("$%&*+"), plaintext: text},
+		{name: "aes128gcm-ascii-9x9-padding", cipherType: cipherAES128GCM, layout: layoutASCII, profile: gridProfile9x9, key: key16,
+			padPercent: 30, padPool: []byte{0x3F, 0x20, 0x23}, plaintext: pattern(48, 13, 200)},
+		{name: "aes256gcm-base64url-4x4-padding", cipherType: cipherAES256GCM, layout: layoutBase64URL, profile: gridProfile4x4, key: key32,
+			padPercent: 35, padPool: []byte(".~"), plaintext: text},
+	}
+}
+
+func main() {
+	tablesPath := flag.String("tables", "sudoku/codec_tables.json", "codec tables JSON (gen_data.go 输出)")
+	outPath := flag.String("o", "sudoku/testdata/vectors.json", "output path")
+	flag.Parse()
+
+	loadTables(*tablesPath)
+
+	f := vectorFile{
+		Comment: "Generated by cmd/genvectors; DO NOT EDIT. masked = first sealAndMask output of a fresh RoleShared session",
+	}
+	for _, s := range specs() {
+		f.Vectors = append(f.Vectors, buildVector(s))
+	}
+
+	data, err := json.MarshalIndent(&f, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	if err := os.WriteFile(*outPath, append(data, '\n'), 0o644); err != nil {
+		panic(err)
+	}
+	fmt.Printf("[GEN] Generated %d vectors (seed 0x%X) -> %s\n", len(f.Vectors), tableSeed, *outPath)
+}
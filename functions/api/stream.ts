@@ -68,7 +68,16 @@ async function getWasmInstance(): Promise<WebAssembly.Instance> {
       env: {
         abort: (msg: number, file: number, line: number, col: number) => {
           throw new Error('Wasm abort');
-        }
+        },
+        logEvent: (level: number, codePtr: number, codeLen: number) => {
+          if (!wasmMemoryCache) return;
+          const code = new TextDecoder().decode(new Uint8Array(wasmMemoryCache.buffer, codePtr, codeLen));
+          console.log(`[WASM:${level}] ${code}`);
+        },
+        getRandom: (ptr: number, len: number) => {
+          if (!wasmMemoryCache) throw new Error('getRandom called before memory is available');
+          crypto.getRandomValues(new Uint8Array(wasmMemoryCache.buffer, ptr, len));
+        },
       }
     });
 
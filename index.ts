@@ -23,26 +23,137 @@ interface SudokuWasmExports {
   memory: WebAssembly.Memory;
   arenaMalloc: (size: number) => number;
   arenaFree: (ptr: number) => void;
-  initSession: (keyPtr: number, keyLen: number, cipherType: number, layoutType: number) => number;
+  initSession: (keyPtr: number, keyLen: number, cipherType: number, layoutType: number, gridProfile?: number, role?: number) => number;
+  initSessions: (batchPtr: number, count: number) => number;
+  initSessionPSK: (pskPtr: number, pskLen: number, identityPtr: number, identityLen: number, cipherType: number, layoutType: number, gridProfile: number, role: number) => number;
+  setPSKHintKey: (keyPtr: number, keyLen: number) => number;
+  pskIdentityHint: (identityPtr: number, identityLen: number) => number;
+  helloIdentityHint: (inPtr: number, inLen: number) => number;
+  setIdentityQuota: (maxPerIdentity: number) => void;
+  registerIdentity: (hashPtr: number) => number;
+  buildHello: (id: number, outPtr: number) => number;
+  parseHello: (id: number, inPtr: number, inLen: number) => number;
+  setUnixTime: (now: number) => void;
+  setHelloTimeWindow: (seconds: number) => void;
+  handshakeStart: (id: number, privPtr: number, outPtr: number) => number;
+  handshakeStartRandom: (id: number, outPtr: number) => number;
+  handshakeConsume: (id: number, inPtr: number, inLen: number) => number;
+  handshakeIsDone: (id: number) => number;
+  sealEarlyData: (id: number, inPtr: number, inLen: number, outPtr: number) => number;
+  openEarlyData: (id: number, inPtr: number, inLen: number, outPtr: number) => number;
+  setExportKey: (keyPtr: number, keyLen: number) => number;
+  exportSession: (id: number, outPtr: number) => number;
+  importSession: (blobPtr: number, blobLen: number, saltOutPtr: number) => number;
+  applyMigrationSalt: (id: number, saltPtr: number, saltLen: number) => number;
+  issueResumptionToken: (id: number, outPtr: number) => number;
+  resumeSession: (tokenPtr: number, tokenLen: number, keyPtr: number, keyLen: number, saltOutPtr: number) => number;
+  snapshotSession: (id: number) => number;
+  rollbackSession: (id: number) => number;
+  tick: (now: number) => void;
+  getMemoryLayout: () => number;
+  getMemoryStats: (outPtr: number) => number;
+  getCapabilities: () => number;
+  getBuildInfo: () => number;
+  setConstantTimeDecode: (id: number, enable: number) => number;
+  setStrictDecode: (id: number, enable: number) => number;
+  getDecodeErrorOffset: (id: number) => number;
+  setForbiddenBytes: (id: number, bitmapPtr: number) => number;
+  setCoverProfile: (id: number, profileId: number) => number;
+  setCoverTemplate: (ptr: number, length: number) => number;
+  setShapingPolicy: (id: number, targetSize: number, jitter: number, maxDelayMs: number) => number;
+  setMaxFrameSize: (id: number, n: number) => number;
+  buildKeepalive: (id: number) => number;
+  buildCloseNotify: (id: number, reasonCode: number) => number;
+  getCloseReason: (id: number) => number;
+  buildControlMessage: (id: number, msgPtr: number, msgLen: number) => number;
+  getControlMessage: (id: number, outPtr: number, outCap: number) => number;
+  getControlDropped: () => number;
+  openStream: (id: number, streamId: number) => number;
+  streamSend: (id: number, streamId: number, inPtr: number, inLen: number) => number;
+  streamRecv: (id: number, inPtr: number, inLen: number) => number;
+  closeStream: (id: number, streamId: number) => number;
+  streamCredit: (id: number, streamId: number, n: number) => number;
+  getSendWindow: (id: number, streamId: number) => number;
+  setFECPolicy: (id: number, k: number, r: number) => number;
+  flushFEC: (id: number) => number;
+  getFECStats: (id: number, outPtr: number) => number;
+  setDatagramMode: (id: number, window: number) => number;
+  buildCoverFrame: (id: number, approxLen: number) => number;
+  analyzeOutput: (ptr: number, n: number, outPtr: number) => number;
+  randomizeNonceSalt: (id: number, outPtr: number) => number;
+  shufflePaddingPool: (id: number) => number;
+  setRateLimit: (id: number, bytesPerTick: number, burst: number) => number;
+  getRateLimitStatus: (id: number, outPtr: number) => number;
+  setSessionOption: (id: number, optionId: number, value: number) => number;
+  getSessionOption: (id: number, optionId: number) => number;
+  getTrace: (id: number, outPtr: number, maxLen: number) => number;
+  getLastRNGDraws: (id: number) => number;
+  setRNGDrawLog: (id: number, logPtr: number, logCap: number) => number;
+  reapIdleSessions: (maxIdleTicks: number) => number;
   closeSession: (id: number) => void;
+  scrubBuffers: () => void;
+  checkArenaIntegrity: () => number;
+  runSelfTest: () => number;
+  selfRoundTrip: (id: number, n: number) => number;
+  maskBench: (layoutType: number, gridProfile: number, size: number, iterations: number) => bigint;
+  aeadBench: (cipherType: number, size: number, iterations: number) => bigint;
   mask: (id: number, inPtr: number, inLen: number) => number;
+  maskBatch: (id: number, descPtr: number, count: number) => number;
+  maskInPlace: (id: number, bufPtr: number, bufCap: number, inLen: number) => number;
+  maskTo: (id: number, inPtr: number, inLen: number, outPtr: number, outCap: number) => number;
+  unmaskTo: (id: number, inPtr: number, inLen: number, outPtr: number, outCap: number) => number;
+  setOutBufSlots: (id: number, ptr: number, capacity: number) => number;
+  acquireOutBuf: (id: number) => number;
+  releaseOutBuf: (id: number, ptr: number) => number;
+  estimateMaskedSize: (id: number, inLen: number) => number;
   unmask: (id: number, inPtr: number, inLen: number) => number;
+  pipeTransform: (srcId: number, dstId: number, inPtr: number, inLen: number) => number;
   getOutLen: () => number;
-  aeadEncrypt: (id: number, plaintextPtr: number, plaintextLen: number, outPtr: number) => number;
-  aeadDecrypt: (id: number, ciphertextPtr: number, ciphertextLen: number, outPtr: number) => number;
-  initCodecTablesWithKey: (keyPtr: number, keyLen: number) => void;
+  getLastError: () => number;
+  setLogLevel: (level: number) => number;
+  maskV2: (id: number, inPtr: number, inLen: number) => bigint;
+  unmaskV2: (id: number, inPtr: number, inLen: number) => bigint;
+  aeadEncrypt: (id: number, plaintextPtr: number, plaintextLen: number, outPtr: number, adPtr: number, adLen: number) => number;
+  aeadDecrypt: (id: number, ciphertextPtr: number, ciphertextLen: number, outPtr: number, adPtr: number, adLen: number) => number;
+  aeadEncryptOut: (id: number, plaintextPtr: number, plaintextLen: number, adPtr: number, adLen: number) => number;
+  aeadDecryptOut: (id: number, ciphertextPtr: number, ciphertextLen: number, adPtr: number, adLen: number) => number;
+  aeadEncryptWithNonce: (id: number, noncePtr: number, plaintextPtr: number, plaintextLen: number, outPtr: number, adPtr: number, adLen: number) => number;
+  aeadDecryptWithNonce: (id: number, noncePtr: number, ciphertextPtr: number, ciphertextLen: number, outPtr: number, adPtr: number, adLen: number) => number;
+  poly1305Sum: (keyPtr: number, msgPtr: number, msgLen: number, outPtr: number) => number;
+  poly1305Verify: (keyPtr: number, msgPtr: number, msgLen: number, tagPtr: number) => number;
+  chachaXor: (keyPtr: number, noncePtr: number, counter: number, inPtr: number, n: number) => number;
+  initCodecTablesWithKey: (keyPtr: number, keyLen: number) => number;
+  warmCodecTables: () => number;
+  getCodecFingerprint: (id: number, outPtr: number) => number;
 }
 
-const CipherType = { None: 0, AES128GCM: 1, ChaCha20Poly: 2 };
-const LayoutType = { ASCII: 0, Entropy: 1 };
+const CipherType = { None: 0, AES128GCM: 1, ChaCha20Poly: 2, XChaCha20Poly: 3, AES256GCM: 4 };
+const LayoutType = { ASCII: 0, Entropy: 1, Base64URL: 2, HeaderToken: 3, Keyed: 0x80 };
+const GridProfile = { Grid4x4: 0, Grid9x9: 1 };
+const SessionRole = { Shared: 0, Client: 1, Server: 2 };
 
 class WasmInstance {
   private exports: SudokuWasmExports;
   private initialized: boolean = false;
 
   constructor(wasmModule: WebAssembly.Module) {
-    const instance = new WebAssembly.Instance(wasmModule, { env: { abort: () => { throw new Error('Wasm abort'); } } });
+    let memory: WebAssembly.Memory | null = null;
+    const instance = new WebAssembly.Instance(wasmModule, {
+      env: {
+        abort: () => { throw new Error('Wasm abort'); },
+        logEvent: (level: number, codePtr: number, codeLen: number) => {
+          if (!memory) return;
+          const code = new TextDecoder().decode(new Uint8Array(memory.buffer, codePtr, codeLen));
+          console.log(`[WASM:${level}] ${code}`);
+        },
+        getRandom: (ptr: number, len: number) => {
+          if (!memory) throw new Error('getRandom called before memory is available');
+          crypto.getRandomValues(new Uint8Array(memory.buffer, ptr, len));
+        },
+      },
+    });
     this.exports = instance.exports as unknown as SudokuWasmExports;
+    memory = this.exports.memory;
   }
 
   // Public accessor for exports
@@ -63,9 +174,12 @@ class WasmInstance {
 
   initCodecTables(key: Uint8Array): void {
     if (this.initialized) return;
-    const [keyPtr, needFree] = this.writeToMemory(key.slice(0, 32));
+    const keyData = key.slice(0, 32);
+    const [keyPtr, needFree] = this.writeToMemory(keyData);
     try {
-      this.exports.initCodecTablesWithKey(keyPtr, key.length);
+      if (this.exports.initCodecTablesWithKey(keyPtr, keyData.length) !== 0) {
+        throw new Error('initCodecTablesWithKey failed');
+      }
       this.initialized = true;
     } finally { if (needFree) this.exports.arenaFree(keyPtr); }
   }
@@ -107,7 +221,7 @@ class WasmInstance {
     const outPtr = this.exports.arenaMalloc(plaintext.length + 16);
     if (outPtr === 0) { this.exports.arenaFree(inPtr); throw new Error('Failed to allocate output buffer'); }
     try {
-      const resultLen = this.exports.aeadEncrypt(sessionId, inPtr, plaintext.length, outPtr);
+      const resultLen = this.exports.aeadEncrypt(sessionId, inPtr, plaintext.length, outPtr, 0, 0);
       if (resultLen === 0) throw new Error('AEAD encryption failed');
       return this.readFromMemory(outPtr, resultLen);
     } finally { this.exports.arenaFree(inPtr); this.exports.arenaFree(outPtr); }
@@ -118,7 +232,7 @@ class WasmInstance {
     const outPtr = this.exports.arenaMalloc(ciphertext.length);
     if (outPtr === 0) { this.exports.arenaFree(inPtr); throw new Error('Failed to allocate output buffer'); }
     try {
-      const resultLen = this.exports.aeadDecrypt(sessionId, inPtr, ciphertext.length, outPtr);
+      const resultLen = this.exports.aeadDecrypt(sessionId, inPtr, ciphertext.length, outPtr, 0, 0);
       if (resultLen === 0) throw new Error('AEAD decryption failed');
       return this.readFromMemory(outPtr, resultLen);
     } finally { this.exports.arenaFree(inPtr); this.exports.arenaFree(outPtr); }
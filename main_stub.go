@@ -0,0 +1,9 @@
+//go:build !(tinygo && wasip1)
+
+// 模块入口 - codec/AEAD 与全部导出函数位于 sudoku 包 (见 sudoku/main.go)，//export 随包链接进 .wasm
+package main
+
+import _ "sudoku-wasm/sudoku"
+
+// main - 浏览器 Wasm 与宿主构建不需要 main 函数，但 TinyGo 需要 (wasip1 命令行见 main_wasip1.go)
+func main() {}
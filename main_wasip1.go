@@ -0,0 +1,10 @@
+//go:build tinygo && wasip1
+
+package main
+
+import "sudoku-wasm/sudoku"
+
+// main - wasip1 命令行 (见 sudoku/cli_wasip1.go)
+func main() {
+	sudoku.RunCLI()
+}
@@ -47,7 +47,16 @@ export async function getWasmInstance(): Promise<WebAssembly.Instance> {
         },
       },
       env: {
-        abort: () => { throw new Error('Wasm abort'); }
+        abort: () => { throw new Error('Wasm abort'); },
+        logEvent: (level: number, codePtr: number, codeLen: number) => {
+          if (!wasmMemoryCache) return;
+          const code = new TextDecoder().decode(new Uint8Array(wasmMemoryCache.buffer, codePtr, codeLen));
+          console.log(`[WASM:${level}] ${code}`);
+        },
+        getRandom: (ptr: number, len: number) => {
+          if (!wasmMemoryCache) throw new Error('getRandom called before memory is available');
+          crypto.getRandomValues(new Uint8Array(wasmMemoryCache.buffer, ptr, len));
+        },
       }
     });
 
@@ -0,0 +1,117 @@
+// 输出统计自检 - 宿主或集成测试在发送前检查 mask 输出的统计特征是否与所选 layout 相符
+// (如 ASCII layout 应全部可打印、字节分布应集中在 hint 字母表与 padding 池上)
+// 只做整数运算: 卡方与熵以定点数输出
+
+package sudoku
+
+import "encoding/binary"
+
+// analyzeOutput 输出 (小端序 uint32):
+//
+//	[0]  输入长度
+//	[1]  出现过的不同字节值个数
+//	[2]  可打印字节数 (0x20-0x7E 及 \t \n \r)
+//	[3]  可打印比例 (万分比)
+//	[4]  相对 256 个字节值均匀分布的卡方统计量 ×100 (超出 uint32 时饱和)
+//	[5]  相对出现过的字节值上均匀分布的卡方统计量 ×100 (衡量字母表内是否均衡)
+//	[6]  香农熵 (毫比特/字节，0-8000)
+//	[7:263] 各字节值出现次数
+const (
+	analyzeHeaderFields = 7
+	analyzeStatsSize    = (analyzeHeaderFields + 256) * 4
+)
+
+// analyzeOutput - 统计 arena[ptr:ptr+n] 的字节分布，结果写入 arena[outPtr:outPtr+1052]
+// 返回值: 1052, -1 输入越界, -2 输出越界, -3 输入长度为 0
+//
+//export analyzeOutput
+func analyzeOutput(ptr uint32, n uint32, outPtr uint32) int32 {
+	if !inArenaRange(ptr, n) {
+		return -1
+	}
+	if !inArenaRange(outPtr, analyzeStatsSize) {
+		return -2
+	}
+	if n == 0 {
+		return -3
+	}
+
+	var hist [256]uint32
+	for i := uint32(0); i < n; i++ {
+		hist[arena[ptr+i]]++
+	}
+	distinct := uint32(0)
+	printable := uint32(0)
+	sumSq := uint64(0)
+	sumCLog := uint64(0) // Σ c·log2(c)，16.16 定点
+	for b, c := range hist {
+		if c == 0 {
+			continue
+		}
+		distinct++
+		if (b >= 0x20 && b <= 0x7E) || b == '\t' || b == '\n' || b == '\r' {
+			printable += c
+		}
+		sumSq += uint64(c) * uint64(c)
+		sumCLog += uint64(c) * uint64(log2Fixed(uint64(c)))
+	}
+
+	// H = log2(n) - Σc·log2(c)/n (截断误差可能使结果略小于 0)
+	entropy := uint64(log2Fixed(uint64(n)))
+	if d := sumCLog / uint64(n); d < entropy {
+		entropy -= d
+	} else {
+		entropy = 0
+	}
+
+	fields := [analyzeHeaderFields]uint32{
+		n, distinct, printable,
+		uint32(uint64(printable) * 10000 / uint64(n)),
+		chiSquare100(256, sumSq, n), chiSquare100(distinct, sumSq, n),
+		uint32(entropy * 1000 >> 16),
+	}
+	for i, v := range fields {
+		binary.LittleEndian.PutUint32(arena[outPtr+uint32(i)*4:outPtr+uint32(i)*4+4], v)
+	}
+	h := outPtr + analyzeHeaderFields*4
+	for i, c := range hist {
+		binary.LittleEndian.PutUint32(arena[h+uint32(i)*4:h+uint32(i)*4+4], c)
+	}
+	return analyzeStatsSize
+}
+
+// chiSquare100 - n 个样本落在 bins 个等概率桶中的卡方统计量 ×100 (超出 uint32 时饱和)
+// Σ(c-e)²/e = bins·Σc²/n - n (e = n/bins)；bins·Σc² >= n² 恒成立，不会下溢
+func chiSquare100(bins uint32, sumSq uint64, n uint32) uint32 {
+	t := uint64(bins) * sumSq
+	q, r := t/uint64(n), t%uint64(n)
+	v := 100*q + 100*r/uint64(n) - 100*uint64(n)
+	if v > 0xFFFFFFFF {
+		return 0xFFFFFFFF
+	}
+	return uint32(v)
+}
+
+// log2Fixed - log2(x) 的 16.16 定点近似 (x >= 1)，小数部分逐位平方求得
+func log2Fixed(x uint64) uint32 {
+	ip := uint32(0)
+	for x>>(ip+1) != 0 {
+		ip++
+	}
+	// m 为 x/2^ip 的 1.31 定点表示，落在 [1, 2)
+	var m uint64
+	if ip >= 31 {
+		m = x >> (ip - 31)
+	} else {
+		m = x << (31 - ip)
+	}
+	frac := uint32(0)
+	for bit := uint32(1) << 15; bit != 0; bit >>= 1 {
+		m = m * m >> 31
+		if m >= 1<<32 {
+			m >>= 1
+			frac |= bit
+		}
+	}
+	return ip<<16 | frac
+}
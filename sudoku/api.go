@@ -0,0 +1,218 @@
+//go:build !tinygo
+
+// Go 接口 - 供标准 Go 程序 (如服务端代理) 直接导入与 Wasm 构建完全相同的 codec/AEAD 实现
+// 以切片收发数据: 输入拷贝进 arena 的 workBuf，调用与 Wasm 导出相同的函数，输出从输出区拷贝出来，
+// 因此线路格式、RNG 序列与错误码与浏览器/Worker 中运行的模块逐字节一致
+// 状态全部位于包级 arena 中 (容量与 session 数见 layout_*.go)，各调用以包级互斥锁串行执行
+// TinyGo 构建不包含本文件
+
+package sudoku
+
+import (
+	"strconv"
+	"sync"
+)
+
+// Error - 调用失败时的错误码 (值见 errors.go)
+type Error int32
+
+func (e Error) Error() string {
+	return "sudoku: error " + strconv.Itoa(int(e))
+}
+
+var apiMu sync.Mutex
+
+// apiMaxInput - 单次调用的输入上限: workBuf 前半段 (后半段为 AEAD 暂存区)
+const apiMaxInput = workBufSize / 2
+
+// Session - 一个 session (状态位于包级 arena)
+type Session struct {
+	id int32
+}
+
+// NewSession - 以 key 创建 RoleShared session (cipherType/layoutType/gridProfile 取值同 initSession)
+// RoleShared 两端的 nonce 序列相同，只适用于单向数据流 (见 initSession)
+func NewSession(key []byte, cipherType uint8, layoutType uint8, gridProfile uint8) (*Session, error) {
+	return NewSessionWithRole(key, cipherType, layoutType, gridProfile, RoleShared)
+}
+
+// NewSessionWithRole - 同 NewSession，role 为 RoleClient/RoleServer 时两个方向使用不同的密钥与 nonce
+func NewSessionWithRole(key []byte, cipherType uint8, layoutType uint8, gridProfile uint8, role uint8) (*Session, error) {
+	apiMu.Lock()
+	defer apiMu.Unlock()
+	if !wasmInitialized {
+		initWasm()
+	}
+	id := initSessionKey(key, cipherType, layoutType, gridProfile, role, nil)
+	if id < 0 {
+		return nil, initError(id)
+	}
+	return &Session{id: id}, nil
+}
+
+// initError - 将 initSessionKey 的局部返回值映射为 errors.go 的错误码
+func initError(r int32) Error {
+	switch r {
+	case -1, -6:
+		return Error(errNoSlot)
+	case -2, -4, -5:
+		return Error(errBadKey)
+	case -3, -8, -9:
+		return Error(errBadConfig)
+	case -7:
+		return Error(errOutOfBounds)
+	}
+	return Error(r) // errQuota
+}
+
+// Close - 释放 session，之后不可再使用
+func (s *Session) Close() {
+	apiMu.Lock()
+	defer apiMu.Unlock()
+	closeSession(s.id)
+}
+
+// SetPaddingPolicy - 同 setPaddingPolicy: padding 比例 (0-100) 与 padding 字节池 (为空时保留当前池)
+// 失败时返回 errBadSession 或 errBadPadding
+func (s *Session) SetPaddingPolicy(percent uint32, pool []byte) error {
+	apiMu.Lock()
+	defer apiMu.Unlock()
+	if len(pool) > maxPadPoolSize {
+		return Error(errBadPadding)
+	}
+	copy(arena[workBufBase:], pool)
+	switch setPaddingPolicy(s.id, percent, workBufBase, uint32(len(pool))) {
+	case 0:
+		return nil
+	case -1:
+		return Error(errBadSession)
+	}
+	return Error(errBadPadding)
+}
+
+// SetOption - 同 setSessionOption (optionId 取值见 options.go 的 SessionOpt*)
+// 失败时返回 errBadSession、errBadConfig (未知选项或取值非法) 或 errNoSlot (trace 槽位已用完)
+func (s *Session) SetOption(optionId uint32, value uint32) error {
+	apiMu.Lock()
+	defer apiMu.Unlock()
+	switch setSessionOption(s.id, optionId, value) {
+	case 0:
+		return nil
+	case -1:
+		return Error(errBadSession)
+	case -4:
+		return Error(errNoSlot)
+	}
+	return Error(errBadConfig)
+}
+
+// Mask - 将 in 的 mask 输出追加到 dst；超过 maskMaxChunk 的输入分多次调用 mask，输出与单次调用的拼接一致
+func (s *Session) Mask(dst []byte, in []byte) ([]byte, error) {
+	apiMu.Lock()
+	defer apiMu.Unlock()
+	for len(in) > 0 {
+		n := len(in)
+		if n > maskMaxChunk {
+			n = maskMaxChunk
+		}
+		var err error
+		if dst, err = s.call(dst, in[:n], mask); err != nil {
+			return dst, err
+		}
+		in = in[n:]
+	}
+	return dst, nil
+}
+
+// Unmask - 将 in 的解码输出追加到 dst；未凑满的 hint 组跨调用保留
+func (s *Session) Unmask(dst []byte, in []byte) ([]byte, error) {
+	apiMu.Lock()
+	defer apiMu.Unlock()
+	for len(in) > 0 {
+		n := len(in)
+		if n > apiMaxInput {
+			n = apiMaxInput
+		}
+		var err error
+		if dst, err = s.call(dst, in[:n], unmask); err != nil {
+			return dst, err
+		}
+		in = in[n:]
+	}
+	return dst, nil
+}
+
+// SealAndMask - 加密 plain 为一帧并 mask，输出追加到 dst
+func (s *Session) SealAndMask(dst []byte, plain []byte) ([]byte, error) {
+	apiMu.Lock()
+	defer apiMu.Unlock()
+	return s.call(dst, plain, sealAndMask)
+}
+
+// UnmaskAndOpen - 解码并解密一个完整的 SealAndMask 帧，明文追加到 dst
+func (s *Session) UnmaskAndOpen(dst []byte, in []byte) ([]byte, error) {
+	apiMu.Lock()
+	defer apiMu.Unlock()
+	return s.call(dst, in, unmaskAndOpen)
+}
+
+// MaskFrame - 同 maskFrame: 将 plain 封装为记录并 mask，输出追加到 dst
+func (s *Session) MaskFrame(dst []byte, plain []byte) ([]byte, error) {
+	apiMu.Lock()
+	defer apiMu.Unlock()
+	return s.call(dst, plain, maskFrame)
+}
+
+// UnmaskFrame - 同 unmaskFrame: 解开 in 中的各条记录，明文追加到 dst
+// 中途的记录失败或遇到关闭记录时，返回此前记录的明文与该记录的错误码 (getOpenStatus)
+func (s *Session) UnmaskFrame(dst []byte, in []byte) ([]byte, error) {
+	apiMu.Lock()
+	defer apiMu.Unlock()
+	dst, err := s.call(dst, in, unmaskFrame)
+	if err == nil && sessionOpenStatus[s.id] != errOK {
+		err = Error(sessionOpenStatus[s.id])
+	}
+	return dst, err
+}
+
+// Seal - 同 aeadEncryptOut: 以 ad 为附加数据加密 plain 为一个 AEAD 帧 (不 mask)，输出追加到 dst
+func (s *Session) Seal(dst []byte, plain []byte, ad []byte) ([]byte, error) {
+	apiMu.Lock()
+	defer apiMu.Unlock()
+	return s.callAD(dst, plain, ad, aeadEncryptOut)
+}
+
+// Open - 同 aeadDecryptOut: 以 ad 为附加数据解密一个 Seal 帧，明文追加到 dst
+func (s *Session) Open(dst []byte, sealed []byte, ad []byte) ([]byte, error) {
+	apiMu.Lock()
+	defer apiMu.Unlock()
+	return s.callAD(dst, sealed, ad, aeadDecryptOut)
+}
+
+// callAD - 将 in 与 ad 依次拷贝到 workBuf 后调用 fn，输出追加到 dst
+func (s *Session) callAD(dst []byte, in []byte, ad []byte, fn func(int32, uint32, uint32, uint32, uint32) uint32) ([]byte, error) {
+	if len(in)+len(ad) > apiMaxInput {
+		return dst, Error(errInputTooLarge)
+	}
+	copy(arena[workBufBase:], in)
+	adPtr := uint32(workBufBase + len(in))
+	copy(arena[adPtr:], ad)
+	p := fn(s.id, workBufBase, uint32(len(in)), adPtr, uint32(len(ad)))
+	if p == 0 {
+		return dst, Error(lastError)
+	}
+	return append(dst, arena[p:p+sessionOutLen[s.id]]...), nil
+}
+
+// call - 将 in 拷贝到 workBuf 起始处后调用 fn，输出追加到 dst
+func (s *Session) call(dst []byte, in []byte, fn func(int32, uint32, uint32) uint32) ([]byte, error) {
+	if len(in) > apiMaxInput {
+		return dst, Error(errInputTooLarge)
+	}
+	copy(arena[workBufBase:], in)
+	p := fn(s.id, workBufBase, uint32(len(in)))
+	if p == 0 {
+		return dst, Error(lastError)
+	}
+	return append(dst, arena[p:p+sessionOutLen[s.id]]...), nil
+}
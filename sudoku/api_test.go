@@ -0,0 +1,242 @@
+//go:build !tinygo
+
+package sudoku
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func apiTestPair(t *testing.T) (*Session, *Session) {
+	t.Helper()
+	key := bytes.Repeat([]byte{0x5A}, 32)
+	tx, err := NewSession(key, CipherChaCha20Poly, LayoutASCII, GridProfile4x4)
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	rx, err := NewSession(key, CipherChaCha20Poly, LayoutASCII, GridProfile4x4)
+	if err != nil {
+		tx.Close()
+		t.Fatalf("NewSession: %v", err)
+	}
+	t.Cleanup(func() {
+		tx.Close()
+		rx.Close()
+	})
+	return tx, rx
+}
+
+func apiTestData(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i*31 + i>>8)
+	}
+	return b
+}
+
+func TestAPIMaskUnmask(t *testing.T) {
+	tx, rx := apiTestPair(t)
+	// 超过 maskMaxChunk 的输入经多次 mask 调用，masked 也超过单次 unmask 的输入上限
+	in := apiTestData(int(maskMaxChunk)*2 + 17)
+	masked, err := tx.Mask(nil, in)
+	if err != nil {
+		t.Fatalf("Mask: %v", err)
+	}
+	if len(masked) <= apiMaxInput {
+		t.Fatalf("masked %d bytes does not exercise Unmask chunking", len(masked))
+	}
+	got, err := rx.Unmask(nil, masked)
+	if err != nil {
+		t.Fatalf("Unmask: %v", err)
+	}
+	if !bytes.Equal(got, in) {
+		t.Fatalf("Unmask: got %d bytes, want %d", len(got), len(in))
+	}
+}
+
+func TestAPISealOpen(t *testing.T) {
+	tx, rx := apiTestPair(t)
+	prefix := []byte("prefix")
+	for _, n := range []int{1, 100, 1000} {
+		plain := apiTestData(n)
+		masked, err := tx.SealAndMask(nil, plain)
+		if err != nil {
+			t.Fatalf("SealAndMask(%d): %v", n, err)
+		}
+		got, err := rx.UnmaskAndOpen(append([]byte(nil), prefix...), masked)
+		if err != nil {
+			t.Fatalf("UnmaskAndOpen(%d): %v", n, err)
+		}
+		if !bytes.HasPrefix(got, prefix) || !bytes.Equal(got[len(prefix):], plain) {
+			t.Fatalf("UnmaskAndOpen(%d): output mismatch", n)
+		}
+	}
+}
+
+func TestAPIErrors(t *testing.T) {
+	newTests := []struct {
+		name       string
+		keyLen     int
+		cipherType uint8
+		want       Error
+	}{
+		{"unknown cipher", 32, 0xEE, Error(errBadConfig)},
+		{"key too long", 33, CipherChaCha20Poly, Error(errBadKey)},
+		{"chacha short key", 16, CipherChaCha20Poly, Error(errBadKey)},
+	}
+	for _, tt := range newTests {
+		if _, err := NewSession(make([]byte, tt.keyLen), tt.cipherType, LayoutASCII, GridProfile4x4); !errors.Is(err, tt.want) {
+			t.Fatalf("NewSession(%s) = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	tx, _ := apiTestPair(t)
+	if _, err := tx.SealAndMask(nil, make([]byte, apiMaxInput+1)); !errors.Is(err, Error(errInputTooLarge)) {
+		t.Fatalf("SealAndMask(too large) = %v, want %v", err, Error(errInputTooLarge))
+	}
+
+	padTests := []struct {
+		name    string
+		percent uint32
+		pool    []byte
+		want    error
+	}{
+		{"ok", 10, nil, nil},
+		{"percent over 100", 101, nil, Error(errBadPadding)},
+		{"pool too long", 10, make([]byte, maxPadPoolSize+1), Error(errBadPadding)},
+	}
+	for _, tt := range padTests {
+		if err := tx.SetPaddingPolicy(tt.percent, tt.pool); !errors.Is(err, tt.want) {
+			t.Fatalf("SetPaddingPolicy(%s) = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	s, err := NewSession(make([]byte, 32), CipherChaCha20Poly, LayoutASCII, GridProfile4x4)
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	s.Close()
+	if _, err := s.Mask(nil, []byte("x")); !errors.Is(err, Error(errBadSession)) {
+		t.Fatalf("Mask(closed) = %v, want %v", err, Error(errBadSession))
+	}
+	if _, err := s.SealAndMask(nil, []byte("x")); !errors.Is(err, Error(errBadSession)) {
+		t.Fatalf("SealAndMask(closed) = %v, want %v", err, Error(errBadSession))
+	}
+	if err := s.SetPaddingPolicy(10, nil); !errors.Is(err, Error(errBadSession)) {
+		t.Fatalf("SetPaddingPolicy(closed) = %v, want %v", err, Error(errBadSession))
+	}
+}
+
+func apiTestRolePair(t *testing.T) (*Session, *Session) {
+	t.Helper()
+	key := bytes.Repeat([]byte{0x5A}, 32)
+	cl, err := NewSessionWithRole(key, CipherChaCha20Poly, LayoutASCII, GridProfile4x4, RoleClient)
+	if err != nil {
+		t.Fatalf("NewSessionWithRole(client): %v", err)
+	}
+	t.Cleanup(cl.Close)
+	sv, err := NewSessionWithRole(key, CipherChaCha20Poly, LayoutASCII, GridProfile4x4, RoleServer)
+	if err != nil {
+		t.Fatalf("NewSessionWithRole(server): %v", err)
+	}
+	t.Cleanup(sv.Close)
+	return cl, sv
+}
+
+func TestAPIRoleFrames(t *testing.T) {
+	cl, sv := apiTestRolePair(t)
+	for _, dir := range []struct {
+		name   string
+		tx, rx *Session
+	}{{"client to server", cl, sv}, {"server to client", sv, cl}} {
+		f1, err := dir.tx.MaskFrame(nil, []byte("frame one"))
+		if err != nil {
+			t.Fatalf("%s: MaskFrame: %v", dir.name, err)
+		}
+		f2, err := dir.tx.MaskFrame(nil, []byte("frame two"))
+		if err != nil {
+			t.Fatalf("%s: MaskFrame: %v", dir.name, err)
+		}
+		if got, err := dir.rx.UnmaskFrame(nil, f1); err != nil || string(got) != "frame one" {
+			t.Fatalf("%s: UnmaskFrame = %q, %v", dir.name, got, err)
+		}
+		// 重放的第二条记录: 返回第一条的明文与 errReplay
+		got, err := dir.rx.UnmaskFrame(nil, append(append([]byte(nil), f2...), f1...))
+		if string(got) != "frame two" || !errors.Is(err, Error(errReplay)) {
+			t.Fatalf("%s: UnmaskFrame(replay) = %q, %v", dir.name, got, err)
+		}
+	}
+
+	// 同角色的 session 使用对端的接收密钥，无法解开
+	other, err := NewSessionWithRole(bytes.Repeat([]byte{0x5A}, 32), CipherChaCha20Poly, LayoutASCII, GridProfile4x4, RoleClient)
+	if err != nil {
+		t.Fatalf("NewSessionWithRole: %v", err)
+	}
+	t.Cleanup(other.Close)
+	f, _ := cl.MaskFrame(nil, []byte("x"))
+	if _, err := other.UnmaskFrame(nil, f); !errors.Is(err, Error(errAuth)) {
+		t.Fatalf("UnmaskFrame(same role) = %v, want %v", err, Error(errAuth))
+	}
+	if _, err := NewSessionWithRole(make([]byte, 32), CipherChaCha20Poly, LayoutASCII, GridProfile4x4, 9); !errors.Is(err, Error(errBadConfig)) {
+		t.Fatalf("NewSessionWithRole(bad role) = %v, want %v", err, Error(errBadConfig))
+	}
+}
+
+func TestAPIAEAD(t *testing.T) {
+	cl, sv := apiTestRolePair(t)
+	ad := []byte("header")
+	tests := []struct {
+		name    string
+		commit  bool
+		plain   []byte
+		openAD  []byte
+		wantErr error
+	}{
+		{"ok", false, []byte("datagram"), ad, nil},
+		{"empty ad", false, []byte("datagram"), nil, Error(errAuth)},
+		{"wrong ad", false, []byte("datagram"), []byte("HEADER"), Error(errAuth)},
+		{"key commit", true, apiTestData(500), ad, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.commit {
+				for _, s := range []*Session{cl, sv} {
+					if err := s.SetOption(SessionOptKeyCommit, 1); err != nil {
+						t.Fatalf("SetOption: %v", err)
+					}
+				}
+			}
+			sealed, err := cl.Seal(nil, tt.plain, ad)
+			if err != nil {
+				t.Fatalf("Seal: %v", err)
+			}
+			got, err := sv.Open([]byte("p:"), sealed, tt.openAD)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Open = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && string(got) != "p:"+string(tt.plain) {
+				t.Fatalf("Open: output mismatch")
+			}
+		})
+	}
+
+	optTests := []struct {
+		name   string
+		option uint32
+		value  uint32
+		want   error
+	}{
+		{"unknown option", 0xFFFF, 0, Error(errBadConfig)},
+		{"bad flag value", SessionOptCompress, 2, Error(errBadConfig)},
+		{"padding percent", SessionOptPaddingPercent, 30, nil},
+	}
+	for _, tt := range optTests {
+		if err := cl.SetOption(tt.option, tt.value); !errors.Is(err, tt.want) {
+			t.Fatalf("SetOption(%s) = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+	if _, err := cl.Seal(nil, make([]byte, apiMaxInput), []byte("x")); !errors.Is(err, Error(errInputTooLarge)) {
+		t.Fatalf("Seal(too large) = %v, want %v", err, Error(errInputTooLarge))
+	}
+}
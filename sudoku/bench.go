@@ -0,0 +1,97 @@
+// 基准测试导出 - 在模块内部循环 N 次 mask / AEAD 加密，排除 JS ↔ Wasm 调用边界的开销，
+// 便于在不同 TinyGo 版本、构建变体 (如 chachasimd) 之间比较吞吐
+// 使用一个固定密钥的临时 session (不影响宿主的 session) 与共享 workBuf/outBuf，调用期间宿主不应使用这两个区域
+// 宿主以 performance.now() 计时整个调用，返回的字节数除以耗时即吞吐
+
+package sudoku
+
+// benchFill - 以固定模式填充 workBuf 前 size 字节作为输入
+func benchFill(size uint32) uint32 {
+	inPtr := uint32(workBufBase)
+	for i := uint32(0); i < size; i++ {
+		arena[inPtr+i] = uint8(i*131 + 7)
+	}
+	return inPtr
+}
+
+// benchSession - 以固定密钥创建临时 session，失败时返回 -1 (lastError 已设置)
+func benchSession(cipherType uint8, layoutType uint8, gridProfile uint8) int32 {
+	key := katKey(0x80)
+	id := initSessionKey(key[:], cipherType, layoutType, gridProfile, RoleShared, nil)
+	if id < 0 {
+		if id == -1 {
+			lastError = errBadSession
+		} else {
+			lastError = errCipher
+		}
+		return -1
+	}
+	return id
+}
+
+// maskBench - 以 layoutType/gridProfile 的临时 session 对 size 字节输入连续调用 iterations 次 mask
+// size 上限为单次 mask 能完整写入 outBuf 的长度 (maskMaxChunk)
+// 返回值: 处理的输入字节总数 (iterations*size)，0 表示失败 (原因通过 getLastError 获取:
+// -1 无可用 session, -2 size 为 0, -3 size 过大, -11 layout/网格规格组合无效)
+//
+//export maskBench
+func maskBench(layoutType uint8, gridProfile uint8, size uint32, iterations uint32) uint64 {
+	if size == 0 {
+		lastError = errEmptyInput
+		return 0
+	}
+	if size > maskMaxChunk {
+		lastError = errInputTooLarge
+		return 0
+	}
+	id := benchSession(CipherNone, layoutType, gridProfile)
+	if id < 0 {
+		return 0
+	}
+	defer closeSession(id)
+
+	inPtr := benchFill(size)
+	total := uint64(0)
+	for i := uint32(0); i < iterations; i++ {
+		if mask(id, inPtr, size) == 0 {
+			return 0
+		}
+		total += uint64(size)
+	}
+	lastError = errOK
+	return total
+}
+
+// aeadBench - 以 cipherType 的临时 session 对 size 字节明文连续调用 iterations 次 aeadEncrypt (无附加数据)
+// 密文写入 workBuf 后半段，size 上限为 workBuf 的一半减去 nonce 与 tag
+// CipherNone 时测得的是复制开销，可作基线
+// 返回值: 加密的明文字节总数 (iterations*size)，0 表示失败 (原因通过 getLastError 获取:
+// -1 无可用 session, -2 size 为 0, -3 size 过大, -11 cipherType 无效)
+//
+//export aeadBench
+func aeadBench(cipherType uint8, size uint32, iterations uint32) uint64 {
+	if size == 0 {
+		lastError = errEmptyInput
+		return 0
+	}
+	if size > aeadScratchSize-xchachaNonceSize-poly1305TagSize {
+		lastError = errInputTooLarge
+		return 0
+	}
+	id := benchSession(cipherType, LayoutASCII, GridProfile4x4)
+	if id < 0 {
+		return 0
+	}
+	defer closeSession(id)
+
+	inPtr := benchFill(size)
+	total := uint64(0)
+	for i := uint32(0); i < iterations; i++ {
+		if aeadEncrypt(id, inPtr, size, aeadScratchBase, 0, 0) == 0 {
+			return 0
+		}
+		total += uint64(size)
+	}
+	lastError = errOK
+	return total
+}
@@ -0,0 +1,21 @@
+// 宿主指针范围校验
+// 宿主传入的 (ptr, len) 必须完全落在 workBuf 起始到 heap 末尾之间 (workBuf/outBuf/heap)，
+// 不得触及 session 区 (其中保存密钥) 与 codec 表区
+
+package sudoku
+
+// inArenaRange - [ptr, ptr+n) 是否位于宿主可访问区域
+// 空范围只要求 ptr 不超出 arena (如 adPtr=0, adLen=0)
+// 以 -tags arenadebug 构建时，越界直接 trap，便于定位宿主侧错误
+func inArenaRange(ptr uint32, n uint32) bool {
+	if n == 0 && ptr <= arenaSize {
+		return true
+	}
+	if ptr >= workBufBase && uint64(ptr)+uint64(n) <= heapEnd {
+		return true
+	}
+	if boundsTrap {
+		panic("arena: host range out of bounds")
+	}
+	return false
+}
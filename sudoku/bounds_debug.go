@@ -0,0 +1,6 @@
+//go:build arenadebug
+
+package sudoku
+
+// 调试构建: 越界指针直接 trap
+const boundsTrap = true
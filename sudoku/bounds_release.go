@@ -0,0 +1,6 @@
+//go:build !arenadebug
+
+package sudoku
+
+// 默认构建: 越界指针返回错误码
+const boundsTrap = false
@@ -0,0 +1,10 @@
+//go:build tinygo && !(gc.leaking && scheduler.none)
+
+// 编译期断言: TinyGo 构建必须使用 -gc=leaking -scheduler=none
+// 模块的全部状态位于静态 arena 与模块级数组中，导出函数不可重入 (drbg 缓存、lastError、共享 outBuf 等)，
+// 带调度器的构建允许宿主回调期间切换执行流，其他 GC 则在线性内存中引入 getMemoryLayout 之外的堆区域；
+// 以其他配置构建时在此处报 undefined 错误，而不是产出行为不同的模块
+
+package sudoku
+
+var _ = requiresGCLeakingAndSchedulerNone
@@ -0,0 +1,57 @@
+// 构建信息 - 模块按固定内存模型编写: 不启动 goroutine、不在 Go 堆上分配、导出路径不经接口动态派发，热路径不使用 defer，
+// 因此 TinyGo 以 -gc=leaking -scheduler=none 构建 (见 Makefile)；其他 GC/调度器配置由 buildassert_tinygo.go 在编译期拒绝
+// -opt 级别不是构建标签，由 Makefile 以 -ldflags "-X sudoku-wasm/sudoku.buildOpt=<级别>" 写入
+
+package sudoku
+
+import "runtime"
+
+const buildInfoSize = 8
+
+// buildOpt - TinyGo -opt 级别 (z/s/0/1/2)，未经 Makefile 构建时为空
+var buildOpt string
+
+// getBuildInfo - 报告编译期确定的构建配置，供宿主核对部署的 .wasm 与预期构建一致
+// 输出 8 字节 (位于 outBuf):
+//
+//	[0]   编译器: 0 Go (宿主测试构建), 1 TinyGo
+//	[1]   GC: 0 Go 运行时, 1 leaking
+//	[2]   调度器: 0 Go 运行时, 1 none
+//	[3]   -opt 级别 (ASCII)，0 表示未知
+//	[4]   bit0 arenadebug, bit1 chachasimd
+//	[5]   目标: 0 浏览器 (GOOS=js), 1 wasip1, 2 其他 (宿主构建)
+//	[6:8] 保留 (0)
+//
+// 返回值: 输出指针 (长度 8，也可通过 getOutLen 获取)
+//
+//export getBuildInfo
+func getBuildInfo() uint32 {
+	out := arena[outBufBase : outBufBase+buildInfoSize]
+	out[0] = buildCompiler
+	out[1] = buildGC
+	out[2] = buildScheduler
+	out[3] = 0
+	if len(buildOpt) == 1 {
+		out[3] = buildOpt[0]
+	}
+	out[4] = 0
+	if boundsTrap {
+		out[4] |= 1 << 0
+	}
+	if chachaSimd {
+		out[4] |= 1 << 1
+	}
+	switch runtime.GOOS {
+	case "js":
+		out[5] = 0
+	case "wasip1":
+		out[5] = 1
+	default:
+		out[5] = 2
+	}
+	out[6] = 0
+	out[7] = 0
+	currentOutLen = buildInfoSize
+	lastError = errOK
+	return outBufBase
+}
@@ -0,0 +1,10 @@
+//go:build !tinygo
+
+package sudoku
+
+// 宿主构建 (go test / go vet): Go 运行时自带 GC 与调度器
+const (
+	buildCompiler  = 0
+	buildGC        = 0
+	buildScheduler = 0
+)
@@ -0,0 +1,10 @@
+//go:build tinygo && gc.leaking && scheduler.none
+
+package sudoku
+
+// TinyGo 构建 (-gc=leaking -scheduler=none)
+const (
+	buildCompiler  = 1
+	buildGC        = 1
+	buildScheduler = 1
+)
@@ -0,0 +1,105 @@
+// AEAD 注册表 - 每种 cipherType 的密钥/nonce/tag 长度与 seal/open 入口集中在 cipherRegistry，
+// 记录层、显式 nonce 接口、数据报模式、initSession 与能力位图都按表查询，新增 AEAD 只需实现一对适配函数并登记一行
+
+package sudoku
+
+// seal 输出 [密文][tag]，返回输出长度 (<= 0 表示失败)；open 输入 [密文][tag]，返回明文长度 (< 0 表示认证失败)
+// key 为 session.key (或接收方向密钥) 的完整 32 字节，适配函数自行截取 keyLen
+type cipherSealFunc func(key *[32]byte, nonce []byte, plaintext []byte, plaintextLen int, ad []byte, out []byte) int
+type cipherOpenFunc func(key *[32]byte, nonce []byte, ciphertextAndTag []byte, ctLen int, ad []byte, out []byte) int
+
+type cipherSpec struct {
+	keyLen   uint8 // 实际使用的密钥字节数
+	nonceLen uint8
+	tagLen   uint8
+	truncate bool // initSession 接受更长的密钥并截断到 keyLen (否则须恰为 keyLen)
+	seal     cipherSealFunc
+	open     cipherOpenFunc
+}
+
+const (
+	cipherCount    = 5  // cipherRegistry 长度 (cipherType 取值上限 +1)
+	cipherMaxNonce = 24 // 各 cipher nonce 长度的最大值
+)
+
+// CipherNone 一行只登记长度，seal/open 为 nil
+var cipherRegistry = [cipherCount]cipherSpec{
+	CipherNone:          {},
+	CipherAES128GCM:     {keyLen: 16, nonceLen: gcmNonceSize, tagLen: gcmTagSize, truncate: true, seal: sealAES128GCM, open: openAES128GCM},
+	CipherChaCha20Poly:  {keyLen: 32, nonceLen: chachaNonceSize, tagLen: poly1305TagSize, seal: sealChaCha20Poly1305, open: openChaCha20Poly1305},
+	CipherXChaCha20Poly: {keyLen: 32, nonceLen: xchachaNonceSize, tagLen: poly1305TagSize, seal: sealXChaCha20Poly1305, open: openXChaCha20Poly1305},
+	CipherAES256GCM:     {keyLen: 32, nonceLen: gcmNonceSize, tagLen: gcmTagSize, seal: sealAES256GCM, open: openAES256GCM},
+}
+
+// cipherLookup - 取得 cipherType 的登记项，未登记的取值返回 nil
+func cipherLookup(cipherType uint8) *cipherSpec {
+	if uint32(cipherType) >= cipherCount {
+		return nil
+	}
+	spec := &cipherRegistry[cipherType]
+	if cipherType != CipherNone && spec.seal == nil {
+		return nil
+	}
+	return spec
+}
+
+// cipherTypeMask - 已登记 cipherType 的位图 (bit n = cipherType n)，用于 getCapabilities
+func cipherTypeMask() uint32 {
+	mask := uint32(0)
+	for t := uint32(0); t < cipherCount; t++ {
+		if cipherLookup(uint8(t)) != nil {
+			mask |= 1 << t
+		}
+	}
+	return mask
+}
+
+// 以下为各 AEAD 到统一签名的适配 (nonce 长度由调用方按 nonceLen 保证)
+
+func sealAES128GCM(key *[32]byte, nonce []byte, plaintext []byte, plaintextLen int, ad []byte, out []byte) int {
+	var n [gcmNonceSize]byte
+	copy(n[:], nonce)
+	return aesgcmSeal(key[:16], &n, plaintext, plaintextLen, ad, len(ad), out)
+}
+
+func openAES128GCM(key *[32]byte, nonce []byte, ciphertextAndTag []byte, ctLen int, ad []byte, out []byte) int {
+	var n [gcmNonceSize]byte
+	copy(n[:], nonce)
+	return aesgcmOpen(key[:16], &n, ciphertextAndTag, ctLen, ad, len(ad), out)
+}
+
+func sealAES256GCM(key *[32]byte, nonce []byte, plaintext []byte, plaintextLen int, ad []byte, out []byte) int {
+	var n [gcmNonceSize]byte
+	copy(n[:], nonce)
+	return aesgcmSeal(key[:], &n, plaintext, plaintextLen, ad, len(ad), out)
+}
+
+func openAES256GCM(key *[32]byte, nonce []byte, ciphertextAndTag []byte, ctLen int, ad []byte, out []byte) int {
+	var n [gcmNonceSize]byte
+	copy(n[:], nonce)
+	return aesgcmOpen(key[:], &n, ciphertextAndTag, ctLen, ad, len(ad), out)
+}
+
+func sealChaCha20Poly1305(key *[32]byte, nonce []byte, plaintext []byte, plaintextLen int, ad []byte, out []byte) int {
+	var n [chachaNonceSize]byte
+	copy(n[:], nonce)
+	return chacha20poly1305Seal(key, &n, plaintext, plaintextLen, ad, len(ad), out)
+}
+
+func openChaCha20Poly1305(key *[32]byte, nonce []byte, ciphertextAndTag []byte, ctLen int, ad []byte, out []byte) int {
+	var n [chachaNonceSize]byte
+	copy(n[:], nonce)
+	return chacha20poly1305Open(key, &n, ciphertextAndTag, ctLen, ad, len(ad), out)
+}
+
+func sealXChaCha20Poly1305(key *[32]byte, nonce []byte, plaintext []byte, plaintextLen int, ad []byte, out []byte) int {
+	var n [xchachaNonceSize]byte
+	copy(n[:], nonce)
+	return xchacha20poly1305Seal(key, &n, plaintext, plaintextLen, ad, len(ad), out)
+}
+
+func openXChaCha20Poly1305(key *[32]byte, nonce []byte, ciphertextAndTag []byte, ctLen int, ad []byte, out []byte) int {
+	var n [xchachaNonceSize]byte
+	copy(n[:], nonce)
+	return xchacha20poly1305Open(key, &n, ciphertextAndTag, ctLen, ad, len(ad), out)
+}
@@ -0,0 +1,95 @@
+//go:build tinygo && wasip1
+
+// wasip1 命令行 - 在 wasmtime/wazero 等 WASI 运行时中以同一份 codec/AEAD 实现充当原生中继的编解码进程
+// 用法: sudoku-wasi.wasm <mask|unmask|seal|open> <密钥 hex> [cipherType] [layoutType] [gridProfile]
+// 创建一个 RoleShared session (默认 ChaCha20-Poly1305、ASCII、4x4)，此后逐帧处理 stdin:
+//   输入与输出帧均为 [长度 (4, 大端序)][数据]，每个输入帧对应一次 mask / unmask / sealAndMask / unmaskAndOpen 调用，
+//   结果 (可能为空) 作为一个输出帧写入 stdout，空输入帧对应空输出帧；mask/unmask 的流状态跨帧延续，open 的输入帧须为完整的 sealAndMask 帧
+// stdin 结束时退出码 0；调用失败时向 stderr 写入错误码 (errors.go) 并以退出码 1 结束
+// 命令行只在启动时解析参数有少量堆分配 (leaking GC 不回收)，逐帧处理不分配
+// 以 -buildmode=c-shared 构建时 main 不运行，模块作为 reactor 提供与浏览器构建相同的导出 (见 Makefile build-wasi-reactor)
+
+package sudoku
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"os"
+	"strconv"
+)
+
+// cliMaxFrame - 单个输入帧上限: workBuf 前半段 (后半段为 AEAD 暂存区)
+const cliMaxFrame = workBufSize / 2
+
+// RunCLI - 命令行入口，由模块根目录的 main 调用
+func RunCLI() {
+	if len(os.Args) < 3 {
+		cliExit("usage: sudoku-wasi <mask|unmask|seal|open> <key hex> [cipherType] [layoutType] [gridProfile]", 2)
+	}
+	var op func(int32, uint32, uint32) uint32
+	switch os.Args[1] {
+	case "mask":
+		op = mask
+	case "unmask":
+		op = unmask
+	case "seal":
+		op = sealAndMask
+	case "open":
+		op = unmaskAndOpen
+	default:
+		cliExit("unknown operation: "+os.Args[1], 2)
+	}
+	key, err := hex.DecodeString(os.Args[2])
+	if err != nil {
+		cliExit("bad key hex", 2)
+	}
+	cfg := [3]uint8{CipherChaCha20Poly, LayoutASCII, GridProfile4x4}
+	for i := 0; i < 3 && 3+i < len(os.Args); i++ {
+		v, err := strconv.ParseUint(os.Args[3+i], 0, 8)
+		if err != nil {
+			cliExit("bad argument: "+os.Args[3+i], 2)
+		}
+		cfg[i] = uint8(v)
+	}
+
+	initWasm()
+	id := initSessionKey(key, cfg[0], cfg[1], cfg[2], RoleShared, nil)
+	if id < 0 {
+		cliExit("initSession: "+strconv.Itoa(int(id)), 1)
+	}
+
+	var hdr [4]byte
+	for {
+		if _, err := io.ReadFull(os.Stdin, hdr[:]); err != nil {
+			if err == io.EOF {
+				os.Exit(0)
+			}
+			cliExit("truncated frame header", 1)
+		}
+		n := binary.BigEndian.Uint32(hdr[:])
+		if n > cliMaxFrame {
+			cliExit("frame too large: "+strconv.Itoa(int(n)), 1)
+		}
+		if _, err := io.ReadFull(os.Stdin, arena[workBufBase:workBufBase+n]); err != nil {
+			cliExit("truncated frame", 1)
+		}
+		// 空帧原样输出为空帧 (seal/open 不接受空输入)
+		out, outLen := uint32(0), uint32(0)
+		if n != 0 {
+			if out = op(id, workBufBase, n); out == 0 {
+				cliExit("error "+strconv.Itoa(int(getLastError())), 1)
+			}
+			outLen = getOutLen()
+		}
+		binary.BigEndian.PutUint32(hdr[:], outLen)
+		os.Stdout.Write(hdr[:])
+		os.Stdout.Write(arena[out : out+outLen])
+	}
+}
+
+// cliExit - 向 stderr 写入一行说明并以 code 退出
+func cliExit(msg string, code int) {
+	os.Stderr.Write([]byte(msg + "\n"))
+	os.Exit(code)
+}
@@ -0,0 +1,77 @@
+// 关闭通知 - 以控制记录在带内通知对端流结束，不必依赖 TCP reset
+// 发送 buildCloseNotify 后本端不能再发送 (仍可接收对端剩余的数据)；
+// 收到对端的关闭记录后 session 锁定，收发均返回 errClosed，只能 closeSession
+// unmaskFrame 遇到关闭记录时照常返回其之前的数据，getOpenStatus 返回 errClosed，记录之后的输入被丢弃
+
+package sudoku
+
+import "encoding/binary"
+
+const (
+	closeSent     = 1 << 0
+	closeReceived = 1 << 1
+
+	closeReasonSize = 2
+)
+
+var (
+	sessionCloseState  [maxSessions]uint8  // closeSent | closeReceived
+	sessionCloseReason [maxSessions]uint16 // 对端关闭记录中的原因码
+)
+
+// txClosed - session 已不能发送
+func txClosed(id int32) bool {
+	return sessionCloseState[id] != 0
+}
+
+// rxClosed - session 已收到对端的关闭记录
+func rxClosed(id int32) bool {
+	return sessionCloseState[id]&closeReceived != 0
+}
+
+// peerClosed - 记录对端关闭并锁定 session
+func peerClosed(id int32, reason uint16) {
+	sessionCloseState[id] |= closeReceived
+	sessionCloseReason[id] = reason
+	logEvent(LogLevelInfo, logPeerClose, uint32(id))
+}
+
+// buildCloseNotify - 生成一条关闭记录并 mask (输出格式同 maskFrame)，之后本端不能再发送
+// reasonCode 由双方约定 (0 表示正常关闭)，对端通过 getCloseReason 读取
+// 收到对端关闭后仍可调用一次作为应答
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取:
+// -1 session 无效, -3 reasonCode 超过 65535, -15 已发送过关闭记录)
+//
+//export buildCloseNotify
+func buildCloseNotify(id int32, reasonCode uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if sessionCloseState[id]&closeSent != 0 {
+		return fail(errClosed)
+	}
+	if reasonCode > 0xFFFF {
+		return fail(errInputTooLarge)
+	}
+	out, _ := sessionOut(id)
+	binary.BigEndian.PutUint16(arena[out+1:out+1+closeReasonSize], uint16(reasonCode))
+	p := maskControlRecord(id, controlClose, closeReasonSize)
+	if p != 0 {
+		sessionCloseState[id] |= closeSent
+	}
+	return p
+}
+
+// getCloseReason - 对端关闭记录中的原因码
+// 返回值: 0-65535 原因码, -1 session 无效, -2 尚未收到关闭记录
+//
+//export getCloseReason
+func getCloseReason(id int32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !rxClosed(id) {
+		return -2
+	}
+	return int32(sessionCloseReason[id])
+}
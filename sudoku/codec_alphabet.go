@@ -0,0 +1,90 @@
+// 输出字母表 - 4x4 hint 字节可替换为其他字符集，使 mask 输出能通过改写控制字符或特殊字符的中间设备
+// codec 表始终以规范 hint 字节 (0x40-0x7F，见 hintByte) 存储: mask 输出时按字母表替换，
+// unmask 时经反查表还原，因此 keyed 表可与任意字母表组合，解码键与 LayoutASCII 完全相同
+//   LayoutASCII        规范 hint 字节本身，padding 可为任意非 hint 字节
+//   LayoutBase64URL    A-Z a-z 0-9 - _ (RFC 4648 base64url)，padding 仅限 . ~ (URL 非保留字符)
+//   LayoutHeaderToken  A-Z a-z 0-9 ! #，padding 仅限 $ % & ' * + - . ^ _ ` | ~ (RFC 9110 token 字符)
+// 纯字母数字只有 62 个字符，不足以容纳 64 个 hint 符号，因此不单独提供
+// 9x9 profile 的符号需要两类字节区分高低位，不支持替换字母表
+
+package sudoku
+
+// 字母表编号 (由 layoutType 低 7 位决定，见 layoutAlphabet)
+const (
+	alphabetASCII       = 0
+	alphabetBase64URL   = 1
+	alphabetHeaderToken = 2
+	alphabetCount       = 3
+)
+
+// hint 符号 (value-1)<<4|position 到输出字符的映射，以及各字母表允许的 padding 字符
+const (
+	alphabetBase64URLHints   = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	alphabetBase64URLPad     = ".~"
+	alphabetHeaderTokenHints = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789!#"
+	alphabetHeaderTokenPad   = "$%&'*+-.^_`|~"
+)
+
+// 反查表取值: 0x40-0x7F 为规范 hint 字节，alphabetPadOK 为允许的 padding，0 为其他字节
+const alphabetPadOK = 1
+
+var (
+	alphabetEncode [alphabetCount][64]uint8
+	alphabetDecode [alphabetCount][256]uint8
+	alphabetMarker [alphabetCount]uint8 // 默认 padding 标记
+)
+
+// setupAlphabets - 构建各字母表的编码/反查表 (setupCodecTables 调用)
+func setupAlphabets() {
+	for i := 0; i < 64; i++ {
+		alphabetEncode[alphabetASCII][i] = 0x40 | uint8(i)
+	}
+	for b := 0; b < 256; b++ {
+		if isHintASCII(uint8(b)) {
+			alphabetDecode[alphabetASCII][b] = uint8(b)
+		} else {
+			alphabetDecode[alphabetASCII][b] = alphabetPadOK
+		}
+	}
+	alphabetMarker[alphabetASCII] = 0x3F
+
+	setupAlphabet(alphabetBase64URL, alphabetBase64URLHints, alphabetBase64URLPad)
+	setupAlphabet(alphabetHeaderToken, alphabetHeaderTokenHints, alphabetHeaderTokenPad)
+}
+
+func setupAlphabet(a int, hints string, pad string) {
+	dec := &alphabetDecode[a]
+	*dec = [256]uint8{}
+	for i := 0; i < 64; i++ {
+		alphabetEncode[a][i] = hints[i]
+		dec[hints[i]] = 0x40 | uint8(i)
+	}
+	for i := 0; i < len(pad); i++ {
+		dec[pad[i]] = alphabetPadOK
+	}
+	alphabetMarker[a] = pad[0]
+}
+
+// layoutAlphabet - layoutType 对应的字母表，未定义的取值按 LayoutASCII 处理 (与旧版本行为一致)
+func layoutAlphabet(layoutType uint8) uint8 {
+	switch layoutType &^ LayoutKeyed {
+	case LayoutBase64URL:
+		return alphabetBase64URL
+	case LayoutHeaderToken:
+		return alphabetHeaderToken
+	}
+	return alphabetASCII
+}
+
+// sessionAlphabet - session 使用的字母表 (9x9 profile 始终为 alphabetASCII)
+func sessionAlphabet(state *[64]byte) uint8 {
+	return layoutAlphabet(state[stateLayoutType])
+}
+
+// isPaddingAllowed - b 能否作为该 session 的 padding 字节
+func isPaddingAllowed(state *[64]byte, b uint8) bool {
+	if state[stateGridProfile] == GridProfile9x9 {
+		return !isProfileHint(GridProfile9x9, b)
+	}
+	return alphabetDecode[sessionAlphabet(state)][b] == alphabetPadOK
+}
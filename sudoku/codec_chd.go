@@ -0,0 +1,177 @@
+// 解码表完美散列 (CHD: compress, hash and displace)
+// 键先散列到桶，每个桶选择一个位移 d，使桶内所有键按 (散列, d) 落到互不冲突的空槽
+// 查找固定两次访存: 读桶的位移，再读槽位比较键 —— 与负载率、键是否存在无关
+//   h    = chdMix(key ^ salt)
+//   桶   = h >> (32 - 桶位数)
+//   槽位 = chdMix(h + d*chdDispMul + chdDispAdd) >> (32 - 槽位数)
+// 4x4 表在运行时构建 (buildDecodeCHD)；9x9 表由 gen_data.go 离线构建 (data_generated.go)，两处散列必须一致
+//
+// 槽位项为 压缩键<<8 | 字节值，0 为空槽: 组内 hint (或 9x9 符号) 两两不同，压缩键不可能为 0
+
+package sudoku
+
+const (
+	chdDispMul = 0x9E3779B9
+	chdDispAdd = 0x7F4A7C15
+
+	chdMaxBucket = 32  // 桶内键数上限，超过时换 salt 重建
+	chdMaxSalts  = 256 // salt 尝试次数 (实际上第一个 salt 即可成功)
+)
+
+// chdMix - murmur3 fmix32
+func chdMix(x uint32) uint32 {
+	x ^= x >> 16
+	x *= 0x85EBCA6B
+	x ^= x >> 13
+	x *= 0xC2B2AE35
+	x ^= x >> 16
+	return x
+}
+
+// chdSlot - 桶位移 d 下的槽位 (取 bits 位)
+func chdSlot(h uint32, d uint16, bits uint32) uint32 {
+	return chdMix(h+uint32(d)*chdDispMul+chdDispAdd) >> (32 - bits)
+}
+
+// chdSaltFor - 第 i 次尝试使用的 salt
+func chdSaltFor(i uint32) uint32 {
+	return i * 0x632BE5AB
+}
+
+// 4x4 表的桶数: 约 3 个键一个桶
+const (
+	chdBucketBits = 12
+	chdBuckets    = 1 << chdBucketBits
+)
+
+// 构建用临时数组 (所有槽位共用，构建在单线程中完成)
+var (
+	chdItems       [256 * maxHintsPerByte]uint16 // 按桶排列的 (字节值*maxHintsPerByte + 组号)
+	chdItemHash    [256 * maxHintsPerByte]uint32 // 与 chdItems 对应的 chdMix(key ^ salt)，尝试位移时不必重复计算
+	chdBucketStart [chdBuckets + 1]uint16
+	chdBucketFill  [chdBuckets]uint16
+	chdBucketOrder [chdBuckets]uint16 // 按桶大小降序
+	chdSizeCount   [chdMaxBucket + 2]uint16
+	chdTmpSlots    [chdMaxBucket]uint32
+)
+
+// hintKey24 - 4x4 解码键的压缩形式: 每个 hint 字节取低 6 位
+// 键的每个字节都必须是 hint 字节 (0x40-0x7F)，否则返回 0 (不可能命中)
+func hintKey24(key uint32) uint32 {
+	if key&0xC0C0C0C0 != 0x40404040 {
+		return 0
+	}
+	return (key>>24&0x3F)<<18 | (key>>16&0x3F)<<12 | (key>>8&0x3F)<<6 | key&0x3F
+}
+
+// buildDecodeCHD - 以 t 的编码表中全部 hint 组构建完美散列解码表 (decodeEntries/disp/salt)
+// 返回 false 表示所有 salt 都无法放置 (不会发生，调用方仍按构建失败处理)
+func buildDecodeCHD(t *codecTable) bool {
+	for attempt := uint32(0); attempt < chdMaxSalts; attempt++ {
+		if chdTryBuild(t, chdSaltFor(attempt)) {
+			return true
+		}
+	}
+	t.entries = 0
+	return false
+}
+
+func chdTryBuild(t *codecTable, salt uint32) bool {
+	for i := range t.decodeEntries {
+		t.decodeEntries[i] = 0
+	}
+	for i := range t.disp {
+		t.disp[i] = 0
+	}
+	t.salt = salt
+	t.entries = 0
+
+	// 按桶计数，前缀和得到每个桶在 chdItems 中的区间
+	for i := range chdBucketFill {
+		chdBucketFill[i] = 0
+	}
+	for b := 0; b < 256; b++ {
+		for g := uint8(0); g < t.count[b]; g++ {
+			h := chdMix(packHintsToKey(t.encode[b][g]) ^ salt)
+			bk := h >> (32 - chdBucketBits)
+			if chdBucketFill[bk] == chdMaxBucket {
+				return false
+			}
+			chdBucketFill[bk]++
+		}
+	}
+	pos := uint16(0)
+	for i := 0; i < chdBuckets; i++ {
+		chdBucketStart[i] = pos
+		pos += chdBucketFill[i]
+		chdBucketFill[i] = 0
+	}
+	chdBucketStart[chdBuckets] = pos
+	for b := 0; b < 256; b++ {
+		for g := uint8(0); g < t.count[b]; g++ {
+			h := chdMix(packHintsToKey(t.encode[b][g]) ^ salt)
+			bk := h >> (32 - chdBucketBits)
+			chdItems[chdBucketStart[bk]+chdBucketFill[bk]] = uint16(b*maxHintsPerByte) + uint16(g)
+			chdItemHash[chdBucketStart[bk]+chdBucketFill[bk]] = h
+			chdBucketFill[bk]++
+		}
+	}
+
+	// 桶按大小降序 (计数排序)，大桶先放置
+	for i := range chdSizeCount {
+		chdSizeCount[i] = 0
+	}
+	for i := 0; i < chdBuckets; i++ {
+		chdSizeCount[chdMaxBucket-chdBucketFill[i]+1]++
+	}
+	for s := 1; s < len(chdSizeCount); s++ {
+		chdSizeCount[s] += chdSizeCount[s-1]
+	}
+	for i := 0; i < chdBuckets; i++ {
+		k := chdMaxBucket - chdBucketFill[i]
+		chdBucketOrder[chdSizeCount[k]] = uint16(i)
+		chdSizeCount[k]++
+	}
+
+	for _, bk := range chdBucketOrder {
+		n := uint32(chdBucketFill[bk])
+		if n == 0 {
+			break
+		}
+		items := chdItems[chdBucketStart[bk] : uint32(chdBucketStart[bk])+n]
+		hashes := chdItemHash[chdBucketStart[bk] : uint32(chdBucketStart[bk])+n]
+		placed := false
+		for d := uint32(0); d <= 0xFFFF && !placed; d++ {
+			placed = true
+			for i, h := range hashes {
+				slot := chdSlot(h, uint16(d), decodeTableBits)
+				if t.decodeEntries[slot] != 0 {
+					placed = false
+					break
+				}
+				for j := 0; j < i; j++ {
+					if chdTmpSlots[j] == slot {
+						placed = false
+						break
+					}
+				}
+				if !placed {
+					break
+				}
+				chdTmpSlots[i] = slot
+			}
+			if placed {
+				t.disp[bk] = uint16(d)
+				for i, it := range items {
+					key := packHintsToKey(t.encode[it/maxHintsPerByte][it%maxHintsPerByte])
+					t.decodeEntries[chdTmpSlots[i]] = hintKey24(key)<<8 | uint32(it/maxHintsPerByte)
+				}
+			}
+		}
+		if !placed {
+			return false
+		}
+		t.entries += n
+	}
+	return true
+}
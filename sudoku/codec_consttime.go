@@ -0,0 +1,48 @@
+// 常量时间解码 - 供 worker 与不可信代码同处一个进程时使用
+// 解码表为完美散列 (codec_chd.go)，普通查表已固定为两次访存；
+// 常量时间模式进一步去掉键校验与比较处的分支，以掩码选择结果
+
+package sudoku
+
+// ctEq32 - a == b 时返回 1，否则返回 0 (无分支)
+func ctEq32(a, b uint32) uint32 {
+	return uint32((uint64(a^b) - 1) >> 63)
+}
+
+// decodeTableLookupCT - decodeTableLookupIn 的常量时间版本
+// 非 hint 字节组成的键按压缩键 0 查找，由 valid 掩码排除
+func decodeTableLookupCT(t *codecTable, key uint32) (uint8, bool) {
+	k24 := (key>>24&0x3F)<<18 | (key>>16&0x3F)<<12 | (key>>8&0x3F)<<6 | key&0x3F
+	valid := ctEq32(key&0xC0C0C0C0, 0x40404040) & (1 ^ ctEq32(k24, 0))
+	h := chdMix(key ^ t.salt)
+	e := t.decodeEntries[chdSlot(h, t.disp[h>>(32-chdBucketBits)], decodeTableBits)]
+	found := ctEq32(e>>8, k24) & valid
+	return uint8(e & -found), found == 1
+}
+
+// grid9DecodeLookupCT - grid9DecodeLookup 的常量时间版本
+func grid9DecodeLookupCT(key uint32) (uint8, bool) {
+	k20 := (key>>16&0x3FF)<<10 | key&0x3FF
+	valid := ctEq32(key&0xFC00FC00, 0) & (1 ^ ctEq32(k20, 0))
+	h := chdMix(key ^ grid9DecodeSalt)
+	e := grid9DecodeEntries[chdSlot(h, grid9DecodeDisp[h>>(32-grid9BucketBits)], grid9DecodeBits)]
+	found := ctEq32(e>>8, k20) & valid
+	return uint8(e & -found), found == 1
+}
+
+// setConstantTimeDecode - 开启/关闭 session 的常量时间解码模式 (enable 非 0 为开启)
+// 返回值: 0 成功, -1 session 无效
+//
+//export setConstantTimeDecode
+func setConstantTimeDecode(id int32, enable uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	session := getSession(id)
+	if enable != 0 {
+		session.flags |= sessionFlagConstTimeDecode
+	} else {
+		session.flags &^= sessionFlagConstTimeDecode
+	}
+	return 0
+}
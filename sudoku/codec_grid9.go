@@ -0,0 +1,223 @@
+// 9x9 codec profile
+// 每个字节编码为 2 个 hint 符号，符号 = cell*9 + (value-1) (0..728)，
+// 取自该字节对应的 9x9 网格；解码键与符号顺序无关
+// 线路格式: 每个符号 2 字节 [0xE0 | sym>>6][0x80 | sym&0x3F]
+// 编码表与完美散列解码表由 gen_data.go 生成 (data_generated.go，散列见 codec_chd.go)
+
+package sudoku
+
+// gridProfile 取值 (initSession)
+const (
+	GridProfile4x4 = 0
+	GridProfile9x9 = 1
+)
+
+const (
+	grid9MaxGroups  = 24
+	grid9DecodeBits = 13
+	grid9DecodeSize = 1 << grid9DecodeBits
+	grid9BucketBits = 11
+	grid9Buckets    = 1 << grid9BucketBits
+
+	grid9HintHi = 0xE0 // 高字节前缀 (0xE0-0xEB)
+	grid9HintLo = 0x80 // 低字节前缀 (0x80-0xBF)
+)
+
+func isGrid9HintHi(b uint8) bool {
+	return b >= grid9HintHi && b <= grid9HintHi|(728>>6)
+}
+
+func isGrid9HintLo(b uint8) bool {
+	return b&0xC0 == grid9HintLo
+}
+
+// isProfileHint - b 是否为该 profile 的 hint 字节 (padding 不得使用)
+func isProfileHint(profile uint8, b uint8) bool {
+	if profile == GridProfile9x9 {
+		return isGrid9HintHi(b) || isGrid9HintLo(b)
+	}
+	return isHintASCII(b)
+}
+
+func grid9Key(a, b uint16) uint32 {
+	if a > b {
+		a, b = b, a
+	}
+	return uint32(a)<<16 | uint32(b)
+}
+
+// grid9Key20 - 9x9 解码键的压缩形式 a<<10 | b；符号超出 10 位的键返回 0 (不可能命中)
+func grid9Key20(key uint32) uint32 {
+	if key&0xFC00FC00 != 0 {
+		return 0
+	}
+	return (key>>16)<<10 | key&0x3FF
+}
+
+// grid9DecodeLookup - 查找键: 固定读取桶位移与一个槽位
+func grid9DecodeLookup(key uint32) (uint8, bool) {
+	k20 := grid9Key20(key)
+	if k20 == 0 {
+		return 0, false
+	}
+	h := chdMix(key ^ grid9DecodeSalt)
+	e := grid9DecodeEntries[chdSlot(h, grid9DecodeDisp[h>>(32-grid9BucketBits)], grid9DecodeBits)]
+	if e>>8 != k20 {
+		return 0, false
+	}
+	return uint8(e), true
+}
+
+// maskGroup9 - 按 9x9 profile 组装一个字节的输出 (含 padding)，返回其中的数据字节数
+// 选中的符号对含禁用字节 (forbid) 时顺延到下一个符号对，全部含禁用字节时返回 0
+func maskGroup9(b uint8, forbid *[32]byte, rng *maskRng, pool *[maxPadPoolSize]uint8, padPoolSize uint8, paddingThreshold32 uint32, marker uint8, grp *[maskMaxPerByte]byte, n *uint32) uint32 {
+	maskEmitPadding(rng, pool, padPoolSize, paddingThreshold32, grp, n)
+	rngAdvance(rng)
+
+	count := grid9EncodeCount[b]
+	groupIdx := rng.x % uint32(count)
+	rngAdvance(rng)
+	if forbid != nil {
+		k := uint8(0)
+		for ; k < count && !syms9Allowed(&grid9EncodeTable[b][groupIdx], forbid); k++ {
+			groupIdx = (groupIdx + 1) % uint32(count)
+		}
+		if k == count {
+			return 0
+		}
+	}
+	syms := grid9EncodeTable[b][groupIdx]
+
+	order := rng.x & 1
+	rngAdvance(rng)
+
+	for j := uint32(0); j < 2; j++ {
+		sym := syms[j^order]
+		maskEmitInnerPadding(rng, pool, padPoolSize, paddingThreshold32, marker, grp, n)
+		rngAdvance(rng)
+
+		grp[*n] = grid9HintHi | uint8(sym>>6)
+		grp[*n+1] = grid9HintLo | uint8(sym&0x3F)
+		*n += 2
+	}
+	return 4
+}
+
+// unmaskBody9 - 按 9x9 profile 解码，未完成的符号保存在 sudokuState 中
+// 返回值同 unmaskBody；严格模式下缺失配对字节的符号同样视为无法解码
+func unmaskBody9(id int32, inPtr uint32, inLen uint32, out uint32, maxOut uint32) (uint32, bool) {
+	state := &getSession(id).sudokuState
+	st := &sessionStats[id]
+	decodeErrors := st.decodeErrors
+	outPos := uint32(0)
+
+	var hintBuf [4]uint8
+	copy(hintBuf[:], state[stateHintBuf:stateHintBuf+4])
+	hintCount := state[stateHintCount]
+	padMarker := state[statePadMarker]
+	cover := sessionCover(state)
+	cursor := state[stateCoverRx]
+	flags := getSession(id).flags
+	constTime := flags&sessionFlagConstTimeDecode != 0
+	strict := flags&sessionFlagStrictDecode != 0
+	ok := true
+
+decode:
+	for i := uint32(0); i < inLen && outPos < maxOut; i++ {
+		b := arena[inPtr+i]
+
+		if cover != nil {
+			if slot, bad := coverStrip(cover, &cursor, b); !slot {
+				if bad {
+					st.decodeErrors++
+					if strict {
+						sessionDecodeErrOffset[id] = int32(i)
+						ok = false
+						break decode
+					}
+				}
+				continue
+			}
+		}
+
+		switch {
+		case b == padMarker:
+			// 组边界标记: 丢弃未完成的符号重新同步 (见 unmaskBody)
+			if hintCount != 0 {
+				hintCount = 0
+				st.decodeErrors++
+				if strict {
+					sessionDecodeErrOffset[id] = int32(i)
+					ok = false
+					break decode
+				}
+			}
+			continue
+		case isGrid9HintHi(b):
+			if hintCount&1 != 0 {
+				// 上一个高字节缺少低字节，丢弃
+				hintCount--
+				st.decodeErrors++
+				if strict {
+					sessionDecodeErrOffset[id] = int32(i)
+					ok = false
+					break decode
+				}
+			}
+			hintBuf[hintCount] = b
+			hintCount++
+		case isGrid9HintLo(b):
+			if hintCount&1 == 0 {
+				st.decodeErrors++
+				if strict {
+					sessionDecodeErrOffset[id] = int32(i)
+					ok = false
+					break decode
+				}
+				continue
+			}
+			hintBuf[hintCount] = b
+			hintCount++
+		default:
+			continue
+		}
+
+		if hintCount == 4 {
+			s0 := uint16(hintBuf[0]&0x0F)<<6 | uint16(hintBuf[1]&0x3F)
+			s1 := uint16(hintBuf[2]&0x0F)<<6 | uint16(hintBuf[3]&0x3F)
+			var val uint8
+			var found bool
+			if constTime {
+				val, found = grid9DecodeLookupCT(grid9Key(s0, s1))
+			} else {
+				val, found = grid9DecodeLookup(grid9Key(s0, s1))
+			}
+			hintCount = 0
+			if found {
+				arena[out+outPos] = val
+				outPos++
+			} else {
+				st.decodeErrors++
+				if strict {
+					sessionDecodeErrOffset[id] = int32(i)
+					ok = false
+					break decode
+				}
+			}
+		}
+	}
+
+	if !ok {
+		hintCount = 0
+	}
+
+	copy(state[stateHintBuf:stateHintBuf+4], hintBuf[:])
+	state[stateHintCount] = hintCount
+	state[stateCoverRx] = cursor
+
+	st.bytesUnmasked += uint64(outPos)
+	if st.decodeErrors != decodeErrors {
+		logEvent(LogLevelWarn, logDecodeError, uint32(id))
+	}
+	return outPos, ok
+}
@@ -0,0 +1,406 @@
+// Codec 表管理 - 默认表与按密钥派生的 keyed 表
+// 所有表存放于 arena 表区 (tableBase 起)，运行时构建，不占用 Wasm 数据段
+// 网格与 hint 位置组合同样在 initWasm 时计算，不依赖 data_generated.go
+// 槽位 0 为默认表 (种子 0x7375646F6B75，initCodecTablesWithKey 可替换)，initWasm 时构建；
+// 其余槽位按种子构建，相同种子的 session 共享同一槽位 (引用计数)，槽位空闲后原地重建
+
+package sudoku
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"unsafe"
+)
+
+// maxCodecTables 随内存布局定义于 layout_*.go
+const (
+	defaultCodecTable = 0
+	defaultCodecSeed  = 0x7375646F6B75
+)
+
+// layoutType 的 keyed 标志位: 置位时 session 使用由密钥派生的独立 codec 表
+const LayoutKeyed = 0x80
+
+// codecTable - 一组编码/解码表
+// 解码表为完美散列 (见 codec_chd.go): decodeEntries 槽位项为 压缩键<<8 | 字节值，disp 为各桶位移
+type codecTable struct {
+	encode        *[256][maxHintsPerByte][4]uint8
+	count         *[256]uint8
+	decodeEntries *[decodeTableSize]uint32
+	disp          *[chdBuckets]uint16
+	seed          uint64
+	salt          uint32 // 完美散列的桶散列 salt
+	refs          uint16
+	entries       uint32 // 已用槽位数 (负载率 = entries / decodeTableSize)
+	rows          uint16 // 已构建的编码表行数 (字节值 0..rows-1)，见 ensureCodecRows
+	decodeReady   bool   // 解码表已构建
+	stopHp        [256]uint16
+}
+
+var codecTables [maxCodecTables]codecTable
+
+// 槽位在表区中的布局: [decodeEntries][encode][count][disp]，按 4KB 对齐
+// decodeEntries 放在首位以保证 4 字节对齐
+const (
+	tableSlotDecodeEntries = 0
+	tableSlotEncode        = tableSlotDecodeEntries + decodeTableSize*4
+	tableSlotCount         = tableSlotEncode + 256*maxHintsPerByte*4
+	tableSlotDisp          = tableSlotCount + 256
+	tableSlotEnd           = tableSlotDisp + chdBuckets*2
+	tableSlotSize          = (tableSlotEnd + 0xFFF) &^ 0xFFF
+
+	tableRegionSize = maxCodecTables * tableSlotSize
+)
+
+// 编译期检查: 表区不得超出 arena
+const _ = uint(arenaSize - tableBase - tableRegionSize)
+
+// 4x4 网格与 hint 位置组合 (setupGrids 构建)
+var allGridsData [numGrids][16]uint8
+var hintPositionsData [numHintPositions][4]uint8
+var gridsReady bool
+
+// gridIndex - 倒排索引: gridIndex[pos][val-1] 为该位置取值 val 的网格集合 (288 位)
+// 一个 hint 组 (4 个位置及取值) 所匹配的网格集合即 4 个集合的交集
+const gridSetWords = (numGrids + 63) / 64
+
+type gridSet [gridSetWords]uint64
+
+var gridIndex [16][4]gridSet
+
+// setupGrids - 回溯枚举全部 288 个 4x4 数独网格，并按字典序列出 C(16,4) 个 hint 位置组合
+func setupGrids() {
+	if gridsReady {
+		return
+	}
+	var g [16]uint8
+	n := 0
+	gridBacktrack(&g, 0, &n)
+
+	idx := 0
+	for a := uint8(0); a < 13; a++ {
+		for b := a + 1; b < 14; b++ {
+			for c := b + 1; c < 15; c++ {
+				for d := c + 1; d < 16; d++ {
+					hintPositionsData[idx] = [4]uint8{a, b, c, d}
+					idx++
+				}
+			}
+		}
+	}
+
+	gridIndex = [16][4]gridSet{}
+	for g := 0; g < numGrids; g++ {
+		for pos := 0; pos < 16; pos++ {
+			gridIndex[pos][allGridsData[g][pos]-1][g/64] |= 1 << (g % 64)
+		}
+	}
+	gridsReady = true
+}
+
+func gridBacktrack(g *[16]uint8, idx int, n *int) {
+	if idx == 16 {
+		allGridsData[*n] = *g
+		*n++
+		return
+	}
+	row, col := idx/4, idx%4
+	br, bc := (row/2)*2, (col/2)*2
+	for num := uint8(1); num <= 4; num++ {
+		valid := true
+		for i := 0; i < 4 && valid; i++ {
+			if g[row*4+i] == num || g[i*4+col] == num {
+				valid = false
+			}
+		}
+		for r := 0; r < 2 && valid; r++ {
+			for c := 0; c < 2; c++ {
+				if g[(br+r)*4+(bc+c)] == num {
+					valid = false
+				}
+			}
+		}
+		if valid {
+			g[idx] = num
+			gridBacktrack(g, idx+1, n)
+			g[idx] = 0
+		}
+	}
+}
+
+// hintByte - 4x4 hint 字节: 0x40 | (value-1)<<4 | position，取值 0x40-0x7F
+// 值与位置同时编码，因此 unmask 无需知道发送端的排列顺序
+func hintByte(pos uint8, val uint8) uint8 {
+	return 0x40 | (val-1)<<4 | pos
+}
+
+// setupCodecTables - 将槽位映射到 arena 表区，并构建网格、输出字母表、伪装流量模板与默认表
+func setupCodecTables() {
+	setupGrids()
+	setupAlphabets()
+	setupCoverProfiles()
+	for i := 0; i < maxCodecTables; i++ {
+		base := uint32(tableBase + i*tableSlotSize)
+		codecTables[i] = codecTable{
+			encode:        (*[256][maxHintsPerByte][4]uint8)(unsafe.Pointer(&arena[base+tableSlotEncode])),
+			count:         (*[256]uint8)(unsafe.Pointer(&arena[base+tableSlotCount])),
+			decodeEntries: (*[decodeTableSize]uint32)(unsafe.Pointer(&arena[base+tableSlotDecodeEntries])),
+			disp:          (*[chdBuckets]uint16)(unsafe.Pointer(&arena[base+tableSlotDisp])),
+		}
+	}
+	resetCodecTable(&codecTables[defaultCodecTable], defaultCodecSeed)
+}
+
+// codecSeedFromKey - keyed 表种子: SHA-256(key) 前 8 字节 (大端序)
+func codecSeedFromKey(key []byte) uint64 {
+	var sum [sha256Size]byte
+	sha256Sum(&sum, key)
+	return binary.BigEndian.Uint64(sum[0:8])
+}
+
+// acquireCodecTable - 取得种子对应的槽位 (已存在则共享，否则构建)
+// 返回值: 槽位号, -1 表示槽位已满
+func acquireCodecTable(seed uint64) int32 {
+	if seed == codecTables[defaultCodecTable].seed {
+		return defaultCodecTable
+	}
+	free := int32(-1)
+	for i := int32(1); i < maxCodecTables; i++ {
+		t := &codecTables[i]
+		if t.refs > 0 && t.seed == seed {
+			t.refs++
+			return i
+		}
+		if t.refs == 0 && free < 0 {
+			free = i
+		}
+	}
+	if free < 0 {
+		return -1
+	}
+	t := &codecTables[free]
+	resetCodecTable(t, seed)
+	t.refs = 1
+	return free
+}
+
+// releaseCodecTable - 释放 session 对槽位的引用
+func releaseCodecTable(slot uint8) {
+	if slot == defaultCodecTable || slot >= maxCodecTables {
+		return
+	}
+	t := &codecTables[slot]
+	if t.refs > 0 {
+		t.refs--
+	}
+}
+
+// 编码表按字节值前缀惰性构建: 第 b 行依赖所有更早行的 stopHp，只能按 0..255 的顺序生成，
+// 因此 mask 遇到尚未构建的字节值时把行补到该值 (按 codecRowChunk 取整，减少重新洗牌网格的次数)；
+// 解码需要全部 hint 组，首次 unmask 时补齐全部行并构建完美散列
+// 最终结果与一次性构建逐字节一致，只是把耗时从 initWasm / initSession 推迟到首次使用
+const codecRowChunk = 32
+
+// resetCodecTable - 将槽位设为种子 seed 的未构建状态
+func resetCodecTable(t *codecTable, seed uint64) {
+	t.seed = seed
+	t.rows = 0
+	t.decodeReady = false
+	t.entries = 0
+}
+
+// buildCodecTable - 按种子立即构建完整的编码/解码表
+// 返回 false 表示完美散列构建失败
+func buildCodecTable(t *codecTable, seed uint64) bool {
+	resetCodecTable(t, seed)
+	return ensureCodecDecode(t)
+}
+
+// codecGridOrder - 以 LCG 打乱网格顺序，字节 b 对应网格 order[b]
+func codecGridOrder(seed uint64, order *[numGrids]uint16) {
+	rngState := uint32(seed ^ (seed >> 32))
+	for i := 0; i < numGrids; i++ {
+		order[i] = uint16(i)
+	}
+	for i := numGrids - 1; i > 0; i-- {
+		rngState = lcgNext(rngState)
+		j := rngState % uint32(i+1)
+		order[i], order[j] = order[j], order[i]
+	}
+}
+
+// ensureCodecRows - 保证编码表前 n 行已构建
+// 按 hint 位置组合顺序为每个字节收集 hint 组 (位置+值)，已被更早字节占用的组跳过
+//
+// hint 组 (hp, 网格 g 在 hp 上的取值) 已被占用 ⇔ 存在更早的字节 b'，其网格与 g 在 hp 上取值相同，
+// 且 b' 收集时走到过 hp (hp <= stopHp[b']，b' 在该位置要么占用了该组，要么发现它已被占用)
+// 因此占用检查只需对倒排索引求交集，再核对交集中更早字节的 stopHp，不需要已用键集合；
+// 续建时也只需 stopHp 与重新生成的网格顺序
+func ensureCodecRows(t *codecTable, n uint16) {
+	if t.rows >= n {
+		return
+	}
+	n = (n + codecRowChunk - 1) / codecRowChunk * codecRowChunk
+	if n > 256 {
+		n = 256
+	}
+
+	var gridOrder [numGrids]uint16
+	codecGridOrder(t.seed, &gridOrder)
+
+	// gridRank[g] - 使用网格 g 的字节值；earlier - 已收集完的字节所用网格的集合
+	var gridRank [numGrids]uint8
+	var earlier gridSet
+	for byteVal := 0; byteVal < 256; byteVal++ {
+		gridRank[gridOrder[byteVal]] = uint8(byteVal)
+	}
+	for byteVal := uint16(0); byteVal < t.rows; byteVal++ {
+		g := gridOrder[byteVal]
+		earlier[g/64] |= 1 << (g % 64)
+	}
+
+	for byteVal := t.rows; byteVal < n; byteVal++ {
+		g := gridOrder[byteVal]
+		grid := &allGridsData[g]
+		count := uint8(0)
+		hpIdx := 0
+		for ; hpIdx < numHintPositions && count < maxHintsPerByte; hpIdx++ {
+			hp := hintPositionsData[hpIdx]
+			if !hintGroupClaimed(hp, grid, &earlier, &gridRank, &t.stopHp, uint16(hpIdx)) {
+				var hints [4]uint8
+				for i := 0; i < 4; i++ {
+					hints[i] = hintByte(hp[i], grid[hp[i]])
+				}
+				t.encode[byteVal][count] = hints
+				count++
+			}
+		}
+		t.count[byteVal] = count
+		for ; count < maxHintsPerByte; count++ {
+			t.encode[byteVal][count] = [4]uint8{}
+		}
+		t.stopHp[byteVal] = uint16(hpIdx - 1)
+		earlier[g/64] |= 1 << (g % 64)
+	}
+	t.rows = n
+}
+
+// ensureCodecDecode - 保证编码表全部行与完美散列解码表已构建
+// 返回 false 表示完美散列构建失败 (下次调用会重试)
+func ensureCodecDecode(t *codecTable) bool {
+	if t.decodeReady {
+		return true
+	}
+	ensureCodecRows(t, 256)
+	t.decodeReady = buildDecodeCHD(t)
+	return t.decodeReady
+}
+
+// sessionCodecTable - session 当前使用的 codec 表
+func sessionCodecTable(state *[64]byte) *codecTable {
+	slot := state[stateCodecTable]
+	if slot >= maxCodecTables {
+		slot = defaultCodecTable
+	}
+	return &codecTables[slot]
+}
+
+const codecFingerprintSize = 16
+
+var codecFingerprintLabel = []byte("sudoku codec")
+
+// getCodecFingerprint - 将 session 当前编码/解码表的指纹写入 arena[outPtr:outPtr+16]，
+// 供两端比对以排查种子/布局不一致 (无需导出数百 KB 的表)
+// 指纹 = SHA-256("sudoku codec" || gridProfile || 编码行数/编码表 || 解码散列 salt (小端序) || 解码槽位 || 桶位移 ||
+// 输出字母表 (64) || padding 标记)[0:16]，表按内存布局 (小端序) 参与散列；4x4 表尚未构建时先完整构建
+// 返回值: 16
+//
+//	-1 session 无效
+//	-2 输出越界
+//	-3 解码表构建失败
+//
+//export getCodecFingerprint
+func getCodecFingerprint(id int32, outPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(outPtr, codecFingerprintSize) {
+		return -2
+	}
+	state := &getSession(id).sudokuState
+	profile := state[stateGridProfile]
+
+	var ctx sha256Context
+	sha256Init(&ctx)
+	sha256Update(&ctx, codecFingerprintLabel, len(codecFingerprintLabel))
+	sha256Update(&ctx, state[stateGridProfile:stateGridProfile+1], 1)
+	var salt [4]byte
+	if profile == GridProfile9x9 {
+		count := (*[256]byte)(unsafe.Pointer(&grid9EncodeCount))
+		encode := (*[256 * grid9MaxGroups * 4]byte)(unsafe.Pointer(&grid9EncodeTable))
+		entries := (*[grid9DecodeSize * 4]byte)(unsafe.Pointer(&grid9DecodeEntries))
+		disp := (*[grid9Buckets * 2]byte)(unsafe.Pointer(&grid9DecodeDisp))
+		binary.LittleEndian.PutUint32(salt[:], grid9DecodeSalt)
+		sha256Update(&ctx, count[:], len(count))
+		sha256Update(&ctx, encode[:], len(encode))
+		sha256Update(&ctx, salt[:], 4)
+		sha256Update(&ctx, entries[:], len(entries))
+		sha256Update(&ctx, disp[:], len(disp))
+	} else {
+		t := sessionCodecTable(state)
+		if !ensureCodecDecode(t) {
+			return -3
+		}
+		count := (*[256]byte)(unsafe.Pointer(t.count))
+		encode := (*[256 * maxHintsPerByte * 4]byte)(unsafe.Pointer(t.encode))
+		entries := (*[decodeTableSize * 4]byte)(unsafe.Pointer(t.decodeEntries))
+		disp := (*[chdBuckets * 2]byte)(unsafe.Pointer(t.disp))
+		binary.LittleEndian.PutUint32(salt[:], t.salt)
+		sha256Update(&ctx, count[:], len(count))
+		sha256Update(&ctx, encode[:], len(encode))
+		sha256Update(&ctx, salt[:], 4)
+		sha256Update(&ctx, entries[:], len(entries))
+		sha256Update(&ctx, disp[:], len(disp))
+	}
+	alpha := &alphabetEncode[sessionAlphabet(state)]
+	sha256Update(&ctx, alpha[:], len(alpha))
+	sha256Update(&ctx, state[statePadMarker:statePadMarker+1], 1)
+
+	var digest [sha256Size]byte
+	sha256Finalize(&ctx, &digest)
+	copy(arena[outPtr:outPtr+codecFingerprintSize], digest[:codecFingerprintSize])
+	return codecFingerprintSize
+}
+
+// hintGroupClaimed - 网格 grid 在位置组合 hp 上的 hint 组是否已被更早的字节占用 (见 buildCodecTable)
+func hintGroupClaimed(hp [4]uint8, grid *[16]uint8, earlier *gridSet, gridRank *[numGrids]uint8, stopHp *[256]uint16, hpIdx uint16) bool {
+	a := &gridIndex[hp[0]][grid[hp[0]]-1]
+	b := &gridIndex[hp[1]][grid[hp[1]]-1]
+	c := &gridIndex[hp[2]][grid[hp[2]]-1]
+	d := &gridIndex[hp[3]][grid[hp[3]]-1]
+	for w := 0; w < gridSetWords; w++ {
+		m := a[w] & b[w] & c[w] & d[w] & earlier[w]
+		for m != 0 {
+			g := w*64 + bits.TrailingZeros64(m)
+			if hpIdx <= stopHp[gridRank[g]] {
+				return true
+			}
+			m &= m - 1
+		}
+	}
+	return false
+}
+
+// decodeTableLookupIn - 查找键: 固定读取桶位移与一个槽位
+func decodeTableLookupIn(t *codecTable, key uint32) (uint8, bool) {
+	k24 := hintKey24(key)
+	if k24 == 0 {
+		return 0, false
+	}
+	h := chdMix(key ^ t.salt)
+	e := t.decodeEntries[chdSlot(h, t.disp[h>>(32-chdBucketBits)], decodeTableBits)]
+	if e>>8 != k24 {
+		return 0, false
+	}
+	return uint8(e), true
+}
@@ -0,0 +1,58 @@
+// 密钥承诺 - AES-GCM 与 ChaCha20-Poly1305 不承诺密钥: 可以构造一条在多个密钥下都能通过认证的密文，
+// 攻击者据此以少量探测把 server 的候选 psk (PSK 模式下由 identity hint 挑选) 逐步二分 (partitioning oracle)
+// 开启 SessionOptKeyCommit 后每条 AEAD 帧在 tag 之后追加 32 字节承诺标签:
+//   HMAC-SHA256(方向密钥, "sudoku key commit" || nonce || AEAD tag || AD)
+// 接收端先校验承诺标签再做 AEAD 解密，不符时按认证失败处理 (errAuth)；HMAC 对密钥抗碰撞，同一帧只有一个密钥能通过
+// 对记录层 (sealAndMask/maskFrame 等)、aeadEncrypt/aeadDecrypt 系列与显式 nonce 的 aeadEncryptWithNonce/aeadDecryptWithNonce 生效，
+// 数据报模式下 nonce 为还原后的完整 nonce；0-RTT 早期数据不附加承诺标签
+// 双方须在收发数据前同时开启，CipherNone 不可开启
+
+package sudoku
+
+const commitTagSize = 32
+
+var keyCommitLabel = []byte("sudoku key commit")
+
+// commitOverhead - 承诺标签占用的字节数，未开启时为 0
+func commitOverhead(session *SudokuInstance) uint32 {
+	if session.flags&sessionFlagKeyCommit == 0 {
+		return 0
+	}
+	return commitTagSize
+}
+
+// commitTag - 计算 nonce 与 AEAD tag (arena[tagPtr:tagPtr+tagLen]) 的承诺标签
+func commitTag(session *SudokuInstance, key *[32]byte, nonce []byte, tagPtr uint32, tagLen uint32, ad []byte, out *[sha256Size]byte) {
+	var ctx hmacContext
+	hmacInit(&ctx, key[:cipherKeyLen(session.cipherType)])
+	hmacUpdate(&ctx, keyCommitLabel, len(keyCommitLabel))
+	hmacUpdate(&ctx, nonce, len(nonce))
+	hmacUpdate(&ctx, arena[tagPtr:tagPtr+tagLen], int(tagLen))
+	hmacUpdate(&ctx, ad, len(ad))
+	hmacFinalize(&ctx, out)
+}
+
+// commitAppend - 封装成功后在 arena[tagPtr+tagLen:] 写入承诺标签，返回追加的字节数
+func commitAppend(session *SudokuInstance, nonce []byte, tagPtr uint32, tagLen uint32, ad []byte) uint32 {
+	if session.flags&sessionFlagKeyCommit == 0 {
+		return 0
+	}
+	var sum [sha256Size]byte
+	commitTag(session, &session.key, nonce, tagPtr, tagLen, ad, &sum)
+	copy(arena[tagPtr+tagLen:tagPtr+tagLen+commitTagSize], sum[:])
+	return commitTagSize
+}
+
+// commitCheck - 常量时间校验紧随 AEAD tag 之后的承诺标签 (未开启时恒为 true)
+func commitCheck(session *SudokuInstance, nonce []byte, tagPtr uint32, tagLen uint32, ad []byte) bool {
+	if session.flags&sessionFlagKeyCommit == 0 {
+		return true
+	}
+	var sum [sha256Size]byte
+	commitTag(session, sessionRxKeyOf(session), nonce, tagPtr, tagLen, ad, &sum)
+	var diff uint8
+	for i := uint32(0); i < commitTagSize; i++ {
+		diff |= arena[tagPtr+tagLen+i] ^ sum[i]
+	}
+	return diff == 0
+}
@@ -0,0 +1,56 @@
+package sudoku
+
+import "testing"
+
+// 显式 nonce 接口在开启密钥承诺时附加并校验承诺标签
+func TestKeyCommitWithNonce(t *testing.T) {
+	plain := []byte("datagram payload")
+	tests := []struct {
+		name      string
+		txCommit  bool
+		rxCommit  bool
+		corrupt   int // 翻转输出中该偏移 (从末尾起) 的字节，0 表示不修改
+		wantLen   uint32
+		wantPlain bool
+	}{
+		{"both on", true, true, 0, uint32(len(plain)) + 16 + commitTagSize, true},
+		{"both off", false, false, 0, uint32(len(plain)) + 16, true},
+		{"commit tag flipped", true, true, 1, uint32(len(plain)) + 16 + commitTagSize, false},
+		{"aead tag flipped", true, true, commitTagSize + 1, uint32(len(plain)) + 16 + commitTagSize, false},
+		{"receiver requires commit", false, true, 0, uint32(len(plain)) + 16, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, rx := testPair(t, CipherChaCha20Poly)
+			if tt.txCommit {
+				setSessionOption(tx, SessionOptKeyCommit, 1)
+			}
+			if tt.rxCommit {
+				setSessionOption(rx, SessionOptKeyCommit, 1)
+			}
+			base := uint32(workBufBase)
+			noncePtr, ptPtr, ctPtr, outPtr := base, base+64, base+256, base+512
+			for i := uint32(0); i < 12; i++ {
+				arena[noncePtr+i] = byte(i)
+			}
+			copy(arena[ptPtr:], plain)
+			n := aeadEncryptWithNonce(tx, noncePtr, ptPtr, uint32(len(plain)), ctPtr, base, 0)
+			if n != tt.wantLen {
+				t.Fatalf("aeadEncryptWithNonce = %d, want %d", n, tt.wantLen)
+			}
+			if tt.corrupt != 0 {
+				arena[ctPtr+n-uint32(tt.corrupt)] ^= 1
+			}
+			got := aeadDecryptWithNonce(rx, noncePtr, ctPtr, n, outPtr, base, 0)
+			if tt.wantPlain {
+				if got != uint32(len(plain)) || string(arena[outPtr:outPtr+got]) != string(plain) {
+					t.Fatalf("aeadDecryptWithNonce = %d (%d)", got, lastError)
+				}
+				return
+			}
+			if got != 0 {
+				t.Fatalf("aeadDecryptWithNonce = %d, want failure", got)
+			}
+		})
+	}
+}
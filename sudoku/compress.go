@@ -0,0 +1,300 @@
+// 压缩 - sealAndMask / maskFrame 在加密前压缩明文 (compress → seal → mask)，
+// unmaskAndOpen / unmaskFrame 在解密后解压；由 session 选项 SessionOptCompress 开启，双方须一致
+// 明文载荷格式: [method (1)][原始长度 (4，大端序)][数据]
+//   method 0: 数据为原样存储 (输入过短或压缩后不更小)
+//   method 1: LZ4 风格的块，固定哈希表、无堆分配:
+//     序列 = [token][字面长度扩展][字面字节][offset (2，小端序)][匹配长度扩展]
+//     token 高 4 位为字面长度、低 4 位为 匹配长度-4，取 15 时后接扩展字节 (255 累加，遇到 <255 结束)
+//     offset 为 0 的序列是块结束标记 (低 4 位必须为 0)，因此块自带边界，多条记录的明文可连续解压
+// 解压后长度受 session 输出区容量与 SessionOptDecompressLimit 限制，超出即失败 (errDecompress)
+
+package sudoku
+
+import "encoding/binary"
+
+const (
+	compressHeaderSize = 5
+	compressMinInput   = 32 // 短于此长度直接存储
+	compressMinMatch   = 4
+	compressMaxOffset  = 0xFFFF
+	compressLastLits   = 5 // 块末尾至少留作字面字节的长度 (保证最后一个匹配之后仍有 4 字节可读)
+	compressHashBits   = 12
+	compressHashSize   = 1 << compressHashBits
+
+	compressMethodStored = 0
+	compressMethodLZ     = 1
+)
+
+// 匹配查找哈希表: 输入位置 + 1 (0 表示空)
+var compressHash [compressHashSize]uint32
+
+func compressHashOf(v uint32) uint32 {
+	return (v * 2654435761) >> (32 - compressHashBits)
+}
+
+// compressPayload - 将 arena[inPtr:inPtr+inLen] 编码为明文载荷写入 dst (容量至少 inLen+compressHeaderSize)
+// 输入与 dst 不得重叠
+// 返回值: 载荷长度
+func compressPayload(inPtr uint32, inLen uint32, dst uint32) uint32 {
+	binary.BigEndian.PutUint32(arena[dst+1:dst+compressHeaderSize], inLen)
+	if inLen >= compressMinInput {
+		if n, ok := lzCompressBlock(inPtr, inLen, dst+compressHeaderSize, inLen); ok {
+			arena[dst] = compressMethodLZ
+			return compressHeaderSize + n
+		}
+	}
+	arena[dst] = compressMethodStored
+	copy(arena[dst+compressHeaderSize:dst+compressHeaderSize+inLen], arena[inPtr:inPtr+inLen])
+	return compressHeaderSize + inLen
+}
+
+// lzPutLen - 写出长度扩展字节
+func lzPutLen(dst uint32, pos uint32, limit uint32, n uint32) (uint32, bool) {
+	for n >= 255 {
+		if pos >= limit {
+			return pos, false
+		}
+		arena[dst+pos] = 255
+		pos++
+		n -= 255
+	}
+	if pos >= limit {
+		return pos, false
+	}
+	arena[dst+pos] = uint8(n)
+	return pos + 1, true
+}
+
+// lzEmit - 写出一个序列 (offset 为 0 时为结束序列)
+func lzEmit(src uint32, litStart uint32, litLen uint32, offset uint32, matchLen uint32, dst uint32, pos uint32, limit uint32) (uint32, bool) {
+	if pos >= limit {
+		return pos, false
+	}
+	tokenPos := pos
+	pos++
+	token := uint8(0)
+	ok := true
+	if litLen >= 15 {
+		token = 15 << 4
+		if pos, ok = lzPutLen(dst, pos, limit, litLen-15); !ok {
+			return pos, false
+		}
+	} else {
+		token = uint8(litLen) << 4
+	}
+	if litLen > limit-pos {
+		return pos, false
+	}
+	copy(arena[dst+pos:dst+pos+litLen], arena[src+litStart:src+litStart+litLen])
+	pos += litLen
+
+	if limit-pos < 2 {
+		return pos, false
+	}
+	binary.LittleEndian.PutUint16(arena[dst+pos:dst+pos+2], uint16(offset))
+	pos += 2
+	if offset != 0 {
+		m := matchLen - compressMinMatch
+		if m >= 15 {
+			token |= 15
+			if pos, ok = lzPutLen(dst, pos, limit, m-15); !ok {
+				return pos, false
+			}
+		} else {
+			token |= uint8(m)
+		}
+	}
+	arena[dst+tokenPos] = token
+	return pos, true
+}
+
+// lzCompressBlock - 贪心 LZ 压缩，输出不超过 limit 字节
+// 返回值: (块长度, 是否成功)；输出达到 limit 时失败 (调用方改为存储)
+func lzCompressBlock(src uint32, srcLen uint32, dst uint32, limit uint32) (uint32, bool) {
+	for i := range compressHash {
+		compressHash[i] = 0
+	}
+	pos := uint32(0)
+	litStart := uint32(0)
+	i := uint32(0)
+	ok := true
+	matchLimit := srcLen - compressLastLits
+	for i+compressMinMatch <= matchLimit {
+		v := binary.LittleEndian.Uint32(arena[src+i : src+i+4])
+		h := compressHashOf(v)
+		cand := compressHash[h]
+		compressHash[h] = i + 1
+		if cand == 0 || i-(cand-1) > compressMaxOffset ||
+			binary.LittleEndian.Uint32(arena[src+cand-1:src+cand+3]) != v {
+			i++
+			continue
+		}
+		ref := cand - 1
+		n := uint32(compressMinMatch)
+		for i+n < matchLimit && arena[src+ref+n] == arena[src+i+n] {
+			n++
+		}
+		if pos, ok = lzEmit(src, litStart, i-litStart, i-ref, n, dst, pos, limit); !ok {
+			return 0, false
+		}
+		i += n
+		litStart = i
+	}
+	if pos, ok = lzEmit(src, litStart, srcLen-litStart, 0, 0, dst, pos, limit); !ok || pos >= limit {
+		return 0, false
+	}
+	return pos, true
+}
+
+// lzGetLen - 读取长度扩展字节
+func lzGetLen(r uint32, end uint32) (uint32, uint32, bool) {
+	n := uint32(0)
+	for r < end {
+		b := arena[r]
+		r++
+		n += uint32(b)
+		if b != 255 {
+			return n, r, true
+		}
+	}
+	return n, r, false
+}
+
+// lzDecompressBlock - 解压 [r, end) 处以结束序列终止的块，写入 w 起的 rawLen 字节
+// 解压在同一区域内进行 (输入位于输出前方): 字面字节与读位置同步前进，
+// 匹配写出前要求不越过读位置，保证尚未读取的输入不会被覆盖
+// 返回值: (块结束后的读位置, 是否成功)
+func lzDecompressBlock(r uint32, end uint32, w uint32, rawLen uint32) (uint32, bool) {
+	wEnd := w + rawLen
+	ok := true
+	for {
+		if r >= end {
+			return r, false
+		}
+		token := arena[r]
+		r++
+
+		lit := uint32(token >> 4)
+		if lit == 15 {
+			var ext uint32
+			if ext, r, ok = lzGetLen(r, end); !ok {
+				return r, false
+			}
+			lit += ext
+		}
+		if lit > end-r || lit > wEnd-w || w > r {
+			return r, false
+		}
+		copy(arena[w:w+lit], arena[r:r+lit])
+		w += lit
+		r += lit
+
+		if end-r < 2 {
+			return r, false
+		}
+		offset := uint32(binary.LittleEndian.Uint16(arena[r : r+2]))
+		r += 2
+		if offset == 0 {
+			// 结束序列: 输出必须恰好为原始长度
+			return r, token&0x0F == 0 && w == wEnd
+		}
+
+		m := uint32(token & 0x0F)
+		if m == 15 {
+			var ext uint32
+			if ext, r, ok = lzGetLen(r, end); !ok {
+				return r, false
+			}
+			m += ext
+		}
+		m += compressMinMatch
+		if offset > w-(wEnd-rawLen) || m > wEnd-w || w+m > r {
+			return r, false
+		}
+		// 重叠匹配需按字节顺序复制
+		for k := uint32(0); k < m; k++ {
+			arena[w+k] = arena[w-offset+k]
+		}
+		w += m
+	}
+}
+
+// decompressPayloads - 解压 [out, out+total) 处连续的明文载荷 (每条记录一个)，结果从 out 起紧密排列
+// 载荷先整体移到输出区末尾，再依次向前解压
+// 返回值: (解压后总长度, 错误码)
+func decompressPayloads(id int32, out uint32, outCap uint32, total uint32) (uint32, int32) {
+	limit := outCap
+	if max := sessionDecompressLimit(id); max != 0 && max < limit {
+		limit = max
+	}
+	r := out + outCap - total
+	copy(arena[r:out+outCap], arena[out:out+total])
+	end := out + outCap
+
+	w := out
+	for r < end {
+		if end-r < compressHeaderSize {
+			return 0, errDecompress
+		}
+		method := arena[r]
+		rawLen := binary.BigEndian.Uint32(arena[r+1 : r+compressHeaderSize])
+		r += compressHeaderSize
+		if rawLen > limit-(w-out) {
+			return 0, errDecompress
+		}
+		switch method {
+		case compressMethodStored:
+			if rawLen > end-r {
+				return 0, errDecompress
+			}
+			copy(arena[w:w+rawLen], arena[r:r+rawLen])
+			r += rawLen
+		case compressMethodLZ:
+			var ok bool
+			if r, ok = lzDecompressBlock(r, end, w, rawLen); !ok {
+				return 0, errDecompress
+			}
+		default:
+			return 0, errDecompress
+		}
+		w += rawLen
+	}
+	return w - out, errOK
+}
+
+// sessionDecompressLimit - SessionOptDecompressLimit (0 表示仅受输出区容量限制)
+func sessionDecompressLimit(id int32) uint32 {
+	state := &getSession(id).sudokuState
+	return binary.BigEndian.Uint32(state[stateDecompressMax : stateDecompressMax+4])
+}
+
+// sealPlainMax - 加密前明文的最大长度 (开启压缩时含载荷头)，用于预留暂存区
+func sealPlainMax(id int32, inLen uint32) uint32 {
+	if getSession(id).flags&sessionFlagCompress != 0 {
+		return inLen + compressHeaderSize
+	}
+	return inLen
+}
+
+// compressForSeal - 开启压缩时将输入编码为明文载荷，暂存于 dst (session 输出区起始处，
+// 加密读取完毕后才会被 mask 输出覆盖)；未开启时原样返回输入
+// 返回值: (明文指针, 明文长度)
+func compressForSeal(id int32, inPtr uint32, inLen uint32, dst uint32) (uint32, uint32) {
+	if getSession(id).flags&sessionFlagCompress == 0 {
+		return inPtr, inLen
+	}
+	return dst, compressPayload(inPtr, inLen, dst)
+}
+
+// decompressOpened - 开启压缩时解压已解密的明文 [out, out+plainLen)
+// 返回值: (输出长度, 错误码)
+func decompressOpened(id int32, out uint32, outCap uint32, plainLen uint32) (uint32, int32) {
+	if getSession(id).flags&sessionFlagCompress == 0 {
+		return plainLen, errOK
+	}
+	n, status := decompressPayloads(id, out, outCap, plainLen)
+	if status != errOK {
+		sessionStats[id].decodeErrors++
+	}
+	return n, status
+}
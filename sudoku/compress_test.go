@@ -0,0 +1,115 @@
+package sudoku
+
+import (
+	"bytes"
+	"testing"
+)
+
+// compressTestRandom - 不可压缩的伪随机字节 (xorshift32)
+func compressTestRandom(n int, seed uint32) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		seed ^= seed << 13
+		seed ^= seed >> 17
+		seed ^= seed << 5
+		b[i] = byte(seed)
+	}
+	return b
+}
+
+// 压缩载荷的 method 选择与 compress → seal → mask → unmask → open → decompress 往返
+func TestCompressRoundTrip(t *testing.T) {
+	rnd := compressTestRandom(300, 1)
+	tests := []struct {
+		name       string
+		in         []byte
+		wantMethod byte
+	}{
+		{"short", []byte("hello"), compressMethodStored},
+		{"text", bytes.Repeat([]byte("the quick brown fox "), 100), compressMethodLZ},
+		{"long match", make([]byte, 3000), compressMethodLZ},
+		{"long literals", append(append([]byte(nil), rnd...), rnd...), compressMethodLZ},
+		{"random", compressTestRandom(600, 7), compressMethodStored},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, rx := testPair(t, CipherChaCha20Poly)
+			for _, id := range []int32{tx, rx} {
+				if r := setSessionOption(id, SessionOptCompress, 1); r != 0 {
+					t.Fatalf("setSessionOption: %d", r)
+				}
+			}
+
+			copy(arena[workBufBase:], tt.in)
+			dst := uint32(workBufBase + 0x4000)
+			n := compressPayload(workBufBase, uint32(len(tt.in)), dst)
+			if m := arena[dst]; m != tt.wantMethod {
+				t.Fatalf("method = %d, want %d", m, tt.wantMethod)
+			}
+			if tt.wantMethod == compressMethodLZ && n >= uint32(len(tt.in)) {
+				t.Fatalf("compressed %d bytes to %d", len(tt.in), n)
+			}
+			if tt.wantMethod == compressMethodStored && n != uint32(len(tt.in))+compressHeaderSize {
+				t.Fatalf("stored payload = %d bytes", n)
+			}
+
+			for _, fn := range []struct {
+				name       string
+				seal, open func(int32, uint32, uint32) uint32
+			}{{"sealAndMask", sealAndMask, unmaskAndOpen}, {"maskFrame", maskFrame, unmaskFrame}} {
+				f, st := testCall(tx, tt.in, fn.seal)
+				if st != errOK {
+					t.Fatalf("%s: %d", fn.name, st)
+				}
+				if got, st := testCall(rx, f, fn.open); st != errOK || !bytes.Equal(got, tt.in) {
+					t.Fatalf("%s round trip: %d bytes (%d)", fn.name, len(got), st)
+				}
+			}
+		})
+	}
+}
+
+// 解压上限与非法载荷: 超出 SessionOptDecompressLimit 或格式非法时失败 (errDecompress)
+func TestDecompressLimit(t *testing.T) {
+	in := bytes.Repeat([]byte("abcd"), 500)
+	tests := []struct {
+		name  string
+		limit uint32
+		want  int32
+	}{
+		{"no limit", 0, errOK},
+		{"exact", uint32(len(in)), errOK},
+		{"one short", uint32(len(in)) - 1, errDecompress},
+		{"tiny", 16, errDecompress},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, rx := testPair(t, CipherChaCha20Poly)
+			setSessionOption(tx, SessionOptCompress, 1)
+			setSessionOption(rx, SessionOptCompress, 1)
+			setSessionOption(rx, SessionOptDecompressLimit, tt.limit)
+			f, _ := testCall(tx, in, sealAndMask)
+			got, st := testCall(rx, f, unmaskAndOpen)
+			if st != tt.want {
+				t.Fatalf("unmaskAndOpen: %d, want %d", st, tt.want)
+			}
+			if st == errOK && !bytes.Equal(got, in) {
+				t.Fatal("output mismatch")
+			}
+		})
+	}
+
+	id := testSession(t, CipherChaCha20Poly, RoleShared)
+	base := uint32(workBufBase)
+	for _, bad := range [][]byte{
+		{9, 0, 0, 0, 1, 'x'},                    // 未知 method
+		{compressMethodStored, 0, 0, 0, 9, 'x'}, // 原始长度超过数据
+		{compressMethodLZ, 0, 0, 0, 4, 0x40},    // 块未结束
+		{compressMethodLZ, 0, 0},                // 载荷头不完整
+	} {
+		copy(arena[base:], bad)
+		if _, st := decompressPayloads(id, base, 256, uint32(len(bad))); st != errDecompress {
+			t.Fatalf("decompressPayloads(% x) = %d, want %d", bad, st, errDecompress)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+// 控制记录 - 与数据记录一样加密并 mask，由模块生成和消化，不交给宿主
+// 记录格式: [0x0000 (2)][载荷长度 (2, 大端序)][AEAD 帧 (明文为 [类型 (1)][正文])]
+// 数据记录的长度字段不会为 0，接收端据此区分；类型位于密文内，线路上与数据记录只差前缀
+// 控制记录与数据记录共用 nonce counter 与抗重放窗口，也计入密钥轮换的帧数/字节数
+//
+// 控制消息 (controlMessage) 的正文对模块不透明 (如密钥轮换请求、padding 策略变更、MTU 更新，格式由双方的控制面约定)，
+// 接收端放入模块级收件箱，宿主通过 getControlMessage 逐条取出
+
+package sudoku
+
+import "encoding/binary"
+
+const (
+	controlHeaderSize = frameHeaderSize + 2
+
+	controlKeepalive = 1 // 心跳: 正文为随机长度的填充，接收端丢弃
+	controlClose     = 2 // 关闭通知: 正文为 2 字节原因码 (大端序)，见 close.go
+	controlMessage   = 3 // 控制消息: 正文交给宿主
+	controlStream    = 4 // 多路复用 stream 记录，见 mux.go
+	// 5、6 为 FEC 数据/校验记录，见 fec.go
+)
+
+// 控制消息收件箱: 所有 session 共用 controlInboxSize 条，每个 session 最多 controlMaxPending 条未取
+// 超出时丢弃最旧的一条 (优先丢弃同一 session 的)，丢弃总数见 getControlDropped
+const (
+	controlMsgMax     = 256
+	controlInboxSize  = 32
+	controlMaxPending = 8
+)
+
+type controlEntry struct {
+	owner int32 // session ID + 1，0 表示空闲
+	seq   uint32
+	n     uint16
+	data  [controlMsgMax]byte
+}
+
+var (
+	controlInbox   [controlInboxSize]controlEntry
+	controlSeq     uint32
+	controlDropped uint32
+)
+
+// 心跳正文长度范围 (字节)
+const (
+	keepaliveMinPad = 8
+	keepaliveMaxPad = 71
+)
+
+// buildKeepalive - 生成一条心跳记录并 mask，输出格式与 maskFrame 相同 (整形/MTU 设置同样生效)
+// 正文长度在 keepaliveMinPad..keepaliveMaxPad 内随机选取 (截断到单条记录的容量，如 MTU)，
+// 对端 unmaskFrame 自动丢弃，不产生输出
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export buildKeepalive
+func buildKeepalive(id int32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	rng := maskRngLoad(id)
+	rngAdvance(&rng)
+	maskRngStore(&getSession(id).sudokuState, &rng)
+	padLen := keepaliveMinPad + rng.x%(keepaliveMaxPad-keepaliveMinPad+1)
+	if room := controlBodyCap(id); padLen > room {
+		padLen = room
+	}
+
+	out, _ := sessionOut(id)
+	for i := uint32(0); i < padLen; i++ {
+		arena[out+1+i] = 0
+	}
+	return maskControlRecord(id, controlKeepalive, padLen)
+}
+
+// controlBodyCap - maskControlRecord 能接受的最大正文长度 (载荷上限、输出区容量与 MTU 中最小者)
+func controlBodyCap(id int32) uint32 {
+	session := getSession(id)
+	fixed := controlHeaderSize + 1 + aeadOverhead(session)
+	recordMax := uint32(maskMaxChunk)
+	if sessionOutPtr[id] != 0 {
+		// 与 maskControlRecord 的绑定输出区检查一致
+		if sessionOutCap[id] < 32 {
+			return 0
+		}
+		recordMax = (sessionOutCap[id] - 32) / 7
+	}
+	if mtu := sessionMaxFrame[id]; mtu != 0 {
+		if m := (mtu - 1) / maskPerByte(&session.sudokuState); m < recordMax {
+			recordMax = m
+		}
+	}
+	if recordMax <= fixed {
+		return 0
+	}
+	body := frameMaxPayload - 1 - aeadOverhead(session)
+	if recordMax-fixed < body {
+		body = recordMax - fixed
+	}
+	return body
+}
+
+// maskControlRecord - 正文已写入 session 输出区 out+1 起的 bodyLen 字节，封装为 ctype 控制记录后 mask
+// 明文 [类型][正文] 暂存于输出区起始处，加密读取完毕后才被 mask 输出覆盖
+func maskControlRecord(id int32, ctype uint8, bodyLen uint32) uint32 {
+	session := getSession(id)
+	payloadLen := 1 + bodyLen + aeadOverhead(session)
+	recordLen := controlHeaderSize + payloadLen
+	if payloadLen > frameMaxPayload {
+		return fail(errInputTooLarge)
+	}
+	if mtu := sessionMaxFrame[id]; mtu != 0 && recordLen*maskPerByte(&session.sudokuState)+1 > mtu {
+		return fail(errInputTooLarge)
+	}
+	out, outCap := sessionOut(id)
+	scratch := uint32(aeadScratchBase)
+	if sessionOutPtr[id] != 0 {
+		// 同 maskFrame: [mask 输出 | 记录暂存 (recordLen)]
+		if recordLen*7+32 > outCap {
+			return fail(errOutputTooSmall)
+		}
+		outCap -= recordLen
+		scratch = out + outCap
+	} else if recordLen > maskMaxChunk {
+		return fail(errInputTooLarge)
+	}
+
+	arena[out] = ctype
+	binary.BigEndian.PutUint16(arena[scratch:scratch+frameHeaderSize], 0)
+	binary.BigEndian.PutUint16(arena[scratch+frameHeaderSize:scratch+controlHeaderSize], uint16(payloadLen))
+	if recordSeal(session, out, 1+bodyLen, scratch+controlHeaderSize) != payloadLen {
+		return fail(errCipher)
+	}
+	sessionStats[id].framesSealed++
+	traceSealed(id, 1+bodyLen)
+	rekeyAfterSeal(id, 1+bodyLen)
+	return maskRecordOut(id, scratch, recordLen, out, outCap)
+}
+
+// openControlRecord - 解开并处理 ptr 处的控制记录 (已跳过长度 0 前缀，最多 avail 字节)，明文暂写到 plainOut
+// FEC 记录的数据 (收到的或恢复的) 留在 plainOut 处
+// 返回值: (消费的字节数, 输出的数据长度, 错误码)，关闭记录返回 errClosed (其后的输入不再处理)
+func openControlRecord(id int32, ptr uint32, avail uint32, plainOut uint32) (uint32, uint32, int32) {
+	n, plainLen, status := openControlPlain(id, ptr, avail, plainOut)
+	if status != errOK {
+		return 0, 0, status
+	}
+	if t := arena[plainOut]; t == controlFECData || t == controlFECParity {
+		dataLen, status := fecReceive(id, plainOut, plainLen)
+		return n, dataLen, status
+	}
+	return n, 0, dispatchControl(id, plainOut, plainLen)
+}
+
+// openControlPlain - 只解密控制记录，明文 [类型][正文] 写到 plainOut
+// 返回值: (消费的字节数, 明文长度, 错误码)
+func openControlPlain(id int32, ptr uint32, avail uint32, plainOut uint32) (uint32, uint32, int32) {
+	if avail < controlHeaderSize-frameHeaderSize {
+		sessionStats[id].decodeErrors++
+		return 0, 0, errBadFrame
+	}
+	payloadLen := uint32(binary.BigEndian.Uint16(arena[ptr : ptr+2]))
+	if payloadLen <= aeadOverhead(getSession(id)) || payloadLen > avail-2 {
+		sessionStats[id].decodeErrors++
+		return 0, 0, errBadFrame
+	}
+	plainLen, status := openChecked(id, ptr+2, payloadLen, plainOut)
+	if status != errOK {
+		return 0, 0, status
+	}
+	return 2 + payloadLen, plainLen, errOK
+}
+
+// dispatchControl - 处理已解密的控制记录明文
+// stream 记录 (见 mux.go) 只能由 streamRecv 处理，在其他路径上视为格式错误
+func dispatchControl(id int32, plainOut uint32, plainLen uint32) int32 {
+	switch arena[plainOut] {
+	case controlKeepalive:
+	case controlMessage:
+		if plainLen-1 > controlMsgMax {
+			sessionStats[id].decodeErrors++
+			return errBadFrame
+		}
+		controlPush(id, plainOut+1, plainLen-1)
+	case controlClose:
+		if plainLen != 1+closeReasonSize {
+			sessionStats[id].decodeErrors++
+			return errBadFrame
+		}
+		peerClosed(id, binary.BigEndian.Uint16(arena[plainOut+1:plainOut+1+closeReasonSize]))
+		return errClosed
+	default:
+		sessionStats[id].decodeErrors++
+		return errBadFrame
+	}
+	return errOK
+}
+
+// buildControlMessage - 将 msgLen 字节 (1..controlMsgMax) 封装为控制消息记录并 mask，输出格式同 maskFrame
+// 对端 unmaskFrame 不输出该消息，宿主通过 getControlMessage 取出
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export buildControlMessage
+func buildControlMessage(id int32, msgPtr uint32, msgLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(msgPtr, msgLen) {
+		return fail(errOutOfBounds)
+	}
+	if msgLen == 0 {
+		return fail(errEmptyInput)
+	}
+	if msgLen > controlMsgMax {
+		return fail(errInputTooLarge)
+	}
+	out, _ := sessionOut(id)
+	copy(arena[out+1:out+1+msgLen], arena[msgPtr:msgPtr+msgLen])
+	return maskControlRecord(id, controlMessage, msgLen)
+}
+
+// controlPush - 将 session id 收到的控制消息放入收件箱
+func controlPush(id int32, ptr uint32, n uint32) {
+	free, oldest, oldestOwn := -1, -1, -1
+	pending := 0
+	for i := range controlInbox {
+		e := &controlInbox[i]
+		if e.owner == 0 {
+			if free < 0 {
+				free = i
+			}
+			continue
+		}
+		if oldest < 0 || e.seq-controlInbox[oldest].seq > 1<<31 {
+			oldest = i
+		}
+		if e.owner == id+1 {
+			pending++
+			if oldestOwn < 0 || e.seq-controlInbox[oldestOwn].seq > 1<<31 {
+				oldestOwn = i
+			}
+		}
+	}
+	slot := free
+	if pending >= controlMaxPending {
+		slot = oldestOwn
+		controlDropped++
+	} else if slot < 0 {
+		slot = oldest
+		controlDropped++
+	}
+	e := &controlInbox[slot]
+	e.owner = id + 1
+	e.seq = controlSeq
+	controlSeq++
+	e.n = uint16(n)
+	copy(e.data[:n], arena[ptr:ptr+n])
+}
+
+// controlPurge - 清除 session id 未取的控制消息 (session 关闭或重新分配时)
+func controlPurge(id int32) {
+	for i := range controlInbox {
+		if controlInbox[i].owner == id+1 {
+			controlInbox[i] = controlEntry{}
+		}
+	}
+}
+
+// getControlMessage - 取出 session 最早的一条控制消息，写入 arena[outPtr:outPtr+outCap]
+// 宿主应在每次 unmaskFrame 后取完，收件箱满时旧消息会被丢弃
+// 返回值: 消息长度 (>0), 0 没有待取的消息, -1 session 无效, -2 输出越界, -3 outCap 小于消息长度 (消息保留)
+//
+//export getControlMessage
+func getControlMessage(id int32, outPtr uint32, outCap uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(outPtr, outCap) {
+		return -2
+	}
+	first := -1
+	for i := range controlInbox {
+		e := &controlInbox[i]
+		if e.owner == id+1 && (first < 0 || e.seq-controlInbox[first].seq > 1<<31) {
+			first = i
+		}
+	}
+	if first < 0 {
+		return 0
+	}
+	e := &controlInbox[first]
+	n := uint32(e.n)
+	if n > outCap {
+		return -3
+	}
+	copy(arena[outPtr:outPtr+n], e.data[:n])
+	*e = controlEntry{}
+	return int32(n)
+}
+
+// getControlDropped - 收件箱满而被丢弃的控制消息总数 (自 initWasm 起，所有 session)
+//
+//export getControlDropped
+func getControlDropped() uint32 {
+	return controlDropped
+}
@@ -0,0 +1,201 @@
+// 伪装流量 profile - mask 输出嵌入固定模板，使线路数据形似 JSON / HTTP chunked 等常见流量
+// 模板为循环使用的字节序列，其中 coverSlot (0x00) 为槽位，其余为字面字节:
+//   发送端每输出一个 mask 字节 (hint 或 padding)，先输出游标到下一个槽位之间的字面字节，再将该字节填入槽位
+//   接收端按相同游标逐字节剥离字面字节，只把槽位字节交给 hint 解码
+// 字面字节按位置而非字节取值剥离，因此可以与 hint 字母表重叠 (如 JSON 的键名)；
+// 与 padding 一样计入 padBytesEmitted，开启后不再输出流末尾 padding。
+// 模板游标按方向保存在 sudokuState 中，任意切分调用的输出拼接结果一致；双方必须在收发数据前选择相同的 profile
+// 游标不回绕到记录边界: 一次 mask 的输出可能以半条模板结束，下一次调用从该位置继续
+
+package sudoku
+
+const (
+	coverSlot        = 0x00
+	coverMaxTemplate = 64
+	coverMaxRun      = 16 // 相邻槽位之间字面字节数上限 (限制最坏膨胀)
+
+	// 单个输入字节的最大输出: maskMaxPerByte 个槽位，每个槽位前至多 coverMaxRun 个字面字节
+	coverMaxPerByte = maskMaxPerByte * (1 + coverMaxRun)
+)
+
+// profile 编号 (setCoverProfile)
+const (
+	CoverNone         = 0
+	CoverJSON         = 1 // {"k":"<16>","v":"<32>"},
+	CoverHTTPChunked  = 2 // 20\r\n<32>\r\n (chunk-size 与槽位数一致)
+	CoverCustom       = 3 // setCoverTemplate 安装的模块级模板
+	coverProfileCount = 4
+)
+
+type coverProfile struct {
+	template   [coverMaxTemplate]uint8
+	length     uint8
+	slots      uint8
+	maxPerByte uint8 // 连续 maskMaxPerByte 个槽位的最大输出字节数 (含字面字节)
+}
+
+var coverProfiles [coverProfileCount]coverProfile
+
+// 内置模板的段: 字面字节后接 slots 个槽位
+type coverSegment struct {
+	lit   string
+	slots uint8
+}
+
+var coverJSONSegments = [...]coverSegment{{"{\"k\":\"", 16}, {"\",\"v\":\"", 32}, {"\"},", 0}}
+var coverHTTPChunkedSegments = [...]coverSegment{{"20\r\n", 32}, {"\r\n", 0}}
+
+// setupCoverProfiles - 构建内置模板 (setupCodecTables 调用)
+func setupCoverProfiles() {
+	setupCoverSegments(&coverProfiles[CoverJSON], coverJSONSegments[:])
+	setupCoverSegments(&coverProfiles[CoverHTTPChunked], coverHTTPChunkedSegments[:])
+}
+
+func setupCoverSegments(p *coverProfile, segs []coverSegment) {
+	n := 0
+	for _, s := range segs {
+		for i := 0; i < len(s.lit); i++ {
+			p.template[n] = s.lit[i]
+			n++
+		}
+		for i := uint8(0); i < s.slots; i++ {
+			p.template[n] = coverSlot
+			n++
+		}
+	}
+	coverMeasure(p, uint8(n))
+}
+
+// coverMeasure - 统计模板的槽位数、最长字面字节段与最坏膨胀
+// 返回值: 最长 (循环) 字面字节段长度；模板无槽位时返回 coverMaxTemplate
+func coverMeasure(p *coverProfile, length uint8) uint8 {
+	p.length = length
+	p.slots = 0
+	for i := uint8(0); i < length; i++ {
+		if p.template[i] == coverSlot {
+			p.slots++
+		}
+	}
+	if p.slots == 0 {
+		return coverMaxTemplate
+	}
+
+	maxRun, maxOut := uint8(0), uint8(0)
+	for start := uint8(0); start < length; start++ {
+		pos, run, out := start, uint8(0), uint8(0)
+		for filled := 0; filled < maskMaxPerByte; out++ {
+			if p.template[pos] == coverSlot {
+				filled++
+				if run > maxRun {
+					maxRun = run
+				}
+				run = 0
+			} else {
+				run++
+			}
+			pos++
+			if pos == length {
+				pos = 0
+			}
+		}
+		if out > maxOut {
+			maxOut = out
+		}
+	}
+	p.maxPerByte = maxOut
+	return maxRun
+}
+
+// sessionCover - session 的 profile，未开启时为 nil
+func sessionCover(state *[64]byte) *coverProfile {
+	p := state[stateCoverProfile]
+	if p == CoverNone || p >= coverProfileCount {
+		return nil
+	}
+	return &coverProfiles[p]
+}
+
+// coverWrap - 将 grp 中的 n 个 mask 字节嵌入模板，写入 buf，推进游标
+// 返回值: 输出字节数
+func coverWrap(p *coverProfile, cursor *uint8, grp *[maskMaxPerByte]byte, n uint32, buf *[coverMaxPerByte]byte) uint32 {
+	pos := *cursor
+	m := uint32(0)
+	for k := uint32(0); k < n; k++ {
+		for p.template[pos] != coverSlot {
+			buf[m] = p.template[pos]
+			m++
+			pos++
+			if pos == p.length {
+				pos = 0
+			}
+		}
+		buf[m] = grp[k]
+		m++
+		pos++
+		if pos == p.length {
+			pos = 0
+		}
+	}
+	*cursor = pos
+	return m
+}
+
+// coverStrip - 接收端处理一个线路字节，推进游标
+// 返回值: (是否为槽位字节, 字面字节是否与模板不符)
+func coverStrip(p *coverProfile, cursor *uint8, b uint8) (bool, bool) {
+	want := p.template[*cursor]
+	*cursor++
+	if *cursor == p.length {
+		*cursor = 0
+	}
+	if want == coverSlot {
+		return true, false
+	}
+	return false, b != want
+}
+
+// maskPerByte - session 单个输入字节的最大 mask 输出
+func maskPerByte(state *[64]byte) uint32 {
+	if p := sessionCover(state); p != nil {
+		return uint32(p.maxPerByte)
+	}
+	return maskMaxPerByte
+}
+
+// setCoverProfile - 选择 session 的伪装流量 profile，双方游标归零
+// 应在收发任何数据前设置 (或双方在同一位置同时切换)，否则对端会把字面字节当作 hint
+// 返回值: 0 成功, -1 session 无效, -2 未知 profile 或 CoverCustom 尚未安装模板
+//
+//export setCoverProfile
+func setCoverProfile(id int32, profileId uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if profileId >= coverProfileCount || (profileId != CoverNone && coverProfiles[profileId].slots == 0) {
+		return -2
+	}
+	state := &getSession(id).sudokuState
+	state[stateCoverProfile] = uint8(profileId)
+	state[stateCoverTx] = 0
+	state[stateCoverRx] = 0
+	return 0
+}
+
+// setCoverTemplate - 安装 CoverCustom 模板 (模块级，所有选择 CoverCustom 的 session 共享)
+// 模板中 0x00 为槽位，至少含 1 个槽位，长度不超过 64，相邻槽位间 (循环) 字面字节不超过 16
+// 已使用 CoverCustom 的 session 游标不会重置，替换模板前应先关闭或切换这些 session
+// 返回值: 0 成功, -1 越界或长度无效, -2 模板无槽位或字面字节段过长
+//
+//export setCoverTemplate
+func setCoverTemplate(ptr uint32, length uint32) int32 {
+	if length == 0 || length > coverMaxTemplate || !inArenaRange(ptr, length) {
+		return -1
+	}
+	var p coverProfile
+	copy(p.template[:], arena[ptr:ptr+length])
+	if coverMeasure(&p, uint8(length)) > coverMaxRun {
+		return -2
+	}
+	coverProfiles[CoverCustom] = p
+	return 0
+}
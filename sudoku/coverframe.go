@@ -0,0 +1,83 @@
+// 伪装帧 - 只含 padding 的 mask 字节流，宿主在流量空档发送，使线路上的报文节奏与真实负载无关
+// 字节取自 session 的 padding 池 (开启伪装流量 profile 时嵌入模板)，不含任何 hint，
+// 对端 unmask / unmaskFrame / streamRecv 解不出数据，输出为空且不报错；不占用 nonce，也不计入密钥轮换
+// 与心跳记录 (buildKeepalive) 不同，伪装帧不经过 AEAD，对端无法确认其来源，只用于填充
+
+package sudoku
+
+// buildCoverFrame - 生成约 approxLen 字节的伪装帧，输出格式同 maskFrame (整形/MTU 设置同样生效)
+// 未开启伪装流量 profile 时长度恰为 approxLen；开启时按模板对齐，可能略短 (至少输出一组槽位)；设置了 MTU 时截断到 MTU
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取:
+// -2 approxLen 为 0, -3 超出输出区容量, -19 padding 池为空 (setPaddingPolicy 的池长度为 0))
+//
+//export buildCoverFrame
+func buildCoverFrame(id int32, approxLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	if approxLen == 0 {
+		return fail(errEmptyInput)
+	}
+	session := getSession(id)
+	state := &session.sudokuState
+	padPoolSize := state[statePadPoolSize]
+	if padPoolSize == 0 {
+		return fail(errNoPadding)
+	}
+	if mtu := sessionMaxFrame[id]; mtu != 0 && approxLen > mtu {
+		approxLen = mtu
+	}
+	out, outCap := sessionOut(id)
+	reserved, maxOut, ok := maskOutReserve(id, approxLen, outCap)
+	if !ok || approxLen > maxOut {
+		return fail(errInputTooLarge)
+	}
+
+	if session.flags&sessionFlagDatagram != 0 {
+		state[stateCoverTx] = 0
+	}
+	pool := &sessionPadPool[id]
+	var allowedPool [maxPadPoolSize]uint8
+	if forbid := sessionForbid(id); forbid != nil {
+		padPoolSize = forbidFilterPool(pool, padPoolSize, forbid, &allowedPool)
+		pool = &allowedPool
+		if padPoolSize == 0 {
+			return fail(errNoPadding)
+		}
+	}
+	rng := maskRngLoad(id)
+	cover := sessionCover(state)
+	cursor := state[stateCoverTx]
+	var grp [maskMaxPerByte]byte
+	var wrapped [coverMaxPerByte]byte
+	dst := out + reserved
+	outPos := uint32(0)
+	for outPos < approxLen {
+		rngAdvance(&rng)
+		// 取高位: LCG 低位周期很短，连续的纯 padding 会呈现可见的重复模式
+		grp[0] = pool[(rng.x>>16)%uint32(padPoolSize)]
+		if cover == nil {
+			arena[dst+outPos] = grp[0]
+			outPos++
+			continue
+		}
+		c := cursor
+		n := coverWrap(cover, &c, &grp, 1, &wrapped)
+		if outPos+n > maxOut || (outPos > 0 && outPos+n > approxLen) {
+			break
+		}
+		copy(arena[dst+outPos:dst+outPos+n], wrapped[:n])
+		outPos += n
+		cursor = c
+	}
+	if outPos == 0 {
+		return fail(errInputTooLarge)
+	}
+	maskRngStore(state, &rng)
+	state[stateCoverTx] = cursor
+	sessionStats[id].padBytesEmitted += uint64(outPos)
+	return maskOutFinish(id, out, reserved, outPos)
+}
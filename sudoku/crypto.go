@@ -0,0 +1,365 @@
+// AEAD 加密 - 从 golang.org/x/crypto 和 Go 标准库移植
+// 官方源码:
+// - https://github.com/golang/crypto/tree/master/chacha20poly1305
+// - https://github.com/golang/crypto/tree/master/chacha20
+// - https://github.com/golang/crypto/tree/master/internal/poly1305
+// - https://github.com/golang/go/tree/master/src/crypto/cipher (GCM)
+//
+// 移植规则:
+// 1. 移除所有 slice 分配，使用固定数组+长度
+// 2. 保持算法位级等价
+// 3. ChaCha20-Poly1305 完整移植
+// 4. AES-128-GCM 完整移植 (AES 分组 + GHASH)，无需回退 Web Crypto
+
+package sudoku
+
+// 加密类型常量
+const (
+	CipherNone          = 0
+	CipherAES128GCM     = 1
+	CipherChaCha20Poly  = 2
+	CipherXChaCha20Poly = 3
+	CipherAES256GCM     = 4
+)
+
+// aeadEncrypt - AEAD 加密入口
+// 参数:
+//   id: session ID
+//   plaintextPtr: 明文数据指针 (arena 中)
+//   plaintextLen: 明文长度
+//   outPtr: 输出缓冲区指针 (arena 中)
+//   adPtr: 附加认证数据指针 (arena 中，adLen=0 时忽略)
+//   adLen: 附加认证数据长度 (如帧头、连接 ID)
+// 返回: 输出总长度 (0 表示失败，原因通过 getLastError 获取)
+//
+// 输出格式:
+//   [nonce (nonceSize)][ciphertext (len=plaintextLen)][tag (16 bytes)]
+//   ChaCha20-Poly1305 / AES-GCM 的 nonce 为 12 字节，XChaCha20-Poly1305 为 24 字节
+//   开启 SessionOptKeyCommit 时 tag 之后另有 32 字节承诺标签 (见 commit.go)
+//
+//export aeadEncrypt
+func aeadEncrypt(id int32, plaintextPtr uint32, plaintextLen uint32, outPtr uint32, adPtr uint32, adLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	
+	if plaintextLen == 0 {
+		return fail(errEmptyInput)
+	}
+	session := getSession(id)
+	if !inArenaRange(plaintextPtr, plaintextLen) || !inArenaRange(adPtr, adLen) ||
+		!inArenaRange(outPtr, plaintextLen+aeadOverhead(session)) {
+		return fail(errOutOfBounds)
+	}
+	if !rateCheck(id, plaintextLen) {
+		return fail(errRateLimited)
+	}
+	
+	n := aeadSeal(session, plaintextPtr, plaintextLen, outPtr, arena[adPtr:adPtr+adLen])
+	if n == 0 {
+		return fail(errCipher)
+	}
+	sessionStats[id].framesSealed++
+	traceSealed(id, plaintextLen)
+	rekeyAfterSeal(id, plaintextLen)
+	rateCharge(id, plaintextLen)
+	lastError = errOK
+	return n
+}
+
+// aeadSeal - 按 session 的 cipherType 加密 (调用方已校验 session)
+// additionalData 仅参与认证，不输出
+func aeadSeal(session *SudokuInstance, plaintextPtr uint32, plaintextLen uint32, outPtr uint32, additionalData []byte) uint32 {
+	if session.cipherType == CipherNone {
+		// 无加密，直接复制
+		for i := uint32(0); i < plaintextLen; i++ {
+			arena[outPtr+i] = arena[plaintextPtr+i]
+		}
+		return plaintextLen
+	}
+	
+	spec := cipherLookup(session.cipherType)
+	if spec == nil {
+		return 0
+	}
+	nonceLen := uint32(spec.nonceLen)
+	var nonce [cipherMaxNonce]byte
+	incNonce(session, nonce[:nonceLen])
+	if nonceLen == xchachaNonceSize {
+		// XChaCha20: 中间 12 字节取随机数 ([salt (4)][random (12)][counter (8)])，nonce 随帧携带；
+		// counter 仍用于抗重放窗口，随机部分使 counter 重复 (快照回滚、RoleShared 等) 时 nonce 依然唯一
+		fillRandom(nonce[4 : nonceLen-8])
+	}
+	
+	// 输出: [nonce][ciphertext][tag]
+	plaintext := arena[plaintextPtr : plaintextPtr+plaintextLen]
+	out := arena[outPtr+nonceLen : outPtr+nonceLen+plaintextLen+uint32(spec.tagLen)]
+	n := spec.seal(&session.key, nonce[:nonceLen], plaintext, int(plaintextLen), additionalData, out)
+	if n <= 0 {
+		return 0
+	}
+	copy(arena[outPtr:outPtr+nonceLen], nonce[:nonceLen])
+	sealedLen := uint32(n) + nonceLen
+	tagLen := uint32(spec.tagLen)
+	return sealedLen + commitAppend(session, nonce[:nonceLen], outPtr+sealedLen-tagLen, tagLen, additionalData)
+}
+
+// aeadDecrypt - AEAD 解密入口
+// adPtr/adLen 必须与加密时一致，否则认证失败
+// 返回: 明文长度 (0 表示失败，原因通过 getLastError 获取)
+//
+//export aeadDecrypt
+func aeadDecrypt(id int32, ciphertextPtr uint32, ciphertextLen uint32, outPtr uint32, adPtr uint32, adLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if rxClosed(id) {
+		return fail(errClosed)
+	}
+	
+	if ciphertextLen == 0 {
+		return fail(errEmptyInput)
+	}
+	if !inArenaRange(ciphertextPtr, ciphertextLen) || !inArenaRange(adPtr, adLen) ||
+		!inArenaRange(outPtr, ciphertextLen) {
+		return fail(errOutOfBounds)
+	}
+	
+	session := getSession(id)
+	n := aeadOpen(session, ciphertextPtr, ciphertextLen, outPtr, arena[adPtr:adPtr+adLen])
+	counter := uint64(0)
+	if ciphertextLen >= uint32(session.nonceSize) {
+		counter = traceNonceCounter(ciphertextPtr, uint32(session.nonceSize))
+	}
+	if n == 0 {
+		sessionStats[id].decodeErrors++
+		logEvent(LogLevelWarn, logTagFailure, uint32(id))
+		traceEvent(id, traceOpenFail, errAuth, ciphertextLen, counter)
+		return fail(errAuth)
+	}
+	sessionStats[id].framesOpened++
+	traceEvent(id, traceOpen, errOK, n, counter)
+	rekeyAfterOpen(id, n)
+	lastError = errOK
+	return n
+}
+
+// aeadEncryptOut - 同 aeadEncrypt，但输出写入 session 输出区 (共享 outBuf 或 setSessionOutBuf 绑定的区域)，
+// 调用约定与 mask 一致；显式 outPtr 的 aeadEncrypt 在过渡期内保留
+// 输入不得与输出区重叠
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export aeadEncryptOut
+func aeadEncryptOut(id int32, plaintextPtr uint32, plaintextLen uint32, adPtr uint32, adLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	out, outCap := sessionOut(id)
+	setOutLen(id, 0)
+	if uint64(plaintextLen)+uint64(aeadOverhead(getSession(id))) > uint64(outCap) {
+		return fail(errOutputTooSmall)
+	}
+	n := aeadEncrypt(id, plaintextPtr, plaintextLen, out, adPtr, adLen)
+	if n == 0 {
+		return 0
+	}
+	setOutLen(id, n)
+	return out
+}
+
+// aeadDecryptOut - 同 aeadDecrypt，但明文写入 session 输出区，调用约定与 unmask 一致
+// 输入不得与输出区重叠
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export aeadDecryptOut
+func aeadDecryptOut(id int32, ciphertextPtr uint32, ciphertextLen uint32, adPtr uint32, adLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if rxClosed(id) {
+		return fail(errClosed)
+	}
+	out, outCap := sessionOut(id)
+	setOutLen(id, 0)
+	if ciphertextLen > outCap {
+		return fail(errOutputTooSmall)
+	}
+	n := aeadDecrypt(id, ciphertextPtr, ciphertextLen, out, adPtr, adLen)
+	if n == 0 {
+		return 0
+	}
+	setOutLen(id, n)
+	return out
+}
+
+// aeadOpen - 按 session 的 cipherType 解密 (调用方已校验 session)
+func aeadOpen(session *SudokuInstance, ciphertextPtr uint32, ciphertextLen uint32, outPtr uint32, additionalData []byte) uint32 {
+	if session.cipherType == CipherNone {
+		// 无加密，直接复制
+		for i := uint32(0); i < ciphertextLen; i++ {
+			arena[outPtr+i] = arena[ciphertextPtr+i]
+		}
+		return ciphertextLen
+	}
+	
+	spec := cipherLookup(session.cipherType)
+	if spec == nil {
+		return 0
+	}
+	nonceLen := uint32(spec.nonceLen)
+	tagLen := uint32(spec.tagLen)
+	commitLen := commitOverhead(session)
+	if ciphertextLen < nonceLen+tagLen+commitLen {
+		return 0
+	}
+	ciphertextLen -= commitLen
+	if !commitCheck(session, arena[ciphertextPtr:ciphertextPtr+nonceLen], ciphertextPtr+ciphertextLen-tagLen, tagLen, additionalData) {
+		return 0
+	}
+	
+	// nonce 取自帧头 (XChaCha20 发送方可使用随机 nonce)
+	ctLen := ciphertextLen - nonceLen
+	ciphertextAndTag := arena[ciphertextPtr+nonceLen : ciphertextPtr+ciphertextLen]
+	out := arena[outPtr : outPtr+ctLen]
+	n := spec.open(sessionRxKeyOf(session), arena[ciphertextPtr:ciphertextPtr+nonceLen], ciphertextAndTag, int(ctLen), additionalData, out)
+	if n < 0 {
+		return 0 // 验证失败
+	}
+	return uint32(n)
+}
+
+// aeadEncryptWithNonce - 使用宿主提供的 nonce 加密 (数据报传输: nonce 随包携带)
+// 参数同 aeadEncrypt，另加 noncePtr: nonce 指针，长度为 session 的 nonceSize
+//   (ChaCha20-Poly1305 / AES-GCM 为 12 字节，XChaCha20-Poly1305 为 24 字节)
+// 不使用也不推进 session 的 nonce counter，nonce 唯一性由宿主保证
+// 输出格式: [ciphertext (len=plaintextLen)][tag (16 bytes)]，不含 nonce；开启密钥承诺时 tag 后另有 32 字节承诺标签
+// 返回: 输出总长度 (0 表示失败，原因通过 getLastError 获取)
+//
+//export aeadEncryptWithNonce
+func aeadEncryptWithNonce(id int32, noncePtr uint32, plaintextPtr uint32, plaintextLen uint32, outPtr uint32, adPtr uint32, adLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	session := getSession(id)
+	if session.cipherType == CipherNone {
+		return fail(errCipher)
+	}
+	if plaintextLen == 0 {
+		return fail(errEmptyInput)
+	}
+	nonceLen := uint32(session.nonceSize)
+	tagLen := uint32(session.tagSize)
+	if !inArenaRange(noncePtr, nonceLen) || !inArenaRange(plaintextPtr, plaintextLen) ||
+		!inArenaRange(adPtr, adLen) || !inArenaRange(outPtr, plaintextLen+tagLen+commitOverhead(session)) {
+		return fail(errOutOfBounds)
+	}
+	if !rateCheck(id, plaintextLen) {
+		return fail(errRateLimited)
+	}
+	
+	plaintext := arena[plaintextPtr : plaintextPtr+plaintextLen]
+	out := arena[outPtr : outPtr+plaintextLen+tagLen]
+	ad := arena[adPtr : adPtr+adLen]
+	nonce := arena[noncePtr : noncePtr+nonceLen]
+	n := cipherLookup(session.cipherType).seal(&session.key, nonce, plaintext, int(plaintextLen), ad, out)
+	if n <= 0 {
+		return fail(errCipher)
+	}
+	n += int(commitAppend(session, nonce, outPtr+uint32(n)-tagLen, tagLen, ad))
+	sessionStats[id].framesSealed++
+	traceEvent(id, traceSeal, errOK, plaintextLen, traceNonceCounter(noncePtr, nonceLen))
+	rateCharge(id, plaintextLen)
+	lastError = errOK
+	return uint32(n)
+}
+
+// aeadDecryptWithNonce - 使用宿主提供的 nonce 解密，与 aeadEncryptWithNonce 对应
+// ciphertextPtr 指向 [ciphertext][tag]，不含 nonce (开启密钥承诺时为 [ciphertext][tag][承诺标签]，先校验承诺标签)；
+// 不经过抗重放窗口，乱序到达的包均可解密
+// 返回: 明文长度 (0 表示失败，原因通过 getLastError 获取)
+//
+//export aeadDecryptWithNonce
+func aeadDecryptWithNonce(id int32, noncePtr uint32, ciphertextPtr uint32, ciphertextLen uint32, outPtr uint32, adPtr uint32, adLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if rxClosed(id) {
+		return fail(errClosed)
+	}
+	session := getSession(id)
+	if session.cipherType == CipherNone {
+		return fail(errCipher)
+	}
+	tagLen := uint32(session.tagSize)
+	commitLen := commitOverhead(session)
+	if ciphertextLen <= tagLen+commitLen {
+		return fail(errEmptyInput)
+	}
+	nonceLen := uint32(session.nonceSize)
+	plainLen := ciphertextLen - tagLen - commitLen
+	if !inArenaRange(noncePtr, nonceLen) || !inArenaRange(ciphertextPtr, ciphertextLen) ||
+		!inArenaRange(adPtr, adLen) || !inArenaRange(outPtr, plainLen) {
+		return fail(errOutOfBounds)
+	}
+	
+	ctLen := ciphertextLen - commitLen
+	ciphertextAndTag := arena[ciphertextPtr : ciphertextPtr+ctLen]
+	out := arena[outPtr : outPtr+plainLen]
+	ad := arena[adPtr : adPtr+adLen]
+	nonce := arena[noncePtr : noncePtr+nonceLen]
+	key := sessionRxKeyOf(session)
+	n := -1
+	if commitCheck(session, nonce, ciphertextPtr+plainLen, tagLen, ad) {
+		n = cipherLookup(session.cipherType).open(key, nonce, ciphertextAndTag, int(ctLen), ad, out)
+	}
+	if n < 0 {
+		sessionStats[id].decodeErrors++
+		logEvent(LogLevelWarn, logTagFailure, uint32(id))
+		traceEvent(id, traceOpenFail, errAuth, ciphertextLen, traceNonceCounter(noncePtr, nonceLen))
+		return fail(errAuth)
+	}
+	sessionStats[id].framesOpened++
+	traceEvent(id, traceOpen, errOK, uint32(n), traceNonceCounter(noncePtr, nonceLen))
+	lastError = errOK
+	return uint32(n)
+}
+
+// incNonce - Nonce 大端序递增
+// 关键: 必须与官方实现位级等价
+func incNonce(session *SudokuInstance, nonce []byte) {
+	// 递增 64-bit counter (大端序存储)
+	session.txCounter++
+	
+	// 构造 12-byte nonce:
+	// 前 4 字节: session 的 nonce salt (默认全 0，见 setNonceSalt)
+	// 后 8 字节: counter (大端序)
+	// 24-byte nonce (XChaCha20): [salt (4)][0 (12)][counter (8)]，aeadSeal 随后以随机数填充中间 12 字节
+	if len(nonce) >= 12 {
+		copy(nonce[0:4], session.sudokuState[stateNonceSalt:stateNonceSalt+4])
+		
+		c := len(nonce) - 8
+		for i := 4; i < c; i++ {
+			nonce[i] = 0
+		}
+		
+		// 后 8 字节: counter (大端序)
+		// 注意: Wasm 是小端序，必须显式使用 BigEndian
+		nonce[c+0] = byte(session.txCounter >> 56)
+		nonce[c+1] = byte(session.txCounter >> 48)
+		nonce[c+2] = byte(session.txCounter >> 40)
+		nonce[c+3] = byte(session.txCounter >> 32)
+		nonce[c+4] = byte(session.txCounter >> 24)
+		nonce[c+5] = byte(session.txCounter >> 16)
+		nonce[c+6] = byte(session.txCounter >> 8)
+		nonce[c+7] = byte(session.txCounter)
+	}
+}
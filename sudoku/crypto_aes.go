@@ -5,10 +5,11 @@
 // 2. 使用固定数组，无 slice
 // 3. 保持 T-table 或类似优化
 
-package main
+package sudoku
 
 import (
 	"encoding/binary"
+	"math/bits"
 )
 
 const (
@@ -18,7 +19,7 @@ const (
 	// AES-256: 14 rounds
 )
 
-// 解密用的 T-表 (从官方源码移植)
+// 加密用的 T-表 (从官方源码移植)
 // Te0[x] = S[x].[02, 01, 01, 03]
 var te0 = [256]uint32{
 	0xc66363a5, 0xf87c7c84, 0xee777799, 0xf67b7b8d,
@@ -147,7 +148,7 @@ func subWord(x uint32) uint32 {
 var rCon = [11]uint32{0x00, 0x01, 0x02, 0x04, 0x08, 0x10, 0x20, 0x40, 0x80, 0x1b, 0x36}
 
 // aesKeyExpansion - AES 密钥扩展
-// 移植自 crypto/aes 的 asm 或 block.go
+// 移植自 crypto/aes 的 expandKeyGeneric (block.go)
 func aesKeyExpansion(ctx *aesContext, key []byte, keyLen int) bool {
 	var nk, nr int
 	
@@ -172,11 +173,11 @@ func aesKeyExpansion(ctx *aesContext, key []byte, keyLen int) bool {
 		ctx.enc[i] = binary.BigEndian.Uint32(key[i*4:])
 	}
 	
-	// 密钥扩展
+	// 密钥扩展 (轮常数位于最高字节，与官方 powx<<24 一致)
 	for i := nk; i < 4*(nr+1); i++ {
 		temp := ctx.enc[i-1]
 		if i%nk == 0 {
-			temp = subWord(rotWord(temp)) ^ rCon[i/nk]
+			temp = subWord(rotWord(temp)) ^ (rCon[i/nk] << 24)
 		} else if nk > 6 && i%nk == 4 {
 			temp = subWord(temp)
 		}
@@ -195,7 +196,8 @@ func aesKeyExpansion(ctx *aesContext, key []byte, keyLen int) bool {
 }
 
 // aesEncryptBlock - AES 加密单块
-// 移植自 crypto/aes
+// 移植自 crypto/aes 的 encryptBlockGeneric
+// te1/te2/te3 由 te0 循环右移得到，避免额外 3KB 表
 func aesEncryptBlock(ctx *aesContext, dst, src []byte) {
 	rk := ctx.enc[:]
 	rounds := ctx.rounds
@@ -212,37 +214,32 @@ func aesEncryptBlock(ctx *aesContext, dst, src []byte) {
 	s2 ^= rk[2]
 	s3 ^= rk[3]
 	
-	// 主轮
+	// 主轮 (SubBytes + ShiftRows + MixColumns + AddRoundKey)
+	k := 4
+	var t0, t1, t2, t3 uint32
 	for r := 1; r < rounds; r++ {
-		t0 := te0[s0&0xff] ^
-			(te0[(s1>>8)&0xff] >> 8) ^
-			(te0[(s2>>16)&0xff] >> 16) ^
-			(te0[s3>>24] >> 24) ^
-			rk[r*4]
-		t1 := te0[s1&0xff] ^
-			(te0[(s2>>8)&0xff] >> 8) ^
-			(te0[(s3>>16)&0xff] >> 16) ^
-			(te0[s0>>24] >> 24) ^
-			rk[r*4+1]
-		t2 := te0[s2&0xff] ^
-			(te0[(s3>>8)&0xff] >> 8) ^
-			(te0[(s0>>16)&0xff] >> 16) ^
-			(te0[s1>>24] >> 24) ^
-			rk[r*4+2]
-		t3 := te0[s3&0xff] ^
-			(te0[(s0>>8)&0xff] >> 8) ^
-			(te0[(s1>>16)&0xff] >> 16) ^
-			(te0[s2>>24] >> 24) ^
-			rk[r*4+3]
+		t0 = rk[k+0] ^ te0[uint8(s0>>24)] ^ bits.RotateLeft32(te0[uint8(s1>>16)], -8) ^
+			bits.RotateLeft32(te0[uint8(s2>>8)], -16) ^ bits.RotateLeft32(te0[uint8(s3)], -24)
+		t1 = rk[k+1] ^ te0[uint8(s1>>24)] ^ bits.RotateLeft32(te0[uint8(s2>>16)], -8) ^
+			bits.RotateLeft32(te0[uint8(s3>>8)], -16) ^ bits.RotateLeft32(te0[uint8(s0)], -24)
+		t2 = rk[k+2] ^ te0[uint8(s2>>24)] ^ bits.RotateLeft32(te0[uint8(s3>>16)], -8) ^
+			bits.RotateLeft32(te0[uint8(s0>>8)], -16) ^ bits.RotateLeft32(te0[uint8(s1)], -24)
+		t3 = rk[k+3] ^ te0[uint8(s3>>24)] ^ bits.RotateLeft32(te0[uint8(s0>>16)], -8) ^
+			bits.RotateLeft32(te0[uint8(s1>>8)], -16) ^ bits.RotateLeft32(te0[uint8(s2)], -24)
+		k += 4
 		s0, s1, s2, s3 = t0, t1, t2, t3
 	}
 	
-	// 最后一轮 (无 MixColumns)
-	// 这里简化处理，实际应使用 S-box
-	s0 = rk[rounds*4]
-	s1 = rk[rounds*4+1]
-	s2 = rk[rounds*4+2]
-	s3 = rk[rounds*4+3]
+	// 最后一轮 (无 MixColumns，直接使用 S-box)
+	t0 = uint32(sBox0[s0>>24])<<24 | uint32(sBox0[s1>>16&0xff])<<16 | uint32(sBox0[s2>>8&0xff])<<8 | uint32(sBox0[s3&0xff])
+	t1 = uint32(sBox0[s1>>24])<<24 | uint32(sBox0[s2>>16&0xff])<<16 | uint32(sBox0[s3>>8&0xff])<<8 | uint32(sBox0[s0&0xff])
+	t2 = uint32(sBox0[s2>>24])<<24 | uint32(sBox0[s3>>16&0xff])<<16 | uint32(sBox0[s0>>8&0xff])<<8 | uint32(sBox0[s1&0xff])
+	t3 = uint32(sBox0[s3>>24])<<24 | uint32(sBox0[s0>>16&0xff])<<16 | uint32(sBox0[s1>>8&0xff])<<8 | uint32(sBox0[s2&0xff])
+	
+	s0 = t0 ^ rk[k+0]
+	s1 = t1 ^ rk[k+1]
+	s2 = t2 ^ rk[k+2]
+	s3 = t3 ^ rk[k+3]
 	
 	binary.BigEndian.PutUint32(dst[0:4], s0)
 	binary.BigEndian.PutUint32(dst[4:8], s1)
@@ -0,0 +1,175 @@
+// AES-GCM AEAD - 从 Go 标准库 crypto/cipher 移植
+// 官方源码: https://github.com/golang/go/blob/master/src/crypto/internal/fips140/aes/gcm/gcm_generic.go
+// 移植规则:
+// 1. 移除 slice 分配
+// 2. 使用固定缓冲区
+// 3. 仅支持 12 字节标准 nonce 与 16 字节标签 (与官方默认 NewGCM 一致)
+
+package sudoku
+
+import (
+	"encoding/binary"
+)
+
+const (
+	gcmNonceSize = 12
+	gcmTagSize   = 16
+)
+
+// gcmInc32 - 计数器块低 32 位大端递增
+func gcmInc32(counterBlock *[aesBlockSize]byte) {
+	ctr := binary.BigEndian.Uint32(counterBlock[12:16])
+	binary.BigEndian.PutUint32(counterBlock[12:16], ctr+1)
+}
+
+// gcmCounterCrypt - CTR 模式加解密
+// 移植自 gcmCounterCryptGeneric
+func gcmCounterCrypt(ctx *aesContext, out, in []byte, inLen int, counter *[aesBlockSize]byte) {
+	var mask [aesBlockSize]byte
+
+	for inLen >= aesBlockSize {
+		aesEncryptBlock(ctx, mask[:], counter[:])
+		gcmInc32(counter)
+
+		for i := 0; i < aesBlockSize; i++ {
+			out[i] = in[i] ^ mask[i]
+		}
+		out = out[aesBlockSize:]
+		in = in[aesBlockSize:]
+		inLen -= aesBlockSize
+	}
+
+	if inLen > 0 {
+		aesEncryptBlock(ctx, mask[:], counter[:])
+		gcmInc32(counter)
+		for i := 0; i < inLen; i++ {
+			out[i] = in[i] ^ mask[i]
+		}
+	}
+}
+
+// gcmAuth - 计算 GHASH(AD, C) 并与 E(K, J0) 异或得到标签
+// 移植自 gcmAuthGeneric
+func gcmAuth(
+	ctx *aesContext,
+	tag *[gcmTagSize]byte,
+	ciphertext []byte,
+	ciphertextLen int,
+	additionalData []byte,
+	adLen int,
+	tagMask *[aesBlockSize]byte,
+) {
+	var zero [aesBlockSize]byte
+	var h [aesBlockSize]byte
+	aesEncryptBlock(ctx, h[:], zero[:])
+
+	var g ghashContext
+	ghashInit(&g, &h)
+
+	if adLen > 0 {
+		ghashUpdate(&g, additionalData, adLen)
+		ghashPad(&g)
+	}
+	ghashUpdate(&g, ciphertext, ciphertextLen)
+	ghashPad(&g)
+
+	// 长度块: len(AD) || len(C)，单位为 bit，大端序
+	var lenBlock [16]byte
+	binary.BigEndian.PutUint64(lenBlock[0:8], uint64(adLen)*8)
+	binary.BigEndian.PutUint64(lenBlock[8:16], uint64(ciphertextLen)*8)
+	ghashUpdate(&g, lenBlock[:], 16)
+
+	ghashFinalize(&g, tag)
+	for i := 0; i < gcmTagSize; i++ {
+		tag[i] ^= tagMask[i]
+	}
+}
+
+// aesgcmSeal - 加密并认证
+// 移植自 sealGeneric
+// 输出格式: [ciphertext][tag (16 bytes)]
+// 返回值: 输出总长度 (0 表示密钥长度无效)
+func aesgcmSeal(
+	key []byte,
+	nonce *[gcmNonceSize]byte,
+	plaintext []byte,
+	plaintextLen int,
+	additionalData []byte,
+	adLen int,
+	out []byte,
+) int {
+	var ctx aesContext
+	if !aesKeyExpansion(&ctx, key, len(key)) {
+		return 0
+	}
+
+	// J0 = nonce || 0x00000001
+	var counter, tagMask [aesBlockSize]byte
+	copy(counter[:], nonce[:])
+	counter[aesBlockSize-1] = 1
+
+	aesEncryptBlock(&ctx, tagMask[:], counter[:])
+	gcmInc32(&counter)
+
+	gcmCounterCrypt(&ctx, out, plaintext, plaintextLen, &counter)
+
+	var tag [gcmTagSize]byte
+	gcmAuth(&ctx, &tag, out, plaintextLen, additionalData, adLen, &tagMask)
+	copy(out[plaintextLen:], tag[:])
+
+	return plaintextLen + gcmTagSize
+}
+
+// aesgcmOpen - 解密并验证
+// 移植自 openGeneric
+// 输入格式: [ciphertext][tag (16 bytes)]
+// 返回值: 明文长度，如果验证失败返回 -1
+func aesgcmOpen(
+	key []byte,
+	nonce *[gcmNonceSize]byte,
+	ciphertextAndTag []byte,
+	ctLen int, // 包含标签的总长度
+	additionalData []byte,
+	adLen int,
+	out []byte,
+) int {
+	if ctLen < gcmTagSize {
+		return -1
+	}
+
+	var ctx aesContext
+	if !aesKeyExpansion(&ctx, key, len(key)) {
+		return -1
+	}
+
+	ciphertextLen := ctLen - gcmTagSize
+	tag := ciphertextAndTag[ciphertextLen:ctLen]
+	ciphertext := ciphertextAndTag[:ciphertextLen]
+
+	var counter, tagMask [aesBlockSize]byte
+	copy(counter[:], nonce[:])
+	counter[aesBlockSize-1] = 1
+
+	aesEncryptBlock(&ctx, tagMask[:], counter[:])
+	gcmInc32(&counter)
+
+	var expectedTag [gcmTagSize]byte
+	gcmAuth(&ctx, &expectedTag, ciphertext, ciphertextLen, additionalData, adLen, &tagMask)
+
+	// 常量时间验证标签
+	var diff uint8
+	for i := 0; i < gcmTagSize; i++ {
+		diff |= tag[i] ^ expectedTag[i]
+	}
+	if diff != 0 {
+		// 验证失败，清零输出
+		for i := 0; i < ciphertextLen; i++ {
+			out[i] = 0
+		}
+		return -1
+	}
+
+	gcmCounterCrypt(&ctx, out, ciphertext, ciphertextLen, &counter)
+
+	return ciphertextLen
+}
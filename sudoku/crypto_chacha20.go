@@ -5,7 +5,7 @@
 // 2. 移除堆分配
 // 3. 保持算法完全等价
 
-package main
+package sudoku
 
 import (
 	"encoding/binary"
@@ -175,7 +175,19 @@ func chacha20Xor(c *chacha20Cipher, dst, src []byte, srcLen int) {
 		src = src[len(keyStream):]
 	}
 	
-	// 处理完整的块
+	// 每次 4 块 (chachasimd 构建下并行生成，见 crypto_chacha20_simd.go)
+	for srcLen >= 4*chachaBlockSize {
+		var blocks [4 * chachaBlockSize]byte
+		chacha20GenerateBlocks4(c, &blocks)
+		for i := 0; i < 4*chachaBlockSize; i++ {
+			dst[i] = src[i] ^ blocks[i]
+		}
+		srcLen -= 4 * chachaBlockSize
+		dst = dst[4*chachaBlockSize:]
+		src = src[4*chachaBlockSize:]
+	}
+
+	// 处理剩余的完整块
 	for srcLen >= chachaBlockSize {
 		var block [chachaBlockSize]byte
 		chacha20GenerateBlock(c, &block)
@@ -220,3 +232,31 @@ func chacha20GenerateKey(c *chacha20Cipher, out *[32]byte) {
 		c.counter = savedCounter
 	}
 }
+
+// ============================================================================
+// 宿主接口: ChaCha20 原始 keystream
+// 供宿主混淆带外元数据，不提供认证；同一 (key, nonce, counter) 不得用于两段不同的数据
+// ============================================================================
+
+// chacha20HostXor - 以 arena[keyPtr:keyPtr+32]、arena[noncePtr:noncePtr+12] 与起始块号 counter 原地 XOR arena[inPtr:inPtr+n]
+// 返回值: 0 成功, -1 密钥或 nonce 越界, -2 数据越界, -3 块号超出 32 位 counter 范围
+//
+//export chachaXor
+func chacha20HostXor(keyPtr uint32, noncePtr uint32, counter uint32, inPtr uint32, n uint32) int32 {
+	if !inArenaRange(keyPtr, chachaKeySize) || !inArenaRange(noncePtr, chachaNonceSize) {
+		return -1
+	}
+	if !inArenaRange(inPtr, n) {
+		return -2
+	}
+	if uint64(counter)+(uint64(n)+chachaBlockSize-1)/chachaBlockSize > 1<<32 {
+		return -3
+	}
+	var c chacha20Cipher
+	chacha20Init(&c, arena[keyPtr:keyPtr+chachaKeySize], arena[noncePtr:noncePtr+chachaNonceSize])
+	c.counter = counter
+	data := arena[inPtr : inPtr+n]
+	chacha20Xor(&c, data, data, int(n))
+	c = chacha20Cipher{}
+	return 0
+}
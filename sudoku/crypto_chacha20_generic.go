@@ -0,0 +1,13 @@
+//go:build !chachasimd
+
+package sudoku
+
+// 默认构建: 逐块生成 keystream
+const chachaSimd = false
+
+// chacha20GenerateBlocks4 - 生成 4 个连续的 keystream 块 (counter 递增 4)
+func chacha20GenerateBlocks4(c *chacha20Cipher, out *[4 * chachaBlockSize]byte) {
+	for j := 0; j < 4; j++ {
+		chacha20GenerateBlock(c, (*[chachaBlockSize]byte)(out[j*chachaBlockSize:(j+1)*chachaBlockSize]))
+	}
+}
@@ -5,7 +5,7 @@
 // 2. 使用固定缓冲区
 // 3. 保持算法流程完全等价
 
-package main
+package sudoku
 
 import (
 	"encoding/binary"
@@ -0,0 +1,225 @@
+// GHASH - 从 Go 标准库 crypto/cipher 移植
+// 官方源码: https://github.com/golang/go/blob/master/src/crypto/internal/fips140/aes/gcm/ghash.go
+// 移植规则:
+// 1. 使用固定数组，无 slice 分配
+// 2. 保持 4-bit 查表乘法与官方实现位级等价
+
+package sudoku
+
+import (
+	"encoding/binary"
+)
+
+const ghashBlockSize = 16
+
+// gcmFieldElement - GF(2^128) 元素
+// low 保存块的前 8 字节，high 保存后 8 字节 (与官方一致)
+type gcmFieldElement struct {
+	low, high uint64
+}
+
+// ghashContext - GHASH 上下文
+type ghashContext struct {
+	productTable [16]gcmFieldElement // H 的 0..15 倍 (位反序索引)
+	y            gcmFieldElement     // 累加器
+	buffer       [ghashBlockSize]byte
+	offset       int
+}
+
+// gcmReductionTable - 4-bit 约简表
+// 从官方源码移植
+var gcmReductionTable = [16]uint16{
+	0x0000, 0x1c20, 0x3840, 0x2460, 0x7080, 0x6ca0, 0x48c0, 0x54e0,
+	0xe100, 0xfd20, 0xd940, 0xc560, 0x9180, 0x8da0, 0xa9c0, 0xb5e0,
+}
+
+// reverseBits - 4 位反序
+func reverseBits(i int) int {
+	i = ((i << 2) & 0xc) | ((i >> 2) & 0x3)
+	i = ((i << 1) & 0xa) | ((i >> 1) & 0x5)
+	return i
+}
+
+// gcmAdd - GF(2^128) 加法
+func gcmAdd(x, y *gcmFieldElement) gcmFieldElement {
+	return gcmFieldElement{x.low ^ y.low, x.high ^ y.high}
+}
+
+// gcmDouble - GF(2^128) 乘 x
+func gcmDouble(x *gcmFieldElement) (double gcmFieldElement) {
+	msbSet := x.high&1 == 1
+
+	double.high = x.high >> 1
+	double.high |= x.low << 63
+	double.low = x.low >> 1
+
+	if msbSet {
+		double.low ^= 0xe100000000000000
+	}
+	return
+}
+
+// ghashInit - 以哈希子密钥 H 初始化
+// 移植自 newGCM 中的 productTable 构造
+func ghashInit(ctx *ghashContext, h *[ghashBlockSize]byte) {
+	x := gcmFieldElement{
+		binary.BigEndian.Uint64(h[0:8]),
+		binary.BigEndian.Uint64(h[8:16]),
+	}
+	ctx.productTable[0] = gcmFieldElement{}
+	ctx.productTable[reverseBits(1)] = x
+
+	for i := 2; i < 16; i += 2 {
+		ctx.productTable[reverseBits(i)] = gcmDouble(&ctx.productTable[reverseBits(i/2)])
+		ctx.productTable[reverseBits(i+1)] = gcmAdd(&ctx.productTable[reverseBits(i)], &x)
+	}
+
+	ctx.y = gcmFieldElement{}
+	ctx.offset = 0
+}
+
+// ghashMul - y *= H
+// 移植自 ghashMul
+func ghashMul(ctx *ghashContext, y *gcmFieldElement) {
+	var z gcmFieldElement
+
+	for i := 0; i < 2; i++ {
+		word := y.high
+		if i == 1 {
+			word = y.low
+		}
+
+		// 每次处理 4 位，从最低位开始
+		for j := 0; j < 64; j += 4 {
+			msw := z.high & 0xf
+			z.high >>= 4
+			z.high |= z.low << 60
+			z.low >>= 4
+			z.low ^= uint64(gcmReductionTable[msw]) << 48
+
+			t := &ctx.productTable[word&0xf]
+
+			z.low ^= t.low
+			z.high ^= t.high
+			word >>= 4
+		}
+	}
+
+	*y = z
+}
+
+// ghashUpdateBlock - 吸收一个完整块
+func ghashUpdateBlock(ctx *ghashContext, block []byte) {
+	ctx.y.low ^= binary.BigEndian.Uint64(block[0:8])
+	ctx.y.high ^= binary.BigEndian.Uint64(block[8:16])
+	ghashMul(ctx, &ctx.y)
+}
+
+// ghashUpdate - 吸收任意长度数据 (跨调用缓冲不完整块)
+func ghashUpdate(ctx *ghashContext, data []byte, len int) {
+	for len > 0 {
+		n := ghashBlockSize - ctx.offset
+		if n > len {
+			n = len
+		}
+		copy(ctx.buffer[ctx.offset:], data[:n])
+		ctx.offset += n
+		data = data[n:]
+		len -= n
+
+		if ctx.offset == ghashBlockSize {
+			ghashUpdateBlock(ctx, ctx.buffer[:])
+			ctx.offset = 0
+		}
+	}
+}
+
+// ghashPad - 将不完整块补零后吸收 (GCM 在 AD 与密文之间需要对齐)
+func ghashPad(ctx *ghashContext) {
+	if ctx.offset == 0 {
+		return
+	}
+	for i := ctx.offset; i < ghashBlockSize; i++ {
+		ctx.buffer[i] = 0
+	}
+	ghashUpdateBlock(ctx, ctx.buffer[:])
+	ctx.offset = 0
+}
+
+// ghashFinalize - 输出当前累加器 (调用前需已 ghashPad)
+func ghashFinalize(ctx *ghashContext, out *[ghashBlockSize]byte) {
+	ghashPad(ctx)
+	binary.BigEndian.PutUint64(out[0:8], ctx.y.low)
+	binary.BigEndian.PutUint64(out[8:16], ctx.y.high)
+}
+
+// ============================================================================
+// 宿主接口: GHASH 原语
+// 供混合方案使用 (AES-CTR 由 Web Crypto 完成，认证在 Wasm 内)，用法同 sha256Init/Update/Final
+// ============================================================================
+
+const maxGhashContexts = 32
+
+var ghashContexts [maxGhashContexts]ghashContext
+var ghashContextUsed [maxGhashContexts]uint8
+
+// ghashHostInit - 以 arena[hPtr:hPtr+16] 中的哈希子密钥 H 分配并初始化一个 GHASH 上下文
+// 返回值: 句柄 (>=0), -1 表示无空闲槽位, -2 H 越界
+//
+//export ghashInit
+func ghashHostInit(hPtr uint32) int32 {
+	if !inArenaRange(hPtr, ghashBlockSize) {
+		return -2
+	}
+	for i := int32(0); i < maxGhashContexts; i++ {
+		if ghashContextUsed[i] == 0 {
+			ghashContextUsed[i] = 1
+			var h [ghashBlockSize]byte
+			copy(h[:], arena[hPtr:hPtr+ghashBlockSize])
+			ghashInit(&ghashContexts[i], &h)
+			return i
+		}
+	}
+	return -1
+}
+
+// ghashHostUpdate - 吸收 arena[dataPtr:dataPtr+dataLen]
+// 不完整块跨调用缓冲；pad 非 0 时吸收后将残缺块补零 (GCM 在 AD 与密文之间需要对齐)
+// 返回值: 0 成功, -1 句柄无效, -2 数据越界
+//
+//export ghashUpdate
+func ghashHostUpdate(h int32, dataPtr uint32, dataLen uint32, pad uint32) int32 {
+	if h < 0 || h >= maxGhashContexts || ghashContextUsed[h] == 0 {
+		return -1
+	}
+	if !inArenaRange(dataPtr, dataLen) {
+		return -2
+	}
+	ghashUpdate(&ghashContexts[h], arena[dataPtr:dataPtr+dataLen], int(dataLen))
+	if pad != 0 {
+		ghashPad(&ghashContexts[h])
+	}
+	return 0
+}
+
+// ghashHostFinal - 补零后输出 16 字节累加器到 arena[outPtr:]，并释放句柄
+// 长度块 (len(AD) || len(C)) 由宿主作为最后一次 update 传入
+// 返回值: 0 成功, -1 句柄无效, -2 输出越界
+//
+//export ghashFinal
+func ghashHostFinal(h int32, outPtr uint32) int32 {
+	if h < 0 || h >= maxGhashContexts || ghashContextUsed[h] == 0 {
+		return -1
+	}
+	if !inArenaRange(outPtr, ghashBlockSize) {
+		return -2
+	}
+	var sum [ghashBlockSize]byte
+	ghashFinalize(&ghashContexts[h], &sum)
+	copy(arena[outPtr:outPtr+ghashBlockSize], sum[:])
+
+	// 清除上下文 (productTable 由 H 导出)
+	ghashContexts[h] = ghashContext{}
+	ghashContextUsed[h] = 0
+	return 0
+}
@@ -0,0 +1,92 @@
+// HMAC-SHA256 / HKDF-SHA256 - 从 Go 标准库 crypto/hmac 与 golang.org/x/crypto/hkdf 移植
+// 官方源码:
+// - https://github.com/golang/go/blob/master/src/crypto/hmac/hmac.go
+// - https://github.com/golang/crypto/blob/master/hkdf/hkdf.go
+// 移植规则:
+// 1. 移除 hash.Hash 接口，直接使用 sha256Context
+// 2. 输出写入调用方提供的固定缓冲区
+
+package sudoku
+
+// hmacContext - HMAC-SHA256 上下文
+type hmacContext struct {
+	inner sha256Context
+	outer sha256Context
+}
+
+// hmacInit - 以密钥初始化 (密钥长于块大小时先哈希)
+func hmacInit(ctx *hmacContext, key []byte) {
+	var k [sha256BlockSize]byte
+	if len(key) > sha256BlockSize {
+		var sum [sha256Size]byte
+		sha256Sum(&sum, key)
+		copy(k[:], sum[:])
+	} else {
+		copy(k[:], key)
+	}
+
+	var pad [sha256BlockSize]byte
+	for i := 0; i < sha256BlockSize; i++ {
+		pad[i] = k[i] ^ 0x36
+	}
+	sha256Init(&ctx.inner)
+	sha256Update(&ctx.inner, pad[:], sha256BlockSize)
+
+	for i := 0; i < sha256BlockSize; i++ {
+		pad[i] = k[i] ^ 0x5c
+	}
+	sha256Init(&ctx.outer)
+	sha256Update(&ctx.outer, pad[:], sha256BlockSize)
+}
+
+// hmacUpdate - 吸收消息
+func hmacUpdate(ctx *hmacContext, data []byte, len int) {
+	sha256Update(&ctx.inner, data, len)
+}
+
+// hmacFinalize - 输出 MAC
+func hmacFinalize(ctx *hmacContext, out *[sha256Size]byte) {
+	var innerSum [sha256Size]byte
+	sha256Finalize(&ctx.inner, &innerSum)
+	sha256Update(&ctx.outer, innerSum[:], sha256Size)
+	sha256Finalize(&ctx.outer, out)
+}
+
+// hkdfExtract - PRK = HMAC(salt, ikm)
+// salt 为空时使用 32 字节全零 (RFC 5869)
+func hkdfExtract(prk *[sha256Size]byte, salt []byte, ikm []byte) {
+	var zeroSalt [sha256Size]byte
+	if len(salt) == 0 {
+		salt = zeroSalt[:]
+	}
+	var ctx hmacContext
+	hmacInit(&ctx, salt)
+	hmacUpdate(&ctx, ikm, len(ikm))
+	hmacFinalize(&ctx, prk)
+}
+
+// hkdfExpand - OKM = T(1) || T(2) || ...，T(i) = HMAC(PRK, T(i-1) || info || i)
+// 返回值: 写入 out 的字节数 (out 长度超过 255*32 时返回 0)
+func hkdfExpand(prk *[sha256Size]byte, info []byte, out []byte) int {
+	if len(out) > 255*sha256Size {
+		return 0
+	}
+
+	var t [sha256Size]byte
+	var ctr [1]byte
+	tLen := 0
+	written := 0
+	for counter := byte(1); written < len(out); counter++ {
+		var ctx hmacContext
+		hmacInit(&ctx, prk[:])
+		hmacUpdate(&ctx, t[:], tLen)
+		hmacUpdate(&ctx, info, len(info))
+		ctr[0] = counter
+		hmacUpdate(&ctx, ctr[:], 1)
+		hmacFinalize(&ctx, &t)
+		tLen = sha256Size
+
+		written += copy(out[written:], t[:])
+	}
+	return written
+}
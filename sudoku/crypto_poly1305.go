@@ -4,7 +4,7 @@
 // 1. 使用固定数组，无 slice
 // 2. 保持数学运算完全等价
 
-package main
+package sudoku
 
 import (
 	"encoding/binary"
@@ -81,6 +81,16 @@ func shiftRightBy2(a uint128) uint128 {
 	return a
 }
 
+// p = 2^130 - 5 的三个 64 位分量
+const (
+	poly1305P0 = 0xFFFFFFFFFFFFFFFB
+	poly1305P1 = 0xFFFFFFFFFFFFFFFF
+	poly1305P2 = 0x0000000000000003
+)
+
+// select64 - v == 1 时返回 x，v == 0 时返回 y (常量时间)
+func select64(v, x, y uint64) uint64 { return ^(v-1)&x | (v-1)&y }
+
 const maskLow2Bits = 0x3
 const maskNotLow2Bits = ^uint64(0x3)
 
@@ -148,9 +158,10 @@ func poly1305UpdateBlock(state *macState, msg []byte, isFinal bool) {
 }
 
 // poly1305Update - 更新消息
-// 移植自 Write
+// 移植自 Write: 先补齐缓冲区中的残缺块，完整块直接从 data 读取 (每轮 4 块)，
+// 只有末尾不足 16 字节的部分进入 ctx.buffer
 func poly1305Update(ctx *poly1305Context, data []byte, len int) {
-	for len > 0 {
+	if ctx.offset > 0 {
 		n := poly1305TagSize - ctx.offset
 		if n > len {
 			n = len
@@ -160,10 +171,31 @@ func poly1305Update(ctx *poly1305Context, data []byte, len int) {
 		data = data[n:]
 		len -= n
 		
-		if ctx.offset == poly1305TagSize {
-			poly1305UpdateBlock(&ctx.state, ctx.buffer[:], false)
-			ctx.offset = 0
+		if ctx.offset < poly1305TagSize {
+			return
 		}
+		poly1305UpdateBlock(&ctx.state, ctx.buffer[:], false)
+		ctx.offset = 0
+	}
+	
+	// 批量处理完整块，无中间拷贝
+	for len >= 4*poly1305TagSize {
+		poly1305UpdateBlock(&ctx.state, data[0:16], false)
+		poly1305UpdateBlock(&ctx.state, data[16:32], false)
+		poly1305UpdateBlock(&ctx.state, data[32:48], false)
+		poly1305UpdateBlock(&ctx.state, data[48:64], false)
+		data = data[4*poly1305TagSize:]
+		len -= 4 * poly1305TagSize
+	}
+	for len >= poly1305TagSize {
+		poly1305UpdateBlock(&ctx.state, data[:poly1305TagSize], false)
+		data = data[poly1305TagSize:]
+		len -= poly1305TagSize
+	}
+	
+	if len > 0 {
+		copy(ctx.buffer[:], data[:len])
+		ctx.offset = len
 	}
 }
 
@@ -177,29 +209,17 @@ func poly1305Finalize(ctx *poly1305Context, out *[poly1305TagSize]byte) {
 	
 	state := ctx.state
 	
-	// 完全模约简
-	// h = (h mod 2^130) + 5 * (h >> 130)
-	// 如果 h >= 2^130 - 5，则 h -= 2^130 - 5
-	
-	// 计算 g = h + 5
-	g0, c := bits.Add64(state.h[0], 5, 0)
-	g1, c := bits.Add64(state.h[1], 0, c)
-	g2 := state.h[2] + c
+	// 完全模约简 (移植自 finalize)
+	// 计算 t = h - (2^130 - 5)，若减法借位 (h < p) 则保留 h，否则取 t
+	hMinusP0, b := bits.Sub64(state.h[0], poly1305P0, 0)
+	hMinusP1, b := bits.Sub64(state.h[1], poly1305P1, b)
+	_, b = bits.Sub64(state.h[2], poly1305P2, b)
 	
-	// 如果 g2 的第2位被设置 (即 g >= 2^130)，则使用 g
-	// 否则使用 h
-	mask := uint64(int64(g2>>2) - 1) // 如果 g2 >= 4，则 mask = 0xFFFFFFFFFFFFFFFF
+	h0 := select64(b, state.h[0], hMinusP0)
+	h1 := select64(b, state.h[1], hMinusP1)
 	
-	g0 &= mask
-	g1 &= mask
-	g2 &= mask
-	mask = ^mask
-	
-	h0 := (state.h[0] & mask) | g0
-	h1 := (state.h[1] & mask) | g1
-	
-	// h += s
-	h0, c = bits.Add64(h0, state.s[0], 0)
+	// h += s (mod 2^128)
+	h0, c := bits.Add64(h0, state.s[0], 0)
 	h1, _ = bits.Add64(h1, state.s[1], c)
 	
 	// 输出小端序
@@ -227,3 +247,48 @@ func poly1305Verify(mac *[poly1305TagSize]byte, msg []byte, key *[32]byte) bool
 	}
 	return diff == 0
 }
+
+// ============================================================================
+// 宿主接口: Poly1305 一次性 MAC
+// 供宿主为辅助消息 (如自行签发的令牌) 计算认证标签，key 必须只用于一条消息
+// ============================================================================
+
+// poly1305HostSum - 以 arena[keyPtr:keyPtr+32] 为一次性密钥，计算 arena[msgPtr:msgPtr+msgLen] 的 16 字节标签写入 arena[outPtr:]
+// 返回值: 0 成功, -1 密钥或消息越界, -2 输出越界
+//
+//export poly1305Sum
+func poly1305HostSum(keyPtr uint32, msgPtr uint32, msgLen uint32, outPtr uint32) int32 {
+	if !inArenaRange(keyPtr, 32) || !inArenaRange(msgPtr, msgLen) {
+		return -1
+	}
+	if !inArenaRange(outPtr, poly1305TagSize) {
+		return -2
+	}
+	var key [32]byte
+	var tag [poly1305TagSize]byte
+	copy(key[:], arena[keyPtr:keyPtr+32])
+	poly1305Sum(&tag, arena[msgPtr:msgPtr+msgLen], &key)
+	copy(arena[outPtr:outPtr+poly1305TagSize], tag[:])
+	key = [32]byte{}
+	return 0
+}
+
+// poly1305HostVerify - 常量时间比较 arena[tagPtr:tagPtr+16] 与消息的标签
+// 返回值: 1 标签一致, 0 不一致, -1 输入越界
+//
+//export poly1305Verify
+func poly1305HostVerify(keyPtr uint32, msgPtr uint32, msgLen uint32, tagPtr uint32) int32 {
+	if !inArenaRange(keyPtr, 32) || !inArenaRange(msgPtr, msgLen) || !inArenaRange(tagPtr, poly1305TagSize) {
+		return -1
+	}
+	var key [32]byte
+	var tag [poly1305TagSize]byte
+	copy(key[:], arena[keyPtr:keyPtr+32])
+	copy(tag[:], arena[tagPtr:tagPtr+poly1305TagSize])
+	ok := poly1305Verify(&tag, arena[msgPtr:msgPtr+msgLen], &key)
+	key = [32]byte{}
+	if !ok {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,209 @@
+// SHA-256 - 从 Go 标准库 crypto/sha256 移植
+// 官方源码: https://github.com/golang/go/blob/master/src/crypto/internal/fips140/sha256/sha256block.go
+// 移植规则:
+// 1. 使用固定数组，无 slice 分配
+// 2. 保持压缩函数与官方实现位级等价
+
+package sudoku
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	sha256Size      = 32
+	sha256BlockSize = 64
+)
+
+var sha256K = [64]uint32{
+	0x428a2f98, 0x71374491, 0xb5c0fbcf, 0xe9b5dba5,
+	0x3956c25b, 0x59f111f1, 0x923f82a4, 0xab1c5ed5,
+	0xd807aa98, 0x12835b01, 0x243185be, 0x550c7dc3,
+	0x72be5d74, 0x80deb1fe, 0x9bdc06a7, 0xc19bf174,
+	0xe49b69c1, 0xefbe4786, 0x0fc19dc6, 0x240ca1cc,
+	0x2de92c6f, 0x4a7484aa, 0x5cb0a9dc, 0x76f988da,
+	0x983e5152, 0xa831c66d, 0xb00327c8, 0xbf597fc7,
+	0xc6e00bf3, 0xd5a79147, 0x06ca6351, 0x14292967,
+	0x27b70a85, 0x2e1b2138, 0x4d2c6dfc, 0x53380d13,
+	0x650a7354, 0x766a0abb, 0x81c2c92e, 0x92722c85,
+	0xa2bfe8a1, 0xa81a664b, 0xc24b8b70, 0xc76c51a3,
+	0xd192e819, 0xd6990624, 0xf40e3585, 0x106aa070,
+	0x19a4c116, 0x1e376c08, 0x2748774c, 0x34b0bcb5,
+	0x391c0cb3, 0x4ed8aa4a, 0x5b9cca4f, 0x682e6ff3,
+	0x748f82ee, 0x78a5636f, 0x84c87814, 0x8cc70208,
+	0x90befffa, 0xa4506ceb, 0xbef9a3f7, 0xc67178f2,
+}
+
+// sha256Context - SHA-256 上下文
+type sha256Context struct {
+	h      [8]uint32
+	buffer [sha256BlockSize]byte
+	offset int
+	length uint64 // 已吸收的总字节数
+}
+
+// sha256Init - 初始化
+func sha256Init(ctx *sha256Context) {
+	ctx.h[0] = 0x6a09e667
+	ctx.h[1] = 0xbb67ae85
+	ctx.h[2] = 0x3c6ef372
+	ctx.h[3] = 0xa54ff53a
+	ctx.h[4] = 0x510e527f
+	ctx.h[5] = 0x9b05688c
+	ctx.h[6] = 0x1f83d9ab
+	ctx.h[7] = 0x5be0cd19
+	ctx.offset = 0
+	ctx.length = 0
+}
+
+// sha256Block - 压缩一个 64 字节块
+// 移植自 blockGeneric
+func sha256Block(ctx *sha256Context, p []byte) {
+	var w [64]uint32
+	for i := 0; i < 16; i++ {
+		w[i] = binary.BigEndian.Uint32(p[i*4:])
+	}
+	for i := 16; i < 64; i++ {
+		v1 := w[i-2]
+		t1 := (bits.RotateLeft32(v1, -17)) ^ (bits.RotateLeft32(v1, -19)) ^ (v1 >> 10)
+		v2 := w[i-15]
+		t2 := (bits.RotateLeft32(v2, -7)) ^ (bits.RotateLeft32(v2, -18)) ^ (v2 >> 3)
+		w[i] = t1 + w[i-7] + t2 + w[i-16]
+	}
+
+	a, b, c, d, e, f, g, h := ctx.h[0], ctx.h[1], ctx.h[2], ctx.h[3], ctx.h[4], ctx.h[5], ctx.h[6], ctx.h[7]
+
+	for i := 0; i < 64; i++ {
+		t1 := h + ((bits.RotateLeft32(e, -6)) ^ (bits.RotateLeft32(e, -11)) ^ (bits.RotateLeft32(e, -25))) + ((e & f) ^ (^e & g)) + sha256K[i] + w[i]
+		t2 := ((bits.RotateLeft32(a, -2)) ^ (bits.RotateLeft32(a, -13)) ^ (bits.RotateLeft32(a, -22))) + ((a & b) ^ (a & c) ^ (b & c))
+
+		h = g
+		g = f
+		f = e
+		e = d + t1
+		d = c
+		c = b
+		b = a
+		a = t1 + t2
+	}
+
+	ctx.h[0] += a
+	ctx.h[1] += b
+	ctx.h[2] += c
+	ctx.h[3] += d
+	ctx.h[4] += e
+	ctx.h[5] += f
+	ctx.h[6] += g
+	ctx.h[7] += h
+}
+
+// sha256Update - 吸收数据
+// 移植自 Write
+func sha256Update(ctx *sha256Context, data []byte, len int) {
+	ctx.length += uint64(len)
+	for len > 0 {
+		n := sha256BlockSize - ctx.offset
+		if n > len {
+			n = len
+		}
+		copy(ctx.buffer[ctx.offset:], data[:n])
+		ctx.offset += n
+		data = data[n:]
+		len -= n
+
+		if ctx.offset == sha256BlockSize {
+			sha256Block(ctx, ctx.buffer[:])
+			ctx.offset = 0
+		}
+	}
+}
+
+// sha256Finalize - 填充并输出摘要
+// 移植自 checkSum
+func sha256Finalize(ctx *sha256Context, out *[sha256Size]byte) {
+	bitLen := ctx.length << 3
+
+	// 填充: 0x80 + 0x00... 至 56 mod 64，再追加 64 位长度
+	var pad [sha256BlockSize + 8]byte
+	pad[0] = 0x80
+	padLen := 56 - ctx.offset
+	if ctx.offset >= 56 {
+		padLen += sha256BlockSize
+	}
+	binary.BigEndian.PutUint64(pad[padLen:], bitLen)
+	sha256Update(ctx, pad[:], padLen+8)
+
+	for i := 0; i < 8; i++ {
+		binary.BigEndian.PutUint32(out[i*4:], ctx.h[i])
+	}
+}
+
+// sha256Sum - 一次性计算摘要
+func sha256Sum(out *[sha256Size]byte, data []byte) {
+	var ctx sha256Context
+	sha256Init(&ctx)
+	sha256Update(&ctx, data, len(data))
+	sha256Finalize(&ctx, out)
+}
+
+// ============================================================================
+// 宿主接口: 握手 transcript 哈希
+// 上下文保存在固定槽位中，宿主通过句柄增量计算，无需 JS 侧哈希库
+// ============================================================================
+
+const maxHashContexts = 32
+
+var hashContexts [maxHashContexts]sha256Context
+var hashContextUsed [maxHashContexts]uint8
+
+// sha256HostInit - 分配并初始化一个 SHA-256 上下文
+// 返回值: 句柄 (>=0), -1 表示无空闲槽位
+//
+//export sha256Init
+func sha256HostInit() int32 {
+	for i := int32(0); i < maxHashContexts; i++ {
+		if hashContextUsed[i] == 0 {
+			hashContextUsed[i] = 1
+			sha256Init(&hashContexts[i])
+			return i
+		}
+	}
+	return -1
+}
+
+// sha256HostUpdate - 吸收 arena[dataPtr:dataPtr+dataLen]
+// 返回值: 0 成功, -1 句柄无效, -2 数据越界
+//
+//export sha256Update
+func sha256HostUpdate(h int32, dataPtr uint32, dataLen uint32) int32 {
+	if h < 0 || h >= maxHashContexts || hashContextUsed[h] == 0 {
+		return -1
+	}
+	if !inArenaRange(dataPtr, dataLen) {
+		return -2
+	}
+	sha256Update(&hashContexts[h], arena[dataPtr:dataPtr+dataLen], int(dataLen))
+	return 0
+}
+
+// sha256HostFinal - 输出 32 字节摘要到 arena[outPtr:]，并释放句柄
+// 返回值: 0 成功, -1 句柄无效, -2 输出越界
+//
+//export sha256Final
+func sha256HostFinal(h int32, outPtr uint32) int32 {
+	if h < 0 || h >= maxHashContexts || hashContextUsed[h] == 0 {
+		return -1
+	}
+	if !inArenaRange(outPtr, sha256Size) {
+		return -2
+	}
+	var sum [sha256Size]byte
+	sha256Finalize(&hashContexts[h], &sum)
+	copy(arena[outPtr:outPtr+sha256Size], sum[:])
+
+	// 清除上下文，避免残留 transcript 状态
+	hashContexts[h] = sha256Context{}
+	hashContextUsed[h] = 0
+	return 0
+}
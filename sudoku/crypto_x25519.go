@@ -0,0 +1,315 @@
+// X25519 - 从 Go 标准库 crypto/ecdh 与 crypto/internal/fips140/edwards25519/field 移植
+// 官方源码:
+// - https://github.com/golang/go/blob/master/src/crypto/ecdh/x25519.go
+// - https://github.com/golang/go/blob/master/src/crypto/internal/fips140/edwards25519/field/fe_generic.go
+// 移植规则:
+// 1. 域元素为 5 个 51 位分量的固定数组，无堆分配
+// 2. Montgomery ladder 与官方实现步骤一致 (常量时间交换)
+
+package sudoku
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const x25519Size = 32
+
+// fieldElement - GF(2^255-19) 元素，值为 l[0] + l[1]*2^51 + ... + l[4]*2^204
+type fieldElement [5]uint64
+
+const maskLow51Bits = (1 << 51) - 1
+
+var x25519Basepoint = [x25519Size]byte{9}
+
+// shiftRightBy51 - 128 位值右移 51 位 (结果不超过 64 位)
+func shiftRightBy51(a uint128) uint64 {
+	return a.hi<<(64-51) | a.lo>>51
+}
+
+// addMul64 - v + a*b
+func addMul64(v uint128, a, b uint64) uint128 {
+	hi, lo := bits.Mul64(a, b)
+	lo, c := bits.Add64(lo, v.lo, 0)
+	hi, _ = bits.Add64(hi, v.hi, c)
+	return uint128{lo, hi}
+}
+
+// feCarryPropagate - 将各分量约简到 52 位以内
+// 移植自 carryPropagateGeneric
+func feCarryPropagate(v *fieldElement) {
+	c0 := v[0] >> 51
+	c1 := v[1] >> 51
+	c2 := v[2] >> 51
+	c3 := v[3] >> 51
+	c4 := v[4] >> 51
+
+	v[0] = v[0]&maskLow51Bits + c4*19
+	v[1] = v[1]&maskLow51Bits + c0
+	v[2] = v[2]&maskLow51Bits + c1
+	v[3] = v[3]&maskLow51Bits + c2
+	v[4] = v[4]&maskLow51Bits + c3
+}
+
+// feMul - v = a * b
+// 移植自 feMulGeneric
+func feMul(v, a, b *fieldElement) {
+	a0, a1, a2, a3, a4 := a[0], a[1], a[2], a[3], a[4]
+	b0, b1, b2, b3, b4 := b[0], b[1], b[2], b[3], b[4]
+
+	// 2^255 = 19 (mod p)，高位分量乘 19 折回低位
+	a1_19 := a1 * 19
+	a2_19 := a2 * 19
+	a3_19 := a3 * 19
+	a4_19 := a4 * 19
+
+	r0 := mul64(a0, b0)
+	r0 = addMul64(r0, a1_19, b4)
+	r0 = addMul64(r0, a2_19, b3)
+	r0 = addMul64(r0, a3_19, b2)
+	r0 = addMul64(r0, a4_19, b1)
+
+	r1 := mul64(a0, b1)
+	r1 = addMul64(r1, a1, b0)
+	r1 = addMul64(r1, a2_19, b4)
+	r1 = addMul64(r1, a3_19, b3)
+	r1 = addMul64(r1, a4_19, b2)
+
+	r2 := mul64(a0, b2)
+	r2 = addMul64(r2, a1, b1)
+	r2 = addMul64(r2, a2, b0)
+	r2 = addMul64(r2, a3_19, b4)
+	r2 = addMul64(r2, a4_19, b3)
+
+	r3 := mul64(a0, b3)
+	r3 = addMul64(r3, a1, b2)
+	r3 = addMul64(r3, a2, b1)
+	r3 = addMul64(r3, a3, b0)
+	r3 = addMul64(r3, a4_19, b4)
+
+	r4 := mul64(a0, b4)
+	r4 = addMul64(r4, a1, b3)
+	r4 = addMul64(r4, a2, b2)
+	r4 = addMul64(r4, a3, b1)
+	r4 = addMul64(r4, a4, b0)
+
+	c0 := shiftRightBy51(r0)
+	c1 := shiftRightBy51(r1)
+	c2 := shiftRightBy51(r2)
+	c3 := shiftRightBy51(r3)
+	c4 := shiftRightBy51(r4)
+
+	v[0] = r0.lo&maskLow51Bits + c4*19
+	v[1] = r1.lo&maskLow51Bits + c0
+	v[2] = r2.lo&maskLow51Bits + c1
+	v[3] = r3.lo&maskLow51Bits + c2
+	v[4] = r4.lo&maskLow51Bits + c3
+	feCarryPropagate(v)
+}
+
+// feSquare - v = a * a
+func feSquare(v, a *fieldElement) {
+	feMul(v, a, a)
+}
+
+// feAdd - v = a + b
+func feAdd(v, a, b *fieldElement) {
+	for i := 0; i < 5; i++ {
+		v[i] = a[i] + b[i]
+	}
+	feCarryPropagate(v)
+}
+
+// feSub - v = a - b (先加 2p 避免下溢)
+func feSub(v, a, b *fieldElement) {
+	v[0] = (a[0] + 0xFFFFFFFFFFFDA) - b[0]
+	v[1] = (a[1] + 0xFFFFFFFFFFFFE) - b[1]
+	v[2] = (a[2] + 0xFFFFFFFFFFFFE) - b[2]
+	v[3] = (a[3] + 0xFFFFFFFFFFFFE) - b[3]
+	v[4] = (a[4] + 0xFFFFFFFFFFFFE) - b[4]
+	feCarryPropagate(v)
+}
+
+// feSwap - cond == 1 时交换 a 与 b (常量时间)
+func feSwap(a, b *fieldElement, cond uint64) {
+	mask := -cond
+	for i := 0; i < 5; i++ {
+		t := mask & (a[i] ^ b[i])
+		a[i] ^= t
+		b[i] ^= t
+	}
+}
+
+// feInvert - v = 1/z = z^(p-2)
+// 移植自 Element.Invert 的加法链
+func feInvert(v, z *fieldElement) {
+	var z2, z9, z11, z2_5_0, z2_10_0, z2_20_0, z2_50_0, z2_100_0, t fieldElement
+
+	feSquare(&z2, z)        // 2
+	feSquare(&t, &z2)       // 4
+	feSquare(&t, &t)        // 8
+	feMul(&z9, &t, z)       // 9
+	feMul(&z11, &z9, &z2)   // 11
+	feSquare(&t, &z11)      // 22
+	feMul(&z2_5_0, &t, &z9) // 31 = 2^5 - 2^0
+
+	feSquare(&t, &z2_5_0) // 2^6 - 2^1
+	for i := 0; i < 4; i++ {
+		feSquare(&t, &t) // 2^10 - 2^5
+	}
+	feMul(&z2_10_0, &t, &z2_5_0) // 2^10 - 2^0
+
+	feSquare(&t, &z2_10_0) // 2^11 - 2^1
+	for i := 0; i < 9; i++ {
+		feSquare(&t, &t) // 2^20 - 2^10
+	}
+	feMul(&z2_20_0, &t, &z2_10_0) // 2^20 - 2^0
+
+	feSquare(&t, &z2_20_0) // 2^21 - 2^1
+	for i := 0; i < 19; i++ {
+		feSquare(&t, &t) // 2^40 - 2^20
+	}
+	feMul(&t, &t, &z2_20_0) // 2^40 - 2^0
+
+	feSquare(&t, &t) // 2^41 - 2^1
+	for i := 0; i < 9; i++ {
+		feSquare(&t, &t) // 2^50 - 2^10
+	}
+	feMul(&z2_50_0, &t, &z2_10_0) // 2^50 - 2^0
+
+	feSquare(&t, &z2_50_0) // 2^51 - 2^1
+	for i := 0; i < 49; i++ {
+		feSquare(&t, &t) // 2^100 - 2^50
+	}
+	feMul(&z2_100_0, &t, &z2_50_0) // 2^100 - 2^0
+
+	feSquare(&t, &z2_100_0) // 2^101 - 2^1
+	for i := 0; i < 99; i++ {
+		feSquare(&t, &t) // 2^200 - 2^100
+	}
+	feMul(&t, &t, &z2_100_0) // 2^200 - 2^0
+
+	feSquare(&t, &t) // 2^201 - 2^1
+	for i := 0; i < 49; i++ {
+		feSquare(&t, &t) // 2^250 - 2^50
+	}
+	feMul(&t, &t, &z2_50_0) // 2^250 - 2^0
+
+	for i := 0; i < 5; i++ {
+		feSquare(&t, &t) // 2^255 - 2^5
+	}
+	feMul(v, &t, &z11) // 2^255 - 21
+}
+
+// feFromBytes - 小端序 32 字节解码 (忽略最高位)
+// 移植自 Element.SetBytes
+func feFromBytes(v *fieldElement, x *[x25519Size]byte) {
+	v[0] = binary.LittleEndian.Uint64(x[0:8]) & maskLow51Bits
+	v[1] = (binary.LittleEndian.Uint64(x[6:14]) >> 3) & maskLow51Bits
+	v[2] = (binary.LittleEndian.Uint64(x[12:20]) >> 6) & maskLow51Bits
+	v[3] = (binary.LittleEndian.Uint64(x[19:27]) >> 1) & maskLow51Bits
+	v[4] = (binary.LittleEndian.Uint64(x[24:32]) >> 12) & maskLow51Bits
+}
+
+// feToBytes - 完全约简后按小端序编码
+// 移植自 Element.reduce 与 Element.bytes
+func feToBytes(out *[x25519Size]byte, a *fieldElement) {
+	v := *a
+	feCarryPropagate(&v)
+
+	// v >= p 时 v+19 会进位到 2^255
+	c := (v[0] + 19) >> 51
+	c = (v[1] + c) >> 51
+	c = (v[2] + c) >> 51
+	c = (v[3] + c) >> 51
+	c = (v[4] + c) >> 51
+
+	v[0] += 19 * c
+	v[1] += v[0] >> 51
+	v[0] &= maskLow51Bits
+	v[2] += v[1] >> 51
+	v[1] &= maskLow51Bits
+	v[3] += v[2] >> 51
+	v[2] &= maskLow51Bits
+	v[4] += v[3] >> 51
+	v[3] &= maskLow51Bits
+	v[4] &= maskLow51Bits
+
+	*out = [x25519Size]byte{}
+	for i, l := range v {
+		bitsOffset := i * 51
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], l<<uint(bitsOffset%8))
+		for j, bb := range buf {
+			off := bitsOffset/8 + j
+			if off >= x25519Size {
+				break
+			}
+			out[off] |= bb
+		}
+	}
+}
+
+// x25519ScalarMult - out = scalar * point (RFC 7748)
+// 移植自 x25519ScalarMult
+func x25519ScalarMult(out *[x25519Size]byte, scalar *[x25519Size]byte, point *[x25519Size]byte) {
+	e := *scalar
+	e[0] &= 248
+	e[31] &= 127
+	e[31] |= 64
+
+	var x1, x2, z2, x3, z3, tmp0, tmp1 fieldElement
+	a24 := fieldElement{121666}
+	feFromBytes(&x1, point)
+	x2[0] = 1
+	x3 = x1
+	z3[0] = 1
+
+	swap := uint64(0)
+	for pos := 254; pos >= 0; pos-- {
+		b := uint64(e[pos/8]>>uint(pos&7)) & 1
+		swap ^= b
+		feSwap(&x2, &x3, swap)
+		feSwap(&z2, &z3, swap)
+		swap = b
+
+		feSub(&tmp0, &x3, &z3)
+		feSub(&tmp1, &x2, &z2)
+		feAdd(&x2, &x2, &z2)
+		feAdd(&z2, &x3, &z3)
+		feMul(&z3, &tmp0, &x2)
+		feMul(&z2, &z2, &tmp1)
+		feSquare(&tmp0, &tmp1)
+		feSquare(&tmp1, &x2)
+		feAdd(&x3, &z3, &z2)
+		feSub(&z2, &z3, &z2)
+		feMul(&x2, &tmp1, &tmp0)
+		feSub(&tmp1, &tmp1, &tmp0)
+		feSquare(&z2, &z2)
+		feMul(&z3, &tmp1, &a24)
+		feSquare(&x3, &x3)
+		feAdd(&tmp0, &tmp0, &z3)
+		feMul(&z3, &x1, &z2)
+		feMul(&z2, &tmp1, &tmp0)
+	}
+	feSwap(&x2, &x3, swap)
+	feSwap(&z2, &z3, swap)
+
+	feInvert(&z2, &z2)
+	feMul(&x2, &x2, &z2)
+	feToBytes(out, &x2)
+
+	for i := range e {
+		e[i] = 0
+	}
+}
+
+// x25519 - 计算共享密钥，对端公钥为低阶点 (结果全 0) 时返回 false
+func x25519(out *[x25519Size]byte, scalar *[x25519Size]byte, peer *[x25519Size]byte) bool {
+	x25519ScalarMult(out, scalar, peer)
+	var acc uint8
+	for i := 0; i < x25519Size; i++ {
+		acc |= out[i]
+	}
+	return acc != 0
+}
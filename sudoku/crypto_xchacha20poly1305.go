@@ -0,0 +1,117 @@
+// XChaCha20-Poly1305 AEAD - 从 golang.org/x/crypto/chacha20poly1305 移植
+// 官方源码:
+// - https://github.com/golang/crypto/blob/master/chacha20poly1305/xchacha20poly1305.go
+// - https://github.com/golang/crypto/blob/master/chacha20/chacha_generic.go (HChaCha20)
+// 移植规则:
+// 1. 移除 slice 分配
+// 2. 子密钥派生后复用 chacha20poly1305Seal/Open
+
+package sudoku
+
+import (
+	"encoding/binary"
+)
+
+const xchachaNonceSize = 24
+
+// hchacha20 - 由 key 与 nonce 前 16 字节派生 32 字节子密钥
+// 移植自 HChaCha20
+func hchacha20(key *[32]byte, nonce []byte, out *[32]byte) {
+	x0 := chachaConstants[0]
+	x1 := chachaConstants[1]
+	x2 := chachaConstants[2]
+	x3 := chachaConstants[3]
+	x4 := binary.LittleEndian.Uint32(key[0:4])
+	x5 := binary.LittleEndian.Uint32(key[4:8])
+	x6 := binary.LittleEndian.Uint32(key[8:12])
+	x7 := binary.LittleEndian.Uint32(key[12:16])
+	x8 := binary.LittleEndian.Uint32(key[16:20])
+	x9 := binary.LittleEndian.Uint32(key[20:24])
+	x10 := binary.LittleEndian.Uint32(key[24:28])
+	x11 := binary.LittleEndian.Uint32(key[28:32])
+	x12 := binary.LittleEndian.Uint32(nonce[0:4])
+	x13 := binary.LittleEndian.Uint32(nonce[4:8])
+	x14 := binary.LittleEndian.Uint32(nonce[8:12])
+	x15 := binary.LittleEndian.Uint32(nonce[12:16])
+
+	for i := 0; i < 10; i++ {
+		// 列轮
+		x0, x4, x8, x12 = chachaQuarterRound(x0, x4, x8, x12)
+		x1, x5, x9, x13 = chachaQuarterRound(x1, x5, x9, x13)
+		x2, x6, x10, x14 = chachaQuarterRound(x2, x6, x10, x14)
+		x3, x7, x11, x15 = chachaQuarterRound(x3, x7, x11, x15)
+		// 对角轮
+		x0, x5, x10, x15 = chachaQuarterRound(x0, x5, x10, x15)
+		x1, x6, x11, x12 = chachaQuarterRound(x1, x6, x11, x12)
+		x2, x7, x8, x13 = chachaQuarterRound(x2, x7, x8, x13)
+		x3, x4, x9, x14 = chachaQuarterRound(x3, x4, x9, x14)
+	}
+
+	// 不与初始状态相加，直接输出首行与末行
+	binary.LittleEndian.PutUint32(out[0:4], x0)
+	binary.LittleEndian.PutUint32(out[4:8], x1)
+	binary.LittleEndian.PutUint32(out[8:12], x2)
+	binary.LittleEndian.PutUint32(out[12:16], x3)
+	binary.LittleEndian.PutUint32(out[16:20], x12)
+	binary.LittleEndian.PutUint32(out[20:24], x13)
+	binary.LittleEndian.PutUint32(out[24:28], x14)
+	binary.LittleEndian.PutUint32(out[28:32], x15)
+}
+
+// xchachaSubkey - 计算子密钥与 12 字节内部 nonce ([0 (4)][nonce[16:24]])
+func xchachaSubkey(key *[32]byte, nonce *[xchachaNonceSize]byte, subkey *[32]byte, chachaNonce *[12]byte) {
+	hchacha20(key, nonce[0:16], subkey)
+	chachaNonce[0] = 0
+	chachaNonce[1] = 0
+	chachaNonce[2] = 0
+	chachaNonce[3] = 0
+	copy(chachaNonce[4:12], nonce[16:24])
+}
+
+// xchacha20poly1305Seal - 加密并认证
+// 移植自 xchacha20poly1305.Seal
+// 输出格式: [ciphertext][tag (16 bytes)]
+func xchacha20poly1305Seal(
+	key *[32]byte,
+	nonce *[xchachaNonceSize]byte,
+	plaintext []byte,
+	plaintextLen int,
+	additionalData []byte,
+	adLen int,
+	out []byte,
+) int {
+	var subkey [32]byte
+	var chachaNonce [12]byte
+	xchachaSubkey(key, nonce, &subkey, &chachaNonce)
+
+	n := chacha20poly1305Seal(&subkey, &chachaNonce, plaintext, plaintextLen, additionalData, adLen, out)
+
+	for i := range subkey {
+		subkey[i] = 0
+	}
+	return n
+}
+
+// xchacha20poly1305Open - 解密并验证
+// 移植自 xchacha20poly1305.Open
+// 返回值: 明文长度，如果验证失败返回 -1
+func xchacha20poly1305Open(
+	key *[32]byte,
+	nonce *[xchachaNonceSize]byte,
+	ciphertextAndTag []byte,
+	ctLen int,
+	additionalData []byte,
+	adLen int,
+	out []byte,
+) int {
+	var subkey [32]byte
+	var chachaNonce [12]byte
+	xchachaSubkey(key, nonce, &subkey, &chachaNonce)
+
+	n := chacha20poly1305Open(&subkey, &chachaNonce, ciphertextAndTag, ctLen, additionalData, adLen, out)
+
+	for i := range subkey {
+		subkey[i] = 0
+	}
+	return n
+}
@@ -0,0 +1,1440 @@
+// Code generated by gen_data.go; DO NOT EDIT.
+package sudoku
+
+var grid9EncodeTable = [256][grid9MaxGroups][2]uint16{
+	{{347, 682},{0, 547},{172, 237},{311, 682},{69, 279},{323, 375},{32, 237},{246, 364},{86, 166},{237, 323},{93, 657},{25, 69},{323, 602},{25, 354},{364, 421},{627, 667},{0, 25},{0, 205},{0, 44},{73, 563},{107, 237},{48, 421},{32, 186},{279, 667},},
+	{{140, 341},{132, 147},{435, 524},{254, 345},{147, 165},{140, 601},{15, 445},{52, 147},{211, 371},{15, 249},{445, 632},{147, 572},{197, 401},{249, 262},{52, 572},{15, 460},{249, 723},{20, 517},{345, 494},{15, 640},{341, 591},{62, 262},{64, 709},{76, 611},},
+	{{165, 323},{502, 698},{180, 578},{288, 388},{522, 561},{20, 678},{71, 180},{502, 514},{492, 667},{106, 323},{71, 149},{452, 618},{34, 256},{20, 492},{492, 656},{57, 686},{165, 405},{217, 724},{472, 678},{203, 217},{4, 522},{203, 299},{106, 191},{180, 376},},
+	{{405, 592},{141, 164},{331, 504},{183, 295},{489, 568},{100, 466},{50, 100},{366, 615},{355, 458},{34, 691},{235, 446},{75, 615},{355, 489},{235, 418},{331, 565},{17, 34},{63, 141},{86, 297},{232, 603},{97, 530},{37, 458},{50, 202},{355, 568},{50, 171},},
+	{{125, 673},{127, 259},{94, 621},{86, 125},{226, 477},{244, 338},{77, 724},{102, 177},{66, 338},{66, 110},{19, 160},{318, 590},{19, 427},{236, 538},{224, 656},{169, 427},{226, 416},{17, 506},{338, 554},{45, 318},{34, 226},{110, 590},{244, 490},{604, 681},},
+	{{157, 719},{134, 403},{209, 353},{83, 372},{60, 593},{353, 463},{74, 719},{348, 618},{21, 530},{140, 506},{622, 705},{289, 299},{353, 536},{8, 134},{83, 693},{496, 572},{353, 639},{157, 353},{74, 416},{289, 348},{299, 446},{176, 260},{423, 639},{83, 209},},
+	{{523, 536},{106, 666},{70, 269},{46, 634},{86, 481},{353, 390},{549, 612},{194, 227},{315, 497},{106, 597},{93, 353},{214, 256},{46, 681},{128, 627},{216, 304},{476, 634},{201, 284},{497, 681},{492, 597},{106, 406},{666, 721},{166, 664},{70, 716},{86, 497},},
+	{{258, 269},{39, 511},{226, 513},{112, 559},{70, 660},{184, 347},{292, 646},{203, 360},{112, 184},{9, 456},{70, 605},{426, 536},{5, 84},{178, 442},{105, 542},{5, 559},{155, 405},{379, 482},{184, 513},{360, 701},{226, 716},{133, 360},{207, 492},{46, 585},},
+	{{95, 653},{546, 653},{139, 287},{57, 488},{252, 614},{312, 700},{87, 577},{454, 722},{57, 213},{341, 420},{126, 247},{87, 272},{268, 614},{170, 370},{480, 504},{173, 594},{591, 633},{291, 476},{173, 439},{247, 316},{521, 574},{247, 685},{126, 536},{102, 454},},
+	{{161, 553},{108, 130},{108, 578},{191, 251},{519, 708},{624, 722},{181, 221},{336, 670},{251, 331},{17, 617},{105, 708},{311, 331},{321, 578},{291, 321},{397, 662},{42, 231},{331, 578},{42, 375},{638, 649},{52, 553},{54, 97},{231, 251},{175, 708},{147, 678},},
+	{{487, 533},{132, 676},{62, 611},{442, 540},{274, 306},{207, 223},{186, 285},{147, 583},{34, 380},{266, 487},{295, 487},{388, 635},{401, 540},{132, 519},{253, 540},{223, 380},{442, 718},{132, 408},{18, 227},{175, 670},{223, 245},{121, 611},{92, 611},{201, 611},},
+	{{468, 590},{64, 368},{26, 127},{260, 281},{273, 368},{320, 368},{369, 596},{169, 329},{427, 540},{203, 633},{261, 467},{47, 391},{281, 618},{64, 596},{260, 596},{560, 633},{127, 169},{140, 583},{222, 709},{140, 560},{329, 676},{0, 260},{536, 676},{260, 625},},
+	{{89, 665},{355, 666},{45, 605},{559, 665},{442, 723},{244, 529},{121, 716},{90, 401},{375, 617},{420, 704},{110, 507},{147, 500},{74, 327},{225, 665},{90, 559},{182, 559},{437, 639},{405, 475},{147, 420},{437, 586},{121, 639},{240, 586},{44, 295},{485, 617},},
+	{{512, 701},{244, 677},{167, 173},{16, 317},{268, 536},{381, 488},{173, 725},{526, 633},{224, 395},{311, 423},{167, 623},{35, 590},{344, 376},{6, 167},{564, 648},{211, 268},{224, 452},{273, 672},{16, 564},{151, 648},{157, 672},{422, 574},{211, 540},{90, 338},},
+	{{147, 376},{341, 564},{344, 568},{273, 521},{60, 615},{224, 576},{64, 273},{478, 576},{25, 711},{426, 610},{324, 366},{262, 643},{32, 324},{250, 556},{532, 711},{201, 593},{89, 543},{317, 508},{434, 500},{104, 556},{317, 341},{201, 564},{60, 478},{352, 695},},
+	{{293, 652},{203, 718},{652, 678},{280, 467},{482, 522},{68, 652},{39, 304},{55, 161},{371, 399},{280, 312},{432, 515},{261, 630},{420, 557},{126, 469},{95, 147},{68, 293},{190, 278},{39, 432},{24, 350},{568, 618},{256, 391},{24, 610},{100, 445},{234, 280},},
+	{{106, 220},{206, 516},{275, 342},{469, 652},{162, 383},{516, 538},{45, 131},{239, 629},{361, 588},{538, 652},{275, 652},{213, 361},{136, 146},{465, 636},{337, 413},{228, 694},{173, 357},{181, 445},{516, 596},{22, 327},{71, 501},{5, 516},{146, 538},{239, 716},},
+	{{164, 313},{430, 590},{221, 566},{294, 498},{305, 520},{171, 190},{229, 334},{49, 328},{56, 540},{204, 246},{280, 584},{43, 661},{334, 348},{110, 661},{254, 419},{49, 117},{313, 376},{56, 280},{72, 554},{229, 506},{398, 492},{261, 387},{628, 675},{147, 708},},
+	{{173, 682},{353, 411},{41, 491},{253, 690},{310, 648},{411, 690},{105, 660},{9, 310},{462, 511},{9, 21},{220, 540},{156, 332},{589, 595},{310, 719},{361, 411},{230, 612},{192, 682},{396, 458},{65, 240},{85, 220},{533, 660},{384, 569},{49, 305},{411, 491},},
+	{{86, 309},{270, 550},{69, 645},{157, 224},{157, 565},{151, 438},{419, 611},{358, 419},{332, 654},{19, 392},{224, 550},{270, 458},{364, 507},{364, 577},{392, 537},{157, 680},{4, 479},{36, 545},{75, 574},{444, 565},{336, 645},{4, 182},{545, 565},{503, 545},},
+	{{92, 410},{325, 698},{269, 302},{282, 321},{92, 321},{252, 610},{40, 449},{396, 596},{375, 500},{199, 252},{271, 436},{295, 510},{64, 122},{34, 395},{570, 644},{169, 724},{321, 556},{522, 542},{199, 209},{382, 410},{356, 658},{169, 450},{345, 705},{345, 559},},
+	{{262, 383},{315, 423},{349, 591},{433, 612},{679, 687},{20, 372},{364, 566},{110, 694},{372, 605},{408, 602},{341, 574},{538, 540},{62, 527},{519, 674},{236, 648},{300, 455},{51, 62},{168, 498},{215, 591},{498, 538},{566, 605},{145, 455},{447, 709},{20, 110},},
+	{{236, 470},{323, 618},{507, 711},{261, 562},{340, 462},{538, 695},{284, 477},{62, 584},{140, 462},{360, 470},{495, 606},{323, 538},{216, 495},{557, 711},{190, 353},{140, 428},{340, 523},{50, 688},{89, 159},{360, 594},{64, 350},{523, 688},{106, 492},{186, 261},},
+	{{30, 531},{315, 660},{38, 315},{55, 372},{467, 689},{183, 567},{146, 408},{77, 682},{514, 586},{312, 689},{169, 398},{332, 689},{156, 530},{132, 455},{132, 511},{117, 567},{552, 682},{259, 448},{332, 567},{259, 682},{9, 672},{208, 274},{142, 448},{351, 511},},
+	{{103, 649},{616, 660},{46, 449},{354, 362},{622, 642},{149, 354},{314, 642},{522, 592},{87, 340},{301, 362},{514, 724},{67, 592},{41, 67},{108, 263},{46, 698},{322, 683},{660, 724},{41, 280},{172, 548},{0, 46},{0, 362},{41, 609},{41, 195},{483, 683},},
+	{{120, 310},{628, 696},{181, 323},{353, 604},{26, 364},{224, 273},{692, 696},{90, 173},{378, 432},{462, 520},{397, 475},{133, 462},{340, 364},{432, 451},{49, 475},{224, 294},{570, 663},{418, 462},{12, 636},{299, 528},{120, 146},{77, 241},{397, 418},{340, 520},},
+	{{316, 467},{412, 517},{118, 528},{385, 509},{58, 419},{90, 613},{90, 696},{303, 470},{147, 613},{58, 668},{64, 470},{196, 691},{470, 644},{509, 624},{166, 541},{12, 596},{2, 489},{58, 637},{423, 445},{36, 696},{221, 284},{330, 502},{80, 509},{596, 644},},
+	{{66, 369},{11, 572},{11, 379},{154, 597},{603, 720},{142, 572},{278, 475},{242, 340},{489, 658},{50, 433},{565, 705},{281, 565},{411, 643},{357, 705},{196, 295},{391, 623},{597, 603},{209, 332},{112, 717},{414, 592},{107, 428},{467, 670},{44, 592},{146, 603},},
+	{{121, 126},{52, 380},{142, 681},{354, 374},{213, 402},{367, 681},{238, 641},{121, 187},{110, 258},{86, 367},{216, 402},{447, 556},{52, 402},{534, 601},{86, 534},{13, 705},{238, 608},{274, 530},{13, 258},{641, 728},{492, 641},{508, 584},{60, 563},{421, 601},},
+	{{189, 493},{189, 507},{476, 596},{123, 237},{460, 613},{67, 633},{116, 611},{271, 665},{495, 613},{611, 696},{146, 266},{67, 317},{408, 507},{62, 249},{67, 517},{199, 392},{177, 611},{527, 696},{62, 170},{369, 527},{481, 665},{611, 684},{300, 706},{362, 392},},
+	{{4, 12},{36, 501},{145, 259},{341, 396},{341, 354},{219, 590},{341, 676},{12, 396},{134, 590},{106, 501},{219, 259},{145, 482},{70, 669},{467, 716},{59, 298},{385, 602},{346, 542},{164, 219},{145, 214},{472, 716},{393, 580},{504, 615},{78, 219},{361, 605},},
+	{{536, 671},{35, 224},{341, 493},{121, 475},{267, 546},{295, 459},{76, 498},{204, 573},{712, 723},{505, 709},{361, 427},{393, 723},{43, 207},{341, 546},{128, 546},{459, 485},{287, 701},{109, 573},{434, 681},{257, 573},{18, 43},{43, 241},{121, 434},{245, 546},},
+	{{659, 711},{135, 209},{21, 293},{129, 242},{517, 694},{280, 550},{135, 473},{249, 572},{242, 314},{602, 625},{203, 694},{280, 300},{414, 726},{21, 561},{461, 586},{448, 494},{196, 561},{326, 550},{293, 612},{135, 228},{40, 61},{545, 586},{478, 637},{186, 711},},
+	{{89, 110},{195, 670},{295, 549},{67, 94},{62, 342},{269, 354},{340, 615},{236, 576},{172, 539},{539, 563},{404, 539},{195, 517},{211, 693},{574, 691},{187, 426},{375, 643},{230, 670},{383, 426},{460, 680},{78, 230},{110, 354},{325, 615},{131, 563},{409, 708},},
+	{{300, 461},{197, 368},{461, 552},{72, 143},{606, 630},{91, 595},{8, 422},{314, 491},{102, 422},{226, 552},{326, 674},{339, 482},{148, 394},{48, 537},{432, 491},{37, 368},{143, 674},{326, 448},{34, 630},{630, 660},{114, 155},{373, 706},{148, 378},{34, 314},},
+	{{86, 230},{243, 625},{555, 728},{1, 216},{86, 429},{25, 99},{299, 639},{134, 142},{38, 120},{1, 728},{331, 545},{264, 610},{280, 532},{204, 508},{313, 545},{25, 214},{468, 508},{25, 166},{145, 320},{415, 452},{299, 625},{584, 684},{617, 714},{67, 256},},
+	{{20, 52},{44, 342},{98, 297},{44, 383},{150, 241},{52, 241},{20, 268},{649, 662},{502, 669},{241, 342},{383, 669},{44, 131},{15, 649},{527, 614},{310, 527},{483, 649},{44, 602},{112, 318},{428, 697},{28, 488},{204, 649},{131, 153},{268, 684},{362, 432},},
+	{{1, 683},{216, 610},{196, 711},{506, 513},{109, 215},{51, 424},{355, 383},{149, 174},{513, 685},{273, 640},{441, 664},{51, 483},{126, 266},{285, 455},{141, 455},{424, 638},{62, 506},{119, 424},{370, 588},{349, 355},{273, 409},{236, 725},{167, 389},{39, 471},},
+	{{163, 240},{50, 719},{44, 163},{102, 482},{88, 469},{75, 253},{67, 201},{123, 266},{28, 469},{110, 421},{75, 342},{413, 636},{24, 136},{67, 615},{315, 544},{75, 365},{123, 615},{61, 335},{50, 598},{123, 501},{24, 699},{75, 249},{201, 225},{149, 295},},
+	{{93, 521},{289, 588},{132, 445},{89, 702},{306, 521},{265, 521},{145, 667},{18, 640},{66, 561},{414, 429},{89, 681},{117, 325},{166, 366},{139, 277},{486, 702},{321, 640},{106, 306},{588, 700},{547, 722},{289, 638},{89, 408},{6, 702},{185, 523},{18, 306},},
+	{{161, 629},{86, 568},{526, 594},{223, 661},{402, 446},{44, 49},{510, 568},{128, 605},{102, 148},{73, 541},{168, 184},{184, 671},{246, 671},{148, 661},{462, 568},{86, 556},{661, 676},{296, 605},{66, 510},{285, 446},{66, 402},{66, 536},{73, 526},{161, 320},},
+	{{133, 282},{33, 296},{209, 498},{414, 540},{2, 426},{322, 672},{257, 564},{375, 724},{526, 659},{13, 479},{73, 334},{2, 692},{2, 356},{257, 322},{648, 724},{257, 520},{387, 678},{362, 648},{375, 583},{270, 282},{84, 181},{433, 692},{33, 99},{23, 479},},
+	{{565, 721},{636, 672},{14, 353},{392, 402},{100, 385},{284, 660},{420, 653},{593, 660},{175, 385},{341, 636},{27, 672},{112, 472},{151, 246},{205, 412},{342, 713},{451, 490},{392, 472},{451, 528},{246, 472},{265, 402},{175, 616},{298, 496},{143, 420},{276, 392},},
+	{{311, 404},{260, 484},{428, 682},{285, 487},{216, 328},{128, 370},{194, 588},{59, 629},{188, 415},{156, 656},{547, 682},{118, 657},{521, 582},{524, 725},{365, 715},{639, 715},{211, 617},{261, 629},{106, 699},{59, 76},{260, 381},{340, 657},{94, 274},{435, 508},},
+	{{112, 376},{258, 648},{10, 106},{261, 575},{431, 487},{378, 539},{30, 510},{393, 487},{179, 281},{90, 220},{112, 291},{291, 525},{112, 343},{90, 343},{90, 158},{671, 688},{499, 634},{258, 291},{2, 587},{198, 431},{10, 499},{44, 393},{78, 480},{247, 612},},
+	{{150, 397},{136, 492},{303, 594},{8, 476},{162, 593},{136, 357},{357, 561},{287, 357},{257, 710},{181, 631},{521, 540},{392, 451},{77, 287},{540, 718},{202, 451},{651, 718},{12, 181},{202, 446},{134, 273},{181, 436},{162, 710},{20, 626},{228, 540},{345, 429},},
+	{{146, 712},{188, 198},{299, 712},{194, 337},{6, 531},{214, 432},{120, 243},{188, 472},{293, 662},{283, 375},{63, 429},{194, 375},{79, 198},{161, 531},{198, 555},{114, 625},{6, 273},{368, 397},{462, 696},{243, 407},{575, 601},{601, 666},{63, 378},{601, 696},},
+	{{344, 609},{52, 211},{278, 540},{160, 333},{192, 640},{24, 32},{601, 675},{169, 278},{386, 453},{37, 709},{192, 262},{211, 670},{206, 327},{24, 575},{299, 675},{71, 483},{262, 431},{11, 532},{172, 278},{532, 625},{262, 375},{222, 234},{160, 511},{24, 662},},
+	{{34, 261},{247, 583},{460, 651},{46, 651},{172, 636},{120, 707},{34, 612},{460, 707},{430, 707},{261, 707},{572, 629},{494, 722},{285, 348},{65, 460},{92, 501},{54, 237},{699, 707},{104, 336},{629, 718},{229, 314},{247, 257},{161, 543},{54, 683},{209, 505},},
+	{{375, 638},{7, 305},{600, 715},{181, 692},{266, 715},{500, 589},{305, 664},{76, 313},{32, 430},{53, 462},{196, 720},{500, 526},{20, 692},{86, 142},{53, 181},{436, 622},{210, 468},{108, 526},{313, 500},{313, 420},{210, 420},{231, 720},{420, 715},{253, 576},},
+	{{293, 354},{249, 347},{58, 88},{129, 325},{74, 166},{308, 395},{66, 395},{74, 395},{100, 112},{300, 371},{337, 371},{271, 448},{74, 293},{315, 710},{0, 112},{17, 223},{189, 549},{448, 481},{129, 721},{677, 718},{150, 690},{569, 721},{300, 337},{403, 582},},
+	{{318, 632},{52, 576},{242, 245},{69, 694},{228, 593},{166, 334},{88, 506},{123, 546},{342, 467},{245, 313},{207, 719},{98, 554},{39, 643},{271, 720},{265, 576},{11, 593},{98, 720},{342, 417},{11, 271},{199, 438},{271, 302},{88, 719},{102, 155},{39, 513},},
+	{{259, 332},{540, 610},{28, 207},{344, 483},{115, 279},{156, 689},{23, 279},{119, 396},{523, 629},{249, 427},{194, 629},{299, 483},{382, 552},{405, 629},{187, 382},{28, 499},{279, 721},{53, 98},{523, 666},{23, 144},{40, 332},{533, 644},{376, 552},{115, 140},},
+	{{445, 582},{368, 374},{537, 632},{38, 199},{330, 728},{48, 489},{445, 497},{136, 684},{77, 521},{228, 642},{295, 434},{265, 598},{123, 489},{88, 642},{0, 658},{167, 305},{276, 608},{632, 695},{374, 562},{330, 608},{0, 316},{179, 211},{38, 537},{550, 575},},
+	{{39, 144},{438, 599},{384, 610},{279, 476},{223, 699},{296, 534},{58, 659},{477, 565},{58, 226},{296, 401},{159, 391},{327, 438},{279, 508},{299, 407},{182, 728},{424, 530},{46, 279},{74, 159},{223, 486},{119, 342},{226, 237},{39, 102},{176, 653},{204, 421},},
+	{{405, 607},{56, 405},{241, 510},{558, 698},{135, 480},{86, 649},{32, 304},{451, 601},{304, 590},{17, 161},{49, 306},{118, 514},{379, 698},{220, 601},{405, 499},{207, 296},{306, 607},{296, 724},{631, 641},{135, 405},{306, 379},{135, 718},{470, 724},{3, 510},},
+	{{573, 603},{23, 577},{163, 462},{312, 616},{55, 430},{55, 326},{23, 533},{616, 641},{80, 710},{284, 392},{212, 518},{120, 223},{354, 403},{546, 626},{38, 724},{616, 637},{134, 295},{189, 577},{134, 382},{55, 382},{0, 174},{109, 447},{382, 419},{158, 343},},
+	{{326, 479},{456, 508},{98, 545},{20, 456},{50, 290},{310, 479},{408, 574},{0, 387},{110, 479},{268, 408},{551, 647},{359, 578},{124, 508},{372, 431},{551, 606},{372, 487},{271, 636},{364, 647},{402, 680},{85, 105},{408, 613},{528, 706},{249, 284},{76, 473},},
+	{{85, 438},{331, 387},{132, 646},{470, 665},{236, 693},{470, 614},{120, 485},{132, 582},{485, 568},{41, 246},{143, 293},{205, 400},{58, 149},{438, 470},{372, 671},{334, 457},{153, 362},{85, 205},{143, 542},{100, 269},{246, 634},{383, 516},{279, 542},{222, 646},},
+	{{161, 453},{667, 700},{142, 371},{161, 194},{516, 654},{11, 584},{119, 415},{53, 559},{483, 553},{18, 630},{317, 700},{18, 86},{1, 700},{103, 371},{453, 700},{161, 188},{161, 307},{227, 317},{415, 677},{188, 546},{294, 483},{409, 677},{103, 284},{126, 630},},
+	{{224, 348},{504, 721},{156, 520},{470, 709},{369, 504},{156, 295},{26, 545},{264, 329},{132, 232},{403, 614},{369, 701},{56, 307},{56, 647},{240, 455},{26, 462},{101, 717},{403, 701},{287, 592},{438, 721},{287, 621},{28, 403},{90, 181},{124, 552},{6, 264},},
+	{{328, 627},{105, 170},{654, 707},{314, 589},{350, 523},{105, 683},{371, 417},{423, 688},{88, 126},{301, 437},{475, 523},{338, 584},{483, 589},{214, 566},{454, 570},{29, 273},{149, 190},{320, 727},{105, 357},{273, 483},{126, 437},{350, 367},{483, 537},{502, 639},},
+	{{177, 455},{43, 570},{251, 298},{145, 555},{336, 699},{110, 678},{344, 721},{129, 673},{123, 678},{86, 97},{32, 608},{223, 623},{223, 524},{97, 512},{229, 576},{229, 487},{382, 462},{17, 415},{290, 306},{266, 470},{277, 576},{208, 623},{129, 484},{223, 538},},
+	{{110, 462},{122, 577},{271, 291},{340, 383},{301, 563},{420, 503},{286, 712},{12, 549},{517, 657},{74, 654},{291, 340},{240, 503},{143, 196},{507, 589},{271, 692},{366, 671},{603, 671},{94, 110},{162, 507},{215, 229},{455, 577},{229, 291},{523, 549},{443, 671},},
+	{{18, 728},{76, 353},{184, 623},{563, 623},{317, 521},{92, 671},{106, 393},{393, 715},{317, 715},{18, 599},{317, 393},{153, 206},{521, 523},{345, 671},{153, 476},{397, 606},{456, 681},{189, 328},{385, 543},{456, 686},{353, 686},{189, 419},{18, 317},{34, 172},},
+	{{269, 345},{325, 592},{195, 254},{71, 421},{217, 269},{40, 74},{643, 685},{12, 520},{164, 289},{54, 402},{88, 386},{128, 711},{180, 592},{24, 615},{71, 88},{141, 180},{94, 254},{313, 338},{367, 443},{229, 273},{440, 711},{158, 478},{575, 651},{289, 697},},
+	{{42, 357},{236, 397},{288, 623},{59, 277},{466, 564},{205, 381},{303, 635},{362, 477},{42, 623},{211, 549},{331, 337},{451, 672},{449, 592},{231, 451},{120, 598},{303, 544},{80, 451},{81, 598},{397, 575},{80, 211},{236, 303},{623, 689},{80, 113},{1, 603},},
+	{{72, 559},{101, 236},{47, 416},{33, 180},{287, 667},{246, 656},{637, 702},{5, 236},{476, 691},{507, 602},{26, 507},{72, 381},{5, 308},{401, 691},{362, 637},{37, 656},{72, 581},{148, 637},{91, 308},{298, 387},{656, 667},{322, 691},{13, 406},{87, 148},},
+	{{17, 642},{340, 593},{56, 266},{66, 708},{73, 608},{66, 524},{408, 415},{0, 229},{94, 120},{593, 655},{479, 669},{24, 174},{34, 66},{315, 479},{152, 286},{17, 628},{300, 415},{258, 401},{50, 628},{221, 415},{0, 632},{24, 300},{251, 437},{17, 659},},
+	{{205, 216},{8, 523},{205, 298},{103, 193},{186, 371},{242, 523},{205, 704},{564, 692},{208, 678},{506, 678},{520, 631},{73, 412},{167, 343},{608, 639},{38, 584},{383, 692},{33, 108},{141, 724},{403, 428},{359, 428},{97, 371},{283, 648},{73, 146},{70, 724},},
+	{{95, 528},{37, 451},{53, 200},{359, 572},{53, 175},{120, 536},{259, 497},{53, 65},{95, 422},{194, 306},{259, 674},{37, 222},{591, 677},{61, 572},{181, 697},{95, 677},{359, 557},{181, 648},{259, 359},{284, 528},{157, 244},{339, 391},{347, 511},{360, 572},},
+	{{45, 315},{30, 226},{114, 585},{244, 492},{610, 683},{135, 315},{610, 658},{30, 128},{114, 492},{114, 514},{61, 467},{95, 267},{188, 640},{44, 618},{345, 598},{244, 514},{95, 135},{15, 365},{439, 632},{44, 684},{162, 214},{45, 267},{45, 345},{67, 492},},
+	{{290, 345},{302, 445},{172, 252},{425, 643},{89, 212},{62, 515},{466, 623},{422, 623},{12, 51},{241, 670},{302, 670},{466, 501},{453, 635},{172, 585},{4, 670},{468, 478},{186, 609},{241, 466},{133, 395},{290, 453},{27, 37},{77, 487},{556, 595},{290, 558},},
+	{{106, 409},{671, 728},{168, 661},{66, 718},{86, 502},{129, 300},{174, 419},{129, 525},{145, 345},{58, 123},{145, 324},{34, 596},{66, 681},{53, 661},{112, 145},{300, 400},{2, 642},{106, 506},{174, 324},{106, 200},{145, 559},{86, 189},{106, 634},{184, 400},},
+	{{365, 698},{232, 711},{128, 365},{211, 487},{52, 585},{156, 698},{12, 331},{449, 531},{42, 108},{96, 449},{341, 705},{156, 610},{32, 201},{620, 686},{289, 600},{375, 622},{309, 565},{96, 106},{148, 526},{201, 664},{585, 674},{323, 449},{345, 654},{580, 622},},
+	{{246, 317},{516, 571},{246, 691},{127, 537},{101, 453},{108, 654},{486, 719},{23, 441},{101, 399},{7, 596},{304, 317},{581, 592},{310, 624},{101, 474},{460, 545},{23, 497},{89, 486},{304, 720},{660, 667},{227, 348},{160, 441},{7, 66},{660, 691},{108, 364},},
+	{{52, 551},{62, 94},{228, 247},{177, 709},{150, 676},{330, 659},{150, 313},{458, 468},{207, 313},{2, 408},{89, 263},{177, 561},{52, 247},{120, 303},{177, 323},{280, 401},{32, 648},{273, 381},{12, 150},{150, 360},{67, 540},{127, 699},{535, 574},{615, 648},},
+	{{177, 671},{221, 246},{119, 606},{98, 606},{206, 606},{246, 712},{623, 656},{238, 325},{162, 712},{71, 313},{238, 634},{119, 340},{154, 263},{365, 548},{292, 675},{5, 325},{214, 727},{88, 257},{325, 451},{119, 162},{206, 523},{298, 552},{150, 221},{206, 620},},
+	{{138, 562},{326, 682},{8, 252},{531, 682},{252, 628},{548, 616},{174, 307},{302, 616},{68, 174},{294, 703},{476, 502},{96, 134},{40, 524},{174, 402},{138, 302},{138, 174},{274, 567},{394, 587},{138, 246},{208, 652},{242, 269},{531, 690},{117, 195},{82, 294},},
+	{{439, 590},{120, 643},{241, 590},{37, 291},{482, 612},{95, 168},{482, 597},{58, 364},{135, 622},{203, 333},{416, 533},{80, 267},{228, 676},{135, 533},{439, 584},{690, 722},{501, 556},{215, 482},{584, 722},{89, 518},{180, 278},{51, 135},{20, 80},{439, 610},},
+	{{148, 656},{160, 668},{417, 571},{208, 547},{92, 337},{602, 664},{160, 571},{176, 349},{208, 635},{454, 566},{423, 602},{523, 571},{176, 551},{387, 547},{107, 301},{267, 668},{551, 576},{33, 482},{434, 467},{260, 271},{279, 423},{387, 566},{66, 356},{248, 454},},
+	{{101, 550},{318, 333},{203, 565},{60, 477},{359, 700},{346, 455},{463, 607},{49, 455},{196, 607},{203, 685},{203, 318},{257, 663},{257, 490},{249, 537},{186, 308},{286, 497},{359, 638},{94, 463},{196, 447},{407, 447},{196, 463},{463, 663},{249, 401},{331, 372},},
+	{{573, 614},{260, 393},{26, 607},{101, 443},{238, 285},{592, 607},{117, 322},{322, 420},{271, 712},{205, 477},{149, 424},{573, 666},{537, 709},{225, 462},{33, 156},{33, 443},{509, 633},{680, 709},{368, 537},{205, 462},{101, 130},{285, 520},{225, 354},{141, 264},},
+	{{24, 328},{66, 501},{0, 515},{150, 535},{242, 711},{183, 535},{561, 618},{133, 685},{527, 711},{0, 451},{548, 696},{163, 419},{389, 550},{98, 579},{221, 314},{477, 515},{276, 586},{135, 635},{425, 671},{425, 515},{401, 623},{548, 550},{411, 425},{163, 685},},
+	{{228, 511},{404, 492},{267, 391},{625, 676},{150, 709},{177, 517},{221, 354},{469, 625},{315, 530},{107, 135},{107, 603},{228, 650},{256, 586},{65, 405},{256, 367},{73, 300},{200, 625},{157, 421},{280, 709},{23, 650},{82, 354},{133, 180},{438, 533},{241, 517},},
+	{{87, 221},{535, 661},{385, 574},{47, 297},{413, 487},{265, 445},{385, 498},{215, 686},{272, 323},{215, 285},{62, 457},{173, 289},{661, 705},{498, 565},{18, 221},{215, 595},{47, 721},{369, 686},{137, 551},{47, 359},{221, 445},{100, 582},{334, 625},{157, 297},},
+	{{447, 563},{341, 645},{0, 185},{547, 563},{499, 547},{34, 575},{76, 667},{34, 341},{702, 728},{93, 475},{53, 153},{0, 373},{576, 714},{563, 681},{107, 645},{427, 625},{172, 506},{34, 317},{53, 702},{55, 219},{295, 521},{295, 419},{93, 632},{365, 450},},
+	{{379, 406},{359, 658},{166, 457},{347, 707},{347, 564},{108, 631},{406, 668},{364, 379},{515, 711},{79, 598},{139, 603},{281, 598},{331, 523},{304, 656},{416, 447},{243, 696},{49, 108},{166, 269},{139, 495},{447, 570},{339, 539},{320, 570},{152, 459},{281, 691},},
+	{{496, 533},{562, 610},{147, 456},{444, 709},{18, 108},{137, 387},{272, 493},{312, 602},{134, 425},{203, 272},{347, 632},{320, 648},{398, 728},{387, 437},{414, 562},{610, 648},{33, 94},{17, 437},{38, 186},{79, 376},{79, 134},{581, 610},{533, 613},{444, 516},},
+	{{363, 597},{63, 343},{528, 686},{105, 487},{188, 268},{16, 232},{338, 719},{503, 597},{551, 700},{188, 351},{343, 413},{188, 680},{590, 621},{423, 578},{309, 574},{37, 680},{700, 723},{271, 507},{547, 703},{417, 663},{503, 621},{256, 507},{461, 578},{528, 578},},
+	{{254, 677},{213, 278},{142, 443},{356, 506},{428, 562},{420, 701},{373, 572},{77, 100},{169, 476},{213, 443},{381, 577},{562, 600},{356, 648},{289, 687},{64, 161},{311, 661},{213, 301},{301, 577},{234, 381},{9, 373},{100, 572},{218, 687},{190, 350},{234, 254},},
+	{{0, 51},{0, 366},{39, 611},{39, 197},{479, 681},{67, 571},{374, 534},{28, 458},{67, 197},{318, 564},{91, 386},{169, 697},{624, 631},{272, 325},{468, 582},{251, 448},{17, 133},{133, 502},{479, 697},{61, 554},{512, 534},{51, 272},{564, 639},{228, 436},},
+	{{301, 529},{117, 150},{73, 236},{399, 416},{341, 521},{378, 703},{167, 355},{253, 714},{643, 670},{276, 399},{181, 453},{670, 714},{215, 236},{643, 656},{117, 409},{453, 465},{378, 388},{171, 374},{378, 677},{521, 558},{236, 362},{94, 236},{287, 677},{150, 629},},
+	{{39, 696},{217, 279},{327, 499},{77, 507},{601, 639},{61, 259},{461, 527},{581, 601},{85, 414},{634, 721},{327, 427},{187, 499},{71, 100},{402, 655},{475, 546},{485, 624},{155, 402},{191, 427},{507, 655},{187, 659},{167, 601},{347, 499},{318, 359},{207, 455},},
+	{{414, 593},{103, 428},{461, 666},{42, 593},{42, 461},{58, 129},{540, 666},{42, 561},{129, 414},{481, 501},{253, 428},{129, 306},{58, 597},{556, 664},{530, 677},{25, 666},{264, 725},{170, 530},{438, 481},{228, 710},{535, 725},{381, 561},{358, 518},{12, 202},},
+	{{645, 720},{490, 645},{504, 577},{61, 566},{419, 601},{273, 502},{137, 593},{593, 720},{698, 718},{102, 367},{456, 624},{231, 263},{137, 515},{419, 645},{96, 436},{39, 419},{237, 692},{9, 698},{144, 443},{504, 659},{515, 550},{116, 645},{77, 467},{82, 632},},
+	{{371, 525},{484, 661},{607, 686},{299, 709},{361, 392},{371, 548},{111, 453},{4, 124},{299, 538},{299, 381},{4, 572},{186, 463},{186, 504},{186, 553},{193, 600},{474, 674},{413, 661},{243, 640},{257, 299},{607, 709},{19, 277},{152, 225},{299, 686},{548, 632},},
+	{{473, 711},{388, 581},{512, 618},{75, 218},{362, 606},{93, 538},{347, 386},{251, 388},{339, 692},{116, 339},{218, 372},{22, 242},{27, 192},{403, 552},{518, 625},{192, 319},{319, 408},{163, 669},{229, 560},{59, 439},{212, 425},{319, 625},{242, 618},{187, 212},},
+	{{252, 568},{21, 37},{37, 237},{119, 435},{246, 544},{598, 638},{503, 714},{168, 564},{215, 538},{172, 639},{305, 693},{21, 475},{504, 672},{312, 579},{141, 329},{312, 703},{185, 478},{312, 504},{305, 568},{387, 478},{80, 119},{202, 672},{478, 703},{115, 189},},
+	{{143, 228},{39, 59},{544, 591},{477, 630},{185, 716},{26, 251},{445, 506},{356, 727},{356, 591},{93, 391},{228, 630},{372, 445},{595, 727},{215, 611},{33, 716},{300, 343},{70, 623},{166, 489},{191, 440},{143, 155},{440, 695},{112, 426},{76, 695},{2, 460},},
+	{{78, 226},{114, 354},{329, 616},{126, 566},{407, 703},{346, 476},{215, 319},{91, 290},{476, 566},{126, 489},{441, 609},{67, 226},{249, 703},{646, 682},{555, 624},{78, 585},{346, 532},{223, 513},{682, 720},{319, 609},{346, 682},{8, 544},{175, 239},{306, 682},},
+	{{632, 664},{108, 158},{375, 706},{150, 380},{35, 314},{327, 706},{12, 209},{196, 300},{176, 693},{268, 337},{50, 229},{620, 706},{454, 716},{258, 435},{92, 706},{100, 524},{576, 664},{237, 490},{380, 664},{0, 350},{143, 337},{129, 150},{258, 350},{150, 170},},
+	{{416, 454},{302, 621},{582, 690},{620, 719},{64, 252},{643, 704},{610, 719},{334, 441},{208, 582},{80, 186},{610, 620},{82, 174},{2, 396},{61, 536},{666, 704},{33, 280},{128, 356},{33, 162},{441, 690},{428, 474},{162, 317},{502, 696},{186, 582},{294, 391},},
+	{{27, 490},{200, 651},{133, 155},{264, 692},{367, 436},{289, 369},{406, 566},{11, 523},{149, 181},{380, 616},{259, 458},{603, 626},{55, 197},{511, 523},{197, 695},{120, 174},{197, 633},{212, 406},{26, 419},{197, 458},{406, 591},{143, 169},{332, 511},{181, 289},},
+	{{347, 354},{272, 411},{237, 728},{164, 388},{39, 476},{380, 728},{171, 476},{513, 615},{581, 706},{313, 437},{591, 640},{39, 666},{39, 340},{243, 289},{49, 377},{171, 523},{188, 190},{116, 728},{74, 691},{388, 523},{119, 666},{126, 260},{84, 119},{229, 477},},
+	{{120, 497},{22, 695},{79, 250},{204, 226},{149, 288},{29, 362},{57, 448},{46, 193},{288, 666},{666, 678},{115, 545},{372, 437},{157, 179},{271, 463},{323, 456},{157, 437},{288, 575},{530, 653},{258, 468},{431, 575},{157, 718},{134, 401},{212, 359},{81, 372},},
+	{{294, 631},{88, 406},{4, 709},{188, 529},{20, 313},{265, 477},{238, 661},{66, 583},{437, 666},{287, 329},{461, 597},{397, 477},{112, 238},{217, 505},{238, 373},{265, 271},{336, 607},{501, 583},{33, 694},{406, 536},{529, 536},{102, 666},{66, 265},{53, 631},},
+	{{284, 443},{67, 399},{67, 533},{77, 525},{161, 316},{236, 565},{113, 620},{121, 270},{175, 660},{368, 517},{167, 477},{67, 129},{135, 368},{376, 660},{8, 207},{113, 684},{121, 352},{167, 443},{129, 316},{389, 604},{254, 267},{42, 512},{231, 517},{113, 565},},
+	{{271, 282},{82, 188},{438, 691},{34, 106},{18, 477},{409, 726},{267, 458},{267, 364},{189, 409},{82, 327},{245, 421},{384, 623},{677, 698},{327, 719},{210, 460},{310, 343},{575, 677},{116, 549},{188, 245},{322, 327},{94, 648},{544, 648},{135, 282},{62, 493},},
+	{{266, 400},{175, 617},{300, 500},{143, 417},{278, 392},{175, 568},{182, 617},{400, 638},{500, 707},{461, 468},{130, 657},{21, 196},{160, 722},{17, 72},{143, 222},{119, 392},{201, 642},{119, 143},{147, 278},{313, 555},{160, 555},{108, 583},{196, 247},{516, 707},},
+	{{56, 75},{256, 384},{336, 657},{98, 272},{432, 508},{4, 160},{10, 689},{56, 294},{336, 595},{177, 206},{4, 549},{118, 625},{394, 657},{4, 625},{394, 649},{140, 709},{10, 336},{185, 394},{75, 98},{370, 681},{492, 538},{126, 681},{56, 603},{444, 543},},
+	{{204, 425},{12, 499},{43, 387},{72, 484},{249, 616},{108, 162},{197, 210},{379, 651},{249, 379},{12, 172},{172, 715},{8, 72},{162, 204},{64, 210},{616, 700},{210, 345},{147, 721},{72, 642},{282, 608},{141, 522},{476, 593},{64, 362},{24, 127},{252, 282},},
+	{{182, 437},{168, 702},{19, 625},{232, 546},{345, 430},{353, 682},{152, 597},{168, 286},{17, 391},{546, 581},{345, 437},{199, 682},{119, 698},{189, 479},{52, 526},{168, 603},{136, 252},{391, 690},{269, 497},{221, 614},{85, 658},{353, 670},{52, 603},{565, 658},},
+	{{251, 407},{572, 595},{595, 674},{66, 380},{595, 698},{555, 630},{162, 681},{1, 146},{121, 453},{116, 327},{647, 698},{1, 496},{41, 261},{657, 698},{87, 238},{421, 436},{162, 647},{327, 421},{316, 436},{429, 619},{507, 698},{251, 681},{162, 173},{17, 316},},
+	{{534, 628},{261, 373},{221, 235},{155, 508},{21, 663},{435, 653},{1, 653},{653, 700},{373, 712},{193, 534},{468, 575},{171, 221},{70, 688},{186, 599},{106, 618},{256, 728},{29, 728},{579, 618},{164, 534},{431, 483},{150, 373},{335, 563},{347, 575},{277, 521},},
+	{{228, 313},{244, 256},{153, 547},{62, 677},{208, 505},{39, 631},{50, 674},{488, 505},{454, 482},{134, 179},{359, 598},{95, 590},{488, 627},{153, 461},{329, 531},{88, 244},{0, 335},{179, 267},{153, 576},{279, 570},{290, 652},{200, 716},{652, 677},{279, 461},},
+	{{309, 420},{208, 420},{232, 725},{420, 711},{256, 581},{154, 166},{280, 294},{166, 581},{309, 368},{146, 302},{368, 553},{277, 353},{134, 553},{437, 710},{179, 232},{232, 368},{6, 141},{112, 232},{232, 302},{232, 412},{106, 216},{200, 521},{277, 345},{476, 649},},
+	{{677, 717},{144, 685},{574, 725},{302, 341},{400, 582},{137, 696},{130, 598},{124, 374},{39, 87},{378, 400},{289, 582},{374, 476},{344, 624},{28, 582},{344, 519},{598, 620},{226, 429},{39, 400},{309, 538},{497, 656},{166, 309},{429, 590},{222, 558},{289, 497},},
+	{{200, 436},{275, 303},{87, 719},{99, 160},{43, 515},{237, 429},{149, 487},{120, 200},{178, 487},{280, 368},{35, 422},{587, 612},{265, 587},{368, 487},{500, 661},{4, 99},{69, 549},{376, 412},{99, 327},{35, 412},{178, 682},{357, 412},{43, 487},{252, 689},},
+	{{20, 144},{43, 327},{539, 639},{374, 550},{109, 137},{174, 626},{35, 120},{120, 378},{174, 248},{158, 207},{453, 518},{442, 639},{488, 690},{327, 597},{539, 582},{327, 518},{568, 582},{412, 438},{55, 473},{374, 479},{87, 309},{67, 639},{158, 224},{158, 564},},
+	{{327, 611},{0, 319},{174, 207},{557, 573},{280, 438},{57, 726},{261, 676},{133, 207},{13, 38},{169, 193},{404, 438},{540, 644},{258, 335},{13, 715},{258, 461},{0, 583},{101, 589},{79, 622},{458, 644},{98, 409},{327, 698},{261, 302},{280, 319},{98, 319},},
+	{{120, 348},{233, 234},{44, 107},{176, 655},{205, 417},{312, 667},{535, 608},{356, 523},{489, 520},{120, 283},{391, 597},{233, 497},{324, 399},{263, 699},{485, 643},{218, 716},{464, 643},{614, 683},{520, 683},{218, 683},{263, 379},{322, 427},{348, 585},{432, 614},},
+	{{137, 413},{312, 381},{137, 718},{473, 723},{6, 511},{593, 692},{312, 459},{245, 371},{115, 404},{225, 381},{433, 557},{159, 615},{99, 692},{501, 723},{120, 632},{298, 511},{36, 557},{371, 459},{35, 433},{348, 447},{239, 473},{322, 615},{511, 718},{266, 558},},
+	{{54, 385},{8, 171},{108, 442},{385, 421},{161, 343},{343, 549},{368, 622},{336, 499},{570, 706},{385, 527},{357, 618},{95, 477},{191, 476},{635, 713},{506, 561},{171, 336},{357, 435},{73, 320},{635, 683},{221, 671},{32, 538},{320, 658},{43, 320},{54, 369},},
+	{{84, 100},{407, 617},{523, 703},{247, 281},{73, 476},{294, 718},{100, 560},{482, 548},{463, 492},{399, 523},{273, 675},{73, 441},{124, 260},{451, 662},{90, 568},{476, 696},{13, 297},{47, 364},{173, 186},{146, 696},{100, 656},{617, 662},{47, 441},{356, 364},},
+	{{106, 261},{246, 635},{383, 517},{286, 541},{223, 646},{227, 689},{227, 528},{215, 657},{12, 293},{533, 549},{272, 421},{348, 657},{92, 201},{272, 613},{158, 493},{92, 194},{162, 260},{130, 194},{507, 541},{456, 533},{118, 310},{624, 696},{184, 316},{354, 603},},
+	{{186, 542},{288, 484},{408, 682},{106, 286},{132, 634},{395, 420},{163, 436},{201, 720},{143, 436},{420, 493},{106, 342},{286, 408},{132, 573},{146, 156},{634, 692},{143, 647},{8, 55},{522, 647},{132, 342},{420, 519},{321, 464},{408, 519},{122, 522},{382, 508},},
+	{{281, 623},{33, 403},{95, 186},{121, 555},{4, 265},{499, 691},{170, 613},{106, 259},{288, 408},{141, 613},{259, 408},{392, 671},{174, 424},{559, 584},{545, 691},{309, 470},{33, 677},{33, 516},{212, 643},{216, 567},{64, 375},{11, 567},{11, 382},{156, 599},},
+	{{274, 478},{132, 435},{346, 368},{478, 536},{497, 644},{78, 177},{346, 688},{264, 289},{23, 585},{12, 162},{226, 443},{205, 492},{117, 585},{177, 389},{35, 151},{403, 463},{35, 56},{182, 226},{303, 429},{274, 566},{117, 132},{49, 381},{140, 675},{357, 369},},
+	{{262, 473},{275, 584},{214, 625},{134, 484},{224, 535},{561, 572},{88, 672},{33, 561},{11, 110},{177, 408},{431, 664},{214, 245},{224, 484},{442, 612},{93, 687},{66, 436},{294, 442},{46, 224},{33, 645},{110, 535},{192, 486},{192, 506},{473, 595},{117, 234},},
+	{{210, 229},{450, 576},{229, 295},{529, 550},{444, 672},{489, 694},{37, 411},{444, 652},{102, 185},{298, 306},{284, 646},{470, 517},{256, 529},{149, 336},{411, 694},{251, 467},{22, 336},{481, 722},{102, 537},{130, 620},{2, 15},{37, 497},{149, 256},{336, 401},},
+	{{456, 687},{359, 687},{193, 419},{22, 317},{32, 177},{392, 510},{419, 633},{544, 665},{491, 633},{36, 90},{90, 456},{32, 703},{129, 703},{16, 426},{16, 90},{16, 137},{16, 491},{364, 727},{256, 532},{592, 713},{532, 666},{32, 224},{334, 491},{123, 472},},
+	{{226, 271},{436, 718},{153, 478},{569, 653},{293, 693},{56, 114},{17, 544},{66, 297},{395, 448},{325, 354},{46, 408},{688, 703},{46, 511},{17, 728},{183, 703},{325, 465},{34, 544},{173, 465},{580, 693},{189, 619},{660, 718},{143, 211},{22, 293},{129, 241},},
+	{{78, 213},{234, 300},{621, 689},{78, 110},{2, 605},{359, 481},{86, 561},{265, 280},{2, 31},{581, 676},{265, 648},{513, 605},{201, 541},{59, 178},{234, 435},{554, 708},{18, 201},{233, 280},{708, 713},{234, 359},{86, 110},{194, 673},{293, 554},{66, 93},},
+	{{302, 387},{653, 674},{317, 686},{15, 407},{82, 148},{3, 439},{56, 317},{296, 541},{3, 616},{361, 660},{387, 516},{195, 660},{72, 536},{249, 587},{551, 565},{296, 385},{156, 282},{98, 210},{195, 495},{249, 594},{302, 463},{195, 361},{463, 551},{72, 141},},
+	{{223, 422},{5, 637},{247, 436},{13, 662},{30, 527},{263, 557},{194, 702},{368, 464},{426, 722},{13, 235},{300, 351},{106, 436},{62, 717},{436, 447},{202, 535},{30, 347},{72, 436},{24, 117},{579, 670},{84, 228},{247, 625},{557, 722},{5, 223},{84, 426},},
+	{{356, 429},{98, 371},{285, 650},{80, 151},{65, 726},{239, 271},{14, 120},{445, 678},{14, 81},{468, 557},{65, 457},{114, 406},{586, 709},{261, 417},{399, 639},{43, 254},{406, 582},{81, 611},{151, 639},{322, 468},{21, 46},{43, 346},{98, 305},{43, 379},},
+	{{281, 523},{155, 249},{335, 387},{343, 507},{364, 569},{238, 478},{143, 201},{428, 712},{335, 727},{554, 672},{180, 486},{335, 428},{28, 662},{59, 103},{238, 598},{358, 441},{147, 592},{387, 462},{238, 675},{82, 320},{8, 675},{217, 608},{197, 712},{507, 515},},
+	{{43, 689},{169, 215},{49, 267},{49, 349},{66, 488},{49, 646},{594, 702},{43, 157},{221, 426},{306, 445},{9, 66},{43, 175},{405, 452},{55, 501},{190, 548},{190, 523},{84, 445},{277, 696},{123, 561},{6, 190},{169, 234},{49, 713},{43, 169},{100, 478},},
+	{{293, 450},{77, 492},{551, 598},{293, 565},{16, 609},{200, 598},{37, 479},{332, 344},{195, 234},{598, 648},{185, 304},{94, 647},{71, 165},{252, 695},{57, 387},{71, 217},{332, 647},{412, 479},{49, 545},{94, 521},{293, 592},{134, 445},{82, 708},{312, 521},},
+	{{105, 203},{148, 561},{82, 195},{105, 636},{188, 403},{252, 308},{223, 412},{95, 428},{45, 82},{143, 308},{265, 528},{249, 611},{265, 506},{115, 223},{45, 335},{105, 724},{82, 388},{561, 666},{120, 463},{143, 653},{153, 621},{82, 570},{528, 596},{223, 663},},
+	{{205, 657},{591, 667},{315, 442},{346, 655},{579, 626},{571, 698},{473, 494},{57, 698},{109, 667},{454, 549},{87, 626},{109, 601},{158, 431},{549, 686},{233, 473},{33, 549},{346, 647},{44, 273},{571, 714},{129, 655},{129, 285},{207, 497},{420, 541},{5, 431},},
+	{{228, 348},{159, 443},{1, 71},{657, 685},{115, 365},{379, 589},{207, 706},{115, 276},{12, 286},{115, 706},{98, 228},{115, 311},{475, 538},{127, 223},{51, 496},{98, 488},{207, 574},{159, 657},{101, 517},{148, 548},{563, 727},{631, 674},{12, 351},{389, 404},},
+	{{149, 367},{66, 543},{132, 695},{536, 572},{615, 651},{238, 549},{20, 62},{20, 185},{646, 663},{119, 227},{399, 712},{215, 395},{324, 488},{72, 634},{89, 399},{431, 651},{549, 651},{447, 623},{289, 367},{399, 406},{312, 399},{257, 482},{431, 682},{287, 488},},
+	{{118, 165},{200, 529},{152, 222},{200, 614},{114, 118},{332, 631},{492, 593},{323, 492},{34, 373},{343, 401},{152, 504},{114, 380},{313, 354},{222, 631},{222, 360},{1, 449},{34, 142},{200, 579},{142, 343},{114, 373},{256, 655},{14, 103},{264, 571},{425, 492},},
+	{{211, 650},{240, 263},{538, 687},{124, 197},{86, 289},{481, 687},{152, 470},{228, 256},{284, 379},{246, 508},{139, 401},{11, 263},{197, 393},{372, 379},{178, 577},{102, 289},{263, 313},{4, 379},{240, 542},{439, 665},{152, 401},{139, 492},{305, 594},{4, 470},},
+	{{81, 515},{181, 273},{48, 139},{24, 73},{437, 611},{159, 228},{248, 273},{169, 568},{27, 73},{101, 248},{61, 359},{462, 728},{159, 644},{159, 370},{149, 204},{159, 588},{535, 588},{448, 470},{437, 568},{359, 623},{149, 718},{181, 204},{305, 718},{193, 335},},
+	{{255, 272},{285, 423},{394, 558},{66, 352},{248, 453},{206, 352},{158, 383},{255, 646},{399, 517},{81, 587},{183, 360},{158, 502},{229, 629},{494, 604},{195, 383},{524, 604},{183, 236},{81, 726},{81, 689},{394, 406},{348, 604},{47, 212},{272, 543},{158, 341},},
+	{{408, 446},{189, 463},{463, 657},{245, 399},{331, 373},{423, 456},{189, 287},{395, 709},{112, 200},{572, 588},{395, 572},{208, 522},{303, 607},{208, 378},{446, 463},{690, 715},{166, 521},{623, 657},{456, 656},{39, 423},{33, 265},{245, 582},{463, 656},{53, 656},},
+	{{198, 466},{283, 514},{227, 351},{143, 262},{158, 254},{408, 528},{82, 466},{507, 692},{277, 599},{198, 262},{5, 93},{76, 428},{70, 76},{60, 707},{60, 143},{318, 346},{70, 332},{380, 442},{124, 212},{372, 636},{5, 297},{599, 714},{187, 692},{262, 714},},
+	{{428, 516},{399, 625},{548, 554},{409, 428},{162, 684},{366, 684},{272, 625},{340, 432},{246, 564},{487, 618},{373, 453},{388, 618},{162, 233},{332, 475},{388, 428},{442, 694},{136, 378},{453, 608},{366, 495},{223, 461},{296, 356},{246, 344},{60, 86},{126, 332},},
+	{{20, 649},{88, 355},{131, 188},{433, 534},{237, 518},{258, 634},{232, 377},{27, 463},{450, 546},{172, 686},{20, 707},{20, 258},{73, 88},{306, 634},{355, 714},{188, 258},{355, 497},{407, 550},{13, 62},{290, 426},{320, 634},{237, 251},{68, 697},{232, 590},},
+	{{46, 354},{220, 442},{99, 582},{341, 622},{154, 300},{489, 676},{582, 642},{268, 315},{272, 538},{300, 330},{489, 582},{22, 400},{287, 419},{43, 141},{200, 294},{311, 315},{220, 476},{521, 642},{315, 400},{189, 220},{253, 330},{545, 603},{33, 215},{112, 287},},
+	{{59, 222},{296, 514},{296, 421},{96, 637},{365, 454},{10, 197},{428, 435},{543, 568},{34, 51},{197, 654},{615, 674},{10, 474},{376, 654},{21, 557},{407, 467},{491, 564},{256, 557},{399, 704},{399, 564},{399, 589},{442, 578},{365, 376},{533, 637},{36, 201},},
+	{{443, 569},{341, 537},{319, 569},{150, 465},{287, 688},{427, 507},{396, 507},{615, 723},{120, 443},{602, 688},{419, 507},{226, 330},{120, 158},{80, 664},{4, 711},{101, 212},{287, 637},{295, 330},{61, 66},{450, 586},{37, 150},{435, 602},{381, 603},{287, 476},},
+	{{76, 371},{76, 132},{577, 607},{532, 615},{447, 518},{348, 394},{112, 303},{166, 552},{62, 199},{212, 337},{188, 406},{90, 279},{464, 532},{310, 406},{251, 485},{69, 447},{378, 712},{492, 544},{69, 251},{76, 271},{406, 607},{62, 406},{241, 506},{558, 701},},
+	{{414, 664},{500, 623},{258, 504},{464, 579},{525, 579},{104, 623},{25, 408},{548, 602},{193, 199},{239, 703},{50, 75},{81, 525},{262, 664},{408, 548},{425, 500},{371, 514},{173, 360},{500, 504},{385, 639},{25, 439},{568, 610},{25, 579},{168, 464},{306, 616},},
+	{{234, 385},{15, 371},{107, 570},{220, 685},{197, 343},{34, 354},{4, 243},{172, 305},{276, 338},{343, 700},{234, 412},{18, 93},{332, 385},{41, 719},{172, 480},{80, 197},{286, 338},{487, 502},{234, 570},{332, 480},{455, 508},{93, 546},{18, 455},{46, 292},},
+	{{56, 551},{509, 537},{53, 278},{561, 647},{233, 433},{573, 637},{95, 362},{153, 706},{105, 278},{493, 551},{89, 614},{343, 577},{421, 523},{386, 637},{509, 647},{339, 650},{387, 647},{561, 717},{175, 503},{105, 121},{89, 433},{329, 387},{127, 647},{472, 660},},
+	{{517, 558},{236, 364},{97, 236},{286, 682},{149, 628},{33, 628},{484, 713},{162, 246},{14, 349},{401, 498},{512, 603},{364, 528},{118, 653},{73, 401},{232, 401},{201, 349},{484, 603},{446, 599},{420, 476},{97, 246},{156, 450},{666, 696},{143, 375},{156, 197},},
+	{{180, 657},{166, 599},{349, 498},{316, 357},{212, 453},{493, 522},{30, 89},{277, 707},{310, 453},{54, 180},{200, 498},{166, 654},{246, 357},{399, 674},{550, 589},{219, 232},{453, 707},{108, 177},{434, 560},{219, 341},{219, 349},{509, 727},{158, 521},{476, 707},},
+	{{437, 484},{233, 707},{536, 728},{380, 561},{357, 520},{17, 204},{484, 492},{233, 536},{116, 499},{4, 286},{333, 506},{420, 484},{52, 169},{233, 392},{312, 728},{204, 372},{82, 452},{153, 239},{583, 648},{361, 707},{332, 627},{105, 169},{648, 707},{312, 593},},
+	{{506, 660},{520, 554},{108, 640},{74, 463},{82, 630},{339, 476},{274, 371},{92, 332},{426, 554},{617, 623},{229, 573},{165, 630},{108, 433},{379, 476},{194, 573},{300, 486},{44, 308},{457, 496},{82, 346},{18, 379},{179, 457},{44, 573},{250, 300},{147, 554},},
+	{{606, 707},{19, 272},{149, 232},{305, 692},{547, 632},{256, 369},{447, 455},{412, 714},{388, 498},{447, 524},{141, 659},{198, 242},{412, 649},{58, 649},{217, 320},{509, 557},{649, 692},{425, 462},{48, 616},{329, 412},{109, 462},{124, 584},{272, 295},{339, 380},},
+	{{61, 433},{213, 430},{317, 624},{235, 619},{182, 213},{119, 291},{275, 430},{566, 590},{433, 542},{476, 609},{115, 387},{584, 631},{119, 689},{35, 244},{182, 329},{131, 202},{317, 379},{202, 476},{235, 387},{244, 502},{22, 727},{77, 357},{182, 624},{566, 624},},
+	{{392, 477},{76, 120},{206, 669},{477, 703},{110, 189},{2, 290},{235, 290},{354, 544},{103, 228},{246, 659},{261, 440},{218, 440},{392, 699},{142, 235},{158, 632},{127, 337},{50, 253},{369, 392},{158, 276},{81, 284},{261, 349},{332, 591},{189, 253},{71, 419},},
+	{{140, 160},{435, 695},{110, 431},{75, 695},{2, 463},{415, 667},{110, 247},{144, 513},{355, 473},{123, 365},{15, 683},{415, 579},{415, 617},{123, 725},{52, 513},{187, 335},{415, 687},{206, 308},{98, 715},{231, 600},{36, 355},{238, 404},{296, 621},{55, 277},},
+	{{323, 604},{346, 680},{0, 543},{172, 238},{312, 680},{64, 283},{31, 238},{246, 367},{85, 169},{238, 323},{24, 64},{323, 600},{24, 359},{367, 420},{629, 674},{0, 24},{0, 204},{0, 38},{79, 563},{101, 238},{48, 420},{31, 185},{283, 674},{246, 650},},
+	{{6, 347},{141, 335},{133, 148},{434, 530},{252, 347},{148, 169},{141, 601},{17, 446},{50, 148},{213, 372},{17, 246},{446, 631},{148, 571},{197, 400},{246, 268},{50, 571},{17, 462},{246, 725},{22, 518},{347, 486},{17, 645},{335, 593},{61, 268},{63, 706},},
+	{{431, 469},{164, 323},{500, 699},{185, 579},{288, 391},{522, 560},{18, 677},{71, 185},{500, 514},{494, 667},{102, 323},{71, 151},{455, 620},{30, 256},{18, 494},{494, 655},{56, 687},{164, 408},{469, 677},{205, 217},{205, 300},{102, 189},{185, 372},{237, 522},},
+	{{194, 453},{407, 585},{136, 166},{324, 506},{188, 288},{491, 575},{105, 459},{52, 105},{368, 617},{358, 453},{33, 691},{240, 446},{80, 617},{358, 491},{240, 422},{324, 558},{12, 33},{65, 136},{88, 299},{225, 607},{90, 523},{36, 453},{52, 201},{358, 575},},
+	{{392, 529},{125, 669},{130, 253},{97, 626},{231, 480},{246, 341},{76, 726},{105, 174},{71, 341},{71, 111},{19, 155},{322, 592},{19, 428},{239, 537},{223, 656},{166, 428},{231, 420},{9, 504},{341, 552},{50, 322},{34, 231},{111, 592},{246, 487},{606, 680},},
+	{{429, 575},{159, 713},{133, 399},{209, 358},{83, 370},{54, 586},{358, 466},{74, 713},{344, 612},{23, 524},{143, 508},{625, 703},{291, 299},{358, 539},{7, 133},{83, 698},{501, 575},{358, 644},{159, 358},{74, 419},{291, 344},{299, 449},{175, 252},{429, 644},},
+	{{411, 534},{529, 534},{99, 671},{66, 264},{49, 637},{85, 484},{353, 395},{555, 614},{195, 227},{321, 495},{99, 602},{92, 353},{212, 260},{49, 679},{134, 626},{216, 299},{473, 637},{199, 284},{495, 679},{488, 602},{99, 411},{671, 722},{170, 664},{66, 711},},
+	{{388, 608},{256, 266},{39, 510},{229, 517},{113, 560},{70, 662},{182, 342},{289, 646},{204, 364},{113, 182},{17, 450},{70, 608},{424, 539},{5, 81},{174, 448},{106, 545},{5, 560},{154, 411},{380, 480},{182, 517},{364, 699},{229, 719},{130, 364},{214, 493},},
+	{{319, 329},{98, 649},{544, 649},{135, 279},{60, 488},{254, 618},{313, 700},{88, 583},{454, 726},{60, 210},{337, 415},{131, 246},{88, 278},{267, 618},{168, 371},{479, 509},{176, 596},{593, 633},{289, 474},{176, 437},{246, 319},{514, 571},{246, 692},{131, 531},},
+	{{308, 554},{158, 554},{114, 129},{114, 582},{193, 243},{520, 705},{629, 727},{187, 218},{334, 666},{243, 332},{12, 617},{100, 705},{308, 332},{318, 582},{289, 318},{396, 662},{43, 228},{332, 582},{43, 371},{634, 649},{45, 554},{55, 98},{228, 243},{171, 705},},
+	{{372, 677},{488, 536},{133, 677},{62, 606},{443, 546},{276, 310},{215, 223},{182, 287},{144, 576},{27, 379},{262, 488},{288, 488},{392, 632},{398, 546},{133, 521},{254, 546},{223, 379},{443, 719},{133, 409},{25, 226},{176, 672},{223, 248},{121, 606},{96, 606},},
+	{{136, 522},{468, 591},{71, 365},{22, 131},{259, 287},{271, 365},{318, 365},{373, 602},{163, 332},{426, 544},{201, 632},{263, 467},{51, 394},{287, 615},{71, 602},{259, 602},{566, 632},{131, 163},{136, 577},{218, 702},{136, 566},{332, 680},{2, 259},{532, 680},},
+	{{220, 617},{87, 657},{359, 670},{52, 605},{565, 657},{448, 726},{245, 522},{122, 713},{98, 401},{371, 617},{418, 707},{110, 510},{147, 497},{73, 325},{230, 657},{98, 565},{180, 565},{435, 643},{405, 474},{147, 418},{435, 592},{122, 643},{236, 592},{39, 288},},
+	{{425, 616},{507, 694},{249, 675},{167, 174},{15, 322},{266, 539},{378, 490},{174, 723},{524, 632},{223, 395},{307, 425},{167, 629},{35, 585},{349, 375},{2, 167},{558, 656},{209, 266},{223, 453},{272, 672},{15, 558},{150, 656},{422, 568},{209, 541},{90, 334},},
+	{{423, 479},{146, 376},{335, 564},{345, 570},{273, 520},{62, 618},{222, 581},{67, 273},{479, 581},{23, 714},{423, 603},{332, 365},{262, 647},{33, 332},{249, 549},{536, 714},{200, 592},{88, 544},{322, 506},{433, 503},{105, 549},{322, 335},{200, 564},{62, 479},},
+	{{280, 572},{291, 649},{204, 711},{649, 681},{280, 462},{484, 529},{69, 649},{44, 305},{56, 158},{377, 396},{280, 311},{432, 521},{261, 637},{419, 551},{96, 152},{69, 291},{193, 277},{44, 432},{19, 349},{572, 616},{19, 603},{103, 445},{235, 280},{591, 603},},
+	{{231, 410},{105, 220},{201, 513},{273, 350},{476, 654},{162, 385},{513, 534},{49, 126},{235, 623},{364, 592},{534, 654},{273, 654},{215, 364},{143, 147},{460, 635},{336, 410},{231, 695},{176, 357},{182, 441},{513, 602},{21, 325},{65, 499},{8, 513},{147, 534},},
+	{{498, 653},{165, 311},{425, 592},{216, 563},{289, 498},{299, 520},{175, 194},{231, 334},{59, 546},{206, 249},{283, 584},{39, 659},{334, 350},{108, 659},{252, 419},{49, 119},{311, 374},{59, 283},{80, 551},{231, 508},{402, 486},{264, 387},{625, 679},{148, 709},},
+	{{29, 411},{177, 681},{359, 411},{42, 488},{256, 692},{307, 653},{411, 692},{104, 658},{12, 307},{462, 504},{12, 22},{217, 544},{159, 324},{591, 594},{307, 711},{364, 411},{229, 614},{194, 681},{398, 450},{68, 237},{88, 217},{536, 658},{384, 571},{53, 297},},
+	{{371, 483},{88, 314},{275, 555},{66, 644},{155, 223},{155, 561},{149, 433},{421, 606},{354, 421},{325, 656},{23, 391},{223, 555},{275, 458},{366, 511},{366, 577},{391, 531},{155, 677},{6, 483},{40, 548},{80, 571},{441, 561},{333, 644},{6, 183},{548, 561},},
+	{{96, 411},{332, 693},{268, 305},{96, 319},{252, 611},{38, 445},{397, 598},{374, 499},{198, 252},{273, 434},{294, 512},{69, 120},{32, 390},{567, 644},{166, 728},{319, 550},{527, 540},{198, 214},{384, 411},{351, 664},{166, 458},{346, 705},{346, 564},{116, 637},},
+	{{217, 678},{263, 380},{315, 426},{346, 593},{437, 619},{678, 685},{19, 372},{367, 561},{115, 701},{372, 603},{405, 596},{341, 570},{537, 548},{59, 527},{517, 672},{241, 652},{300, 452},{45, 59},{168, 495},{212, 593},{495, 537},{561, 603},{146, 452},{19, 115},},
+	{{345, 443},{238, 468},{320, 614},{509, 717},{265, 560},{333, 462},{532, 693},{279, 485},{55, 582},{137, 462},{361, 468},{498, 607},{320, 532},{216, 498},{553, 717},{191, 357},{137, 429},{333, 528},{49, 687},{85, 159},{361, 602},{68, 345},{528, 687},{99, 486},},
+	{{217, 667},{34, 539},{321, 661},{38, 321},{60, 371},{462, 690},{185, 570},{147, 411},{80, 680},{516, 585},{313, 690},{170, 396},{325, 690},{157, 522},{132, 510},{122, 570},{550, 680},{257, 449},{325, 570},{257, 680},{9, 667},{207, 271},{135, 449},{357, 510},},
+	{{145, 699},{103, 656},{614, 659},{46, 443},{351, 365},{625, 642},{145, 351},{310, 642},{522, 585},{86, 341},{297, 365},{520, 720},{66, 585},{44, 66},{110, 263},{46, 699},{320, 679},{659, 720},{44, 285},{2, 46},{2, 365},{44, 604},{44, 194},{483, 679},},
+	{{457, 536},{121, 313},{623, 696},{182, 323},{354, 609},{19, 361},{216, 274},{684, 696},{93, 176},{382, 437},{462, 515},{398, 468},{127, 462},{341, 361},{437, 457},{47, 468},{216, 294},{575, 664},{416, 462},{13, 635},{302, 529},{121, 146},{75, 238},{398, 416},},
+	{{422, 518},{409, 518},{120, 529},{382, 504},{57, 422},{94, 613},{94, 696},{297, 470},{149, 613},{57, 670},{63, 470},{191, 692},{470, 639},{504, 629},{165, 542},{14, 598},{6, 494},{57, 633},{426, 441},{37, 696},{217, 283},{324, 498},{79, 504},{598, 639},},
+	{{216, 573},{70, 376},{15, 573},{15, 382},{158, 597},{608, 721},{136, 573},{272, 469},{236, 334},{493, 662},{50, 436},{566, 708},{280, 566},{410, 639},{356, 708},{197, 291},{387, 628},{597, 608},{211, 326},{108, 713},{422, 586},{103, 430},{461, 674},{39, 586},},
+	{{276, 560},{122, 129},{51, 382},{143, 680},{208, 402},{360, 680},{238, 644},{122, 187},{112, 257},{83, 360},{221, 402},{448, 556},{51, 402},{535, 596},{83, 535},{12, 705},{238, 609},{276, 525},{12, 257},{644, 728},{487, 644},{509, 580},{56, 560},{422, 596},},
+	{{109, 531},{189, 492},{189, 505},{472, 597},{119, 240},{462, 619},{64, 636},{109, 611},{274, 665},{500, 619},{611, 694},{144, 263},{64, 322},{413, 505},{58, 248},{64, 515},{201, 392},{173, 611},{526, 694},{58, 163},{375, 526},{477, 665},{611, 689},{303, 702},},
+	{{128, 612},{7, 12},{149, 252},{340, 396},{340, 355},{223, 591},{340, 683},{128, 591},{103, 497},{223, 252},{149, 484},{67, 673},{464, 711},{54, 301},{380, 597},{345, 548},{168, 223},{149, 211},{471, 711},{395, 580},{504, 612},{80, 223},{366, 605},{98, 538},},
+	{{585, 718},{538, 674},{29, 218},{333, 489},{122, 468},{265, 544},{288, 466},{77, 500},{205, 568},{718, 720},{506, 703},{361, 424},{389, 720},{42, 208},{333, 544},{129, 544},{466, 480},{282, 698},{108, 568},{437, 678},{254, 568},{25, 42},{42, 240},{122, 437},},
+	{{197, 616},{664, 713},{142, 208},{21, 295},{129, 237},{513, 699},{279, 556},{142, 468},{248, 567},{237, 307},{595, 624},{202, 699},{279, 303},{415, 725},{21, 562},{463, 590},{444, 489},{197, 562},{328, 556},{295, 616},{142, 231},{41, 62},{541, 590},{484, 638},},
+	{{236, 358},{84, 109},{192, 668},{294, 554},{66, 98},{59, 345},{262, 358},{333, 620},{236, 582},{178, 533},{533, 564},{401, 533},{192, 517},{212, 700},{572, 690},{184, 429},{370, 640},{226, 668},{384, 429},{80, 226},{109, 358},{326, 620},{126, 564},{413, 710},},
+	{{251, 600},{300, 460},{197, 367},{460, 551},{77, 137},{611, 630},{91, 600},{7, 421},{313, 487},{107, 421},{228, 551},{324, 671},{338, 477},{148, 393},{51, 534},{436, 487},{40, 367},{137, 671},{324, 447},{29, 630},{630, 657},{108, 159},{371, 708},{148, 382},},
+	{{582, 668},{87, 228},{244, 622},{557, 727},{4, 221},{87, 429},{18, 104},{303, 644},{128, 135},{41, 124},{4, 727},{327, 542},{269, 605},{283, 537},{198, 505},{313, 542},{18, 208},{473, 505},{18, 170},{145, 317},{421, 450},{303, 622},{582, 685},{620, 717},},
+	{{318, 472},{18, 52},{44, 347},{98, 303},{44, 378},{147, 241},{18, 269},{653, 663},{495, 668},{241, 347},{378, 668},{44, 132},{12, 653},{529, 614},{308, 529},{482, 653},{44, 599},{426, 694},{28, 489},{201, 653},{132, 153},{269, 684},{293, 372},{411, 560},},
+	{{82, 318},{5, 681},{217, 611},{194, 712},{509, 520},{111, 207},{53, 429},{359, 380},{144, 177},{520, 686},{270, 641},{446, 665},{53, 478},{128, 268},{280, 457},{140, 457},{429, 635},{57, 509},{123, 429},{375, 586},{346, 359},{270, 413},{238, 720},{165, 394},},
+	{{4, 193},{162, 242},{50, 712},{44, 162},{104, 479},{88, 474},{78, 257},{63, 201},{123, 261},{34, 474},{108, 415},{78, 346},{408, 632},{19, 136},{63, 618},{316, 546},{78, 361},{123, 618},{56, 335},{50, 599},{123, 499},{19, 696},{78, 251},{201, 232},},
+	{{52, 542},{94, 513},{294, 589},{134, 441},{82, 706},{309, 513},{266, 513},{150, 668},{18, 645},{68, 563},{422, 429},{82, 683},{119, 327},{167, 367},{135, 272},{494, 706},{315, 645},{106, 309},{589, 696},{542, 721},{294, 638},{82, 407},{6, 706},{188, 523},},
+	{{141, 653},{155, 626},{88, 570},{523, 601},{216, 657},{399, 447},{40, 51},{506, 570},{126, 605},{104, 148},{73, 543},{184, 667},{245, 667},{148, 657},{461, 570},{88, 557},{657, 681},{289, 605},{68, 506},{279, 447},{68, 399},{68, 536},{73, 523},{155, 319},},
+	{{128, 649},{128, 283},{28, 289},{214, 501},{414, 544},{5, 426},{317, 673},{260, 558},{369, 728},{523, 662},{16, 481},{78, 334},{5, 684},{5, 354},{260, 317},{649, 728},{260, 520},{395, 679},{362, 649},{369, 579},{275, 283},{84, 180},{438, 684},{28, 100},},
+	{{148, 545},{566, 724},{11, 355},{389, 402},{102, 381},{280, 660},{414, 649},{592, 660},{176, 381},{340, 636},{34, 672},{108, 469},{148, 246},{202, 409},{343, 711},{456, 488},{389, 469},{456, 528},{246, 469},{263, 402},{176, 616},{301, 499},{141, 414},{278, 389},},
+	{{397, 407},{312, 397},{256, 484},{431, 679},{279, 490},{223, 327},{126, 377},{190, 590},{62, 625},{180, 420},{159, 654},{546, 679},{120, 665},{513, 583},{529, 725},{366, 718},{641, 718},{213, 615},{262, 625},{103, 695},{62, 72},{256, 383},{333, 665},{95, 273},},
+	{{135, 343},{115, 376},{252, 652},{267, 567},{425, 493},{384, 537},{31, 509},{387, 493},{179, 282},{93, 220},{115, 292},{292, 522},{115, 343},{93, 343},{93, 155},{674, 691},{497, 632},{252, 292},{2, 588},{200, 425},{14, 497},{42, 387},{73, 480},{248, 613},},
+	{{436, 657},{152, 404},{140, 489},{297, 595},{5, 470},{168, 587},{140, 351},{351, 564},{281, 351},{184, 634},{521, 541},{392, 458},{76, 281},{541, 718},{206, 458},{649, 718},{17, 184},{206, 446},{130, 277},{184, 436},{168, 710},{25, 624},{226, 541},{343, 426},},
+	{{352, 626},{145, 713},{181, 203},{299, 713},{6, 536},{214, 435},{120, 244},{181, 476},{294, 662},{286, 373},{68, 430},{193, 373},{76, 203},{155, 536},{203, 555},{114, 626},{6, 275},{368, 401},{463, 696},{244, 410},{574, 594},{594, 670},{68, 384},{594, 696},},
+	{{342, 608},{51, 207},{273, 547},{153, 337},{197, 643},{22, 28},{594, 682},{169, 273},{381, 455},{38, 702},{197, 267},{207, 674},{202, 329},{22, 569},{301, 682},{66, 485},{267, 430},{17, 535},{176, 273},{535, 627},{267, 377},{217, 240},{153, 509},{22, 663},},
+	{{44, 431},{27, 264},{249, 582},{461, 656},{47, 656},{174, 630},{27, 619},{461, 707},{431, 707},{264, 707},{572, 624},{489, 726},{286, 347},{67, 461},{98, 499},{55, 242},{693, 707},{101, 340},{624, 712},{227, 314},{249, 253},{158, 548},{55, 678},{213, 504},},
+	{{119, 215},{370, 634},{6, 298},{602, 716},{184, 685},{268, 716},{500, 585},{298, 661},{73, 306},{31, 426},{45, 463},{190, 728},{23, 685},{89, 135},{45, 184},{439, 624},{215, 474},{113, 526},{306, 500},{306, 414},{215, 414},{227, 728},{414, 716},{260, 577},},
+	{{224, 466},{288, 353},{245, 343},{60, 88},{134, 328},{79, 163},{312, 392},{67, 392},{79, 392},{105, 108},{298, 376},{339, 376},{273, 447},{79, 288},{319, 706},{0, 108},{11, 224},{196, 550},{447, 479},{134, 728},{681, 718},{147, 685},{573, 728},{298, 339},},
+	{{296, 424},{322, 636},{52, 579},{236, 246},{68, 695},{228, 589},{169, 340},{87, 510},{120, 540},{342, 462},{246, 306},{213, 715},{90, 556},{44, 644},{274, 723},{263, 579},{11, 589},{90, 723},{342, 418},{11, 274},{203, 439},{274, 302},{87, 715},{104, 154},},
+	{{193, 222},{253, 327},{547, 607},{33, 208},{346, 483},{113, 286},{154, 689},{19, 286},{120, 401},{528, 622},{245, 428},{193, 622},{300, 483},{378, 554},{405, 622},{188, 378},{33, 499},{286, 722},{52, 90},{528, 666},{19, 146},{36, 327},{531, 639},{375, 554},},
+	{{404, 593},{448, 577},{360, 373},{44, 204},{329, 720},{48, 486},{448, 499},{135, 685},{76, 516},{233, 642},{290, 437},{265, 594},{122, 486},{82, 642},{2, 659},{166, 298},{278, 607},{632, 701},{373, 565},{329, 607},{2, 320},{176, 207},{44, 537},{550, 574},},
+	{{451, 592},{39, 152},{434, 602},{380, 604},{287, 474},{223, 693},{294, 534},{55, 660},{485, 565},{55, 229},{294, 401},{155, 388},{327, 434},{287, 505},{297, 405},{180, 721},{427, 528},{49, 287},{72, 155},{223, 494},{117, 350},{229, 237},{176, 652},{200, 421},},
+	{{72, 278},{409, 606},{56, 409},{235, 508},{564, 694},{138, 480},{89, 655},{28, 302},{455, 598},{302, 591},{13, 160},{53, 307},{123, 518},{382, 694},{224, 598},{409, 498},{210, 292},{307, 606},{292, 724},{638, 644},{138, 409},{307, 382},{138, 714},{468, 724},},
+	{{19, 434},{575, 604},{19, 578},{164, 462},{314, 615},{62, 428},{62, 328},{19, 536},{615, 639},{75, 706},{286, 387},{211, 519},{122, 217},{547, 627},{38, 721},{615, 635},{132, 288},{197, 578},{132, 383},{62, 383},{5, 178},{109, 445},{383, 414},{155, 344},},
+	{{240, 575},{325, 480},{454, 506},{90, 547},{21, 454},{53, 294},{306, 480},{412, 575},{6, 395},{114, 480},{266, 412},{553, 644},{354, 579},{120, 506},{371, 431},{553, 607},{371, 491},{277, 632},{365, 644},{403, 680},{83, 100},{412, 619},{528, 705},{246, 287},},
+	{{101, 124},{82, 439},{332, 388},{131, 639},{468, 662},{238, 695},{468, 618},{124, 483},{131, 577},{483, 574},{38, 247},{135, 291},{204, 398},{59, 150},{439, 468},{373, 674},{340, 458},{161, 360},{82, 204},{135, 546},{101, 261},{247, 634},{381, 514},{280, 546},},
+	{{97, 248},{161, 452},{666, 698},{138, 372},{161, 192},{521, 649},{14, 578},{117, 420},{46, 558},{481, 553},{26, 633},{318, 698},{26, 83},{3, 698},{100, 372},{452, 698},{161, 186},{161, 312},{232, 318},{420, 681},{186, 541},{292, 481},{405, 681},{100, 280},},
+	{{216, 340},{216, 348},{512, 725},{156, 517},{474, 703},{370, 512},{19, 547},{261, 332},{128, 226},{400, 617},{370, 699},{59, 311},{59, 639},{236, 454},{19, 467},{106, 719},{400, 699},{285, 588},{435, 725},{285, 625},{33, 400},{98, 184},{118, 552},{0, 261},},
+	{{366, 709},{106, 164},{651, 709},{308, 593},{344, 522},{106, 676},{373, 421},{423, 689},{86, 126},{305, 434},{473, 522},{336, 576},{483, 593},{212, 560},{458, 574},{33, 270},{150, 196},{318, 720},{106, 356},{270, 483},{126, 434},{344, 366},{483, 538},{500, 644},},
+	{{21, 384},{175, 455},{41, 571},{246, 302},{150, 550},{333, 693},{116, 679},{347, 726},{127, 673},{121, 679},{81, 95},{27, 606},{218, 628},{218, 524},{95, 512},{230, 577},{230, 492},{384, 463},{15, 421},{294, 306},{265, 476},{272, 577},{210, 628},{127, 480},},
+	{{327, 406},{108, 459},{125, 579},{273, 288},{333, 385},{299, 564},{416, 503},{283, 713},{13, 556},{518, 658},{72, 654},{288, 333},{241, 503},{139, 191},{504, 587},{273, 684},{365, 673},{610, 673},{95, 108},{162, 504},{211, 226},{457, 579},{226, 288},{524, 556},},
+	{{248, 499},{22, 728},{74, 354},{187, 622},{561, 622},{319, 514},{90, 667},{104, 388},{388, 715},{319, 715},{22, 596},{319, 388},{154, 199},{514, 522},{342, 667},{154, 474},{401, 611},{451, 681},{193, 331},{386, 546},{451, 687},{354, 687},{193, 416},{22, 319},},
+	{{86, 284},{265, 350},{325, 590},{191, 252},{66, 420},{221, 265},{44, 73},{639, 691},{13, 518},{168, 289},{60, 400},{86, 385},{132, 712},{181, 590},{20, 616},{66, 86},{135, 181},{98, 252},{312, 338},{366, 441},{233, 278},{436, 712},{155, 485},{570, 652},},
+	{{226, 599},{42, 359},{237, 398},{295, 624},{58, 273},{460, 558},{206, 385},{297, 634},{364, 481},{42, 624},{211, 554},{324, 339},{450, 666},{446, 592},{226, 450},{120, 599},{297, 548},{80, 450},{85, 599},{398, 573},{237, 297},{624, 685},{80, 109},{2, 603},},
+	{{2, 40},{75, 565},{102, 235},{45, 416},{35, 184},{284, 674},{246, 653},{633, 702},{2, 235},{475, 691},{506, 594},{19, 506},{75, 381},{2, 308},{396, 691},{366, 633},{40, 653},{75, 577},{144, 633},{95, 308},{301, 395},{321, 691},{15, 409},{88, 144},},
+	{{346, 488},{15, 645},{341, 590},{55, 267},{65, 702},{77, 604},{65, 529},{408, 419},{94, 119},{590, 654},{484, 674},{25, 173},{35, 65},{318, 484},{150, 287},{15, 629},{299, 419},{252, 397},{48, 629},{219, 419},{0, 637},{25, 299},{244, 433},{15, 664},},
+	{{468, 675},{200, 219},{8, 526},{200, 299},{104, 190},{187, 372},{240, 526},{200, 710},{560, 685},{215, 675},{507, 675},{519, 634},{72, 408},{162, 342},{606, 646},{41, 576},{383, 685},{30, 112},{136, 725},{400, 431},{353, 431},{93, 372},{279, 655},{72, 146},},
+	{{228, 607},{93, 524},{36, 450},{48, 204},{354, 574},{48, 173},{124, 532},{252, 503},{48, 65},{93, 420},{197, 312},{252, 670},{36, 217},{587, 675},{59, 574},{187, 701},{93, 675},{354, 554},{187, 654},{252, 354},{284, 524},{159, 245},{340, 387},{347, 507},},
+	{{339, 554},{45, 317},{34, 225},{116, 588},{244, 493},{609, 676},{139, 317},{609, 662},{34, 127},{116, 493},{116, 516},{56, 462},{90, 265},{41, 617},{347, 594},{244, 516},{90, 139},{12, 360},{436, 632},{41, 691},{164, 210},{45, 265},{45, 347},{64, 493},},
+	{{80, 420},{293, 345},{300, 449},{174, 253},{430, 642},{87, 208},{59, 519},{461, 627},{420, 627},{11, 51},{240, 672},{300, 672},{461, 499},{450, 635},{174, 593},{1, 672},{469, 481},{185, 607},{240, 461},{134, 393},{31, 43},{80, 493},{549, 595},{293, 560},},
+	{{488, 600},{105, 409},{671, 721},{169, 664},{67, 719},{81, 495},{128, 304},{176, 420},{128, 530},{149, 350},{60, 120},{149, 329},{34, 600},{67, 677},{46, 664},{116, 149},{304, 399},{8, 641},{105, 508},{176, 329},{105, 202},{149, 564},{81, 189},{105, 630},},
+	{{187, 520},{362, 696},{227, 715},{128, 362},{207, 488},{52, 588},{154, 696},{13, 325},{441, 532},{37, 113},{93, 441},{333, 704},{154, 609},{33, 201},{616, 689},{294, 596},{374, 628},{314, 563},{93, 99},{151, 530},{201, 665},{588, 667},{315, 441},{350, 655},},
+	{{177, 435},{244, 319},{517, 571},{244, 684},{128, 537},{103, 457},{108, 650},{491, 715},{20, 445},{103, 401},{0, 596},{304, 319},{583, 591},{309, 622},{103, 469},{467, 543},{20, 495},{89, 491},{304, 727},{665, 669},{225, 349},{159, 445},{0, 71},{665, 684},},
+	{{633, 656},{53, 551},{60, 93},{229, 246},{173, 706},{152, 676},{331, 662},{152, 311},{450, 474},{208, 311},{5, 407},{87, 261},{173, 563},{53, 246},{124, 299},{173, 319},{280, 404},{27, 656},{277, 381},{16, 152},{152, 364},{64, 548},{130, 696},{538, 571},},
+	{{24, 229},{173, 674},{117, 606},{204, 606},{246, 714},{625, 652},{242, 332},{169, 714},{63, 311},{242, 638},{117, 339},{156, 268},{365, 540},{296, 680},{1, 332},{208, 727},{86, 252},{332, 453},{117, 169},{204, 530},{299, 551},{204, 618},{110, 117},{332, 638},},
+	{{220, 704},{135, 563},{330, 683},{0, 254},{538, 683},{254, 622},{174, 314},{300, 616},{66, 174},{289, 704},{469, 499},{96, 129},{37, 525},{174, 400},{135, 300},{135, 174},{276, 575},{389, 590},{135, 248},{212, 649},{235, 265},{538, 689},{125, 189},{85, 289},},
+	{{148, 419},{436, 586},{119, 643},{234, 586},{37, 294},{483, 614},{91, 168},{483, 597},{60, 365},{143, 626},{202, 336},{419, 535},{75, 268},{226, 675},{143, 535},{436, 582},{503, 555},{212, 483},{582, 722},{88, 518},{183, 271},{45, 143},{23, 75},{436, 611},},
+	{{9, 561},{147, 648},{153, 672},{420, 569},{208, 540},{97, 341},{600, 660},{153, 569},{173, 349},{208, 631},{455, 561},{425, 600},{526, 569},{173, 555},{387, 540},{104, 300},{261, 672},{555, 580},{32, 485},{432, 463},{253, 277},{287, 425},{387, 561},{67, 353},},
+	{{434, 502},{106, 549},{317, 334},{200, 559},{55, 482},{356, 701},{344, 450},{466, 610},{48, 450},{196, 610},{200, 684},{200, 317},{256, 663},{256, 488},{250, 536},{180, 307},{282, 502},{356, 636},{92, 466},{196, 442},{411, 442},{196, 466},{466, 663},{250, 404},},
+	{{19, 345},{575, 619},{253, 388},{106, 442},{235, 287},{589, 603},{122, 315},{315, 421},{273, 714},{202, 480},{150, 423},{575, 671},{539, 710},{230, 465},{35, 161},{35, 442},{510, 632},{681, 710},{367, 539},{202, 465},{106, 126},{287, 517},{230, 351},{137, 263},},
+	{{520, 600},{23, 332},{69, 500},{2, 520},{147, 533},{235, 712},{188, 533},{562, 616},{134, 689},{523, 712},{2, 457},{546, 693},{162, 416},{389, 557},{97, 577},{220, 310},{483, 520},{271, 587},{137, 635},{426, 670},{426, 520},{399, 624},{546, 557},{406, 426},},
+	{{76, 552},{231, 504},{262, 387},{627, 681},{152, 703},{172, 514},{470, 627},{321, 526},{106, 135},{106, 606},{231, 656},{254, 590},{64, 412},{254, 362},{76, 301},{206, 627},{156, 414},{279, 703},{21, 656},{86, 354},{130, 184},{436, 536},{241, 514},{254, 637},},
+	{{70, 237},{82, 216},{532, 660},{380, 567},{50, 300},{410, 490},{263, 448},{380, 497},{214, 688},{271, 320},{214, 287},{58, 454},{176, 288},{660, 709},{497, 564},{18, 216},{214, 602},{50, 720},{375, 688},{141, 552},{50, 354},{216, 448},{103, 577},{333, 626},},
+	{{75, 572},{441, 566},{334, 646},{0, 181},{545, 566},{495, 545},{34, 572},{75, 670},{34, 334},{703, 720},{94, 475},{49, 159},{0, 371},{584, 717},{566, 680},{104, 646},{431, 623},{174, 510},{34, 316},{49, 703},{62, 223},{293, 515},{293, 419},{94, 634},},
+	{{205, 211},{382, 407},{354, 662},{163, 458},{348, 709},{348, 563},{110, 636},{407, 674},{360, 382},{520, 714},{75, 602},{140, 607},{281, 602},{329, 525},{303, 652},{421, 446},{243, 693},{51, 110},{163, 264},{140, 495},{446, 570},{341, 533},{323, 570},{151, 462},},
+	{{210, 593},{502, 537},{560, 607},{145, 451},{447, 702},{142, 394},{275, 490},{312, 594},{131, 428},{206, 275},{346, 633},{316, 651},{396, 727},{394, 436},{414, 560},{607, 651},{34, 93},{14, 436},{37, 187},{75, 377},{75, 131},{578, 607},{537, 619},{447, 514},},
+}
+
+var grid9EncodeCount = [256]uint8{
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+	24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,24,
+}
+
+const grid9DecodeSalt = 0x00000000
+
+var grid9DecodeEntries = [grid9DecodeSize]uint32{
+	0x07DA0202,0x015A3185,0x086292B9,0x051A2620,0x0632D7FF,0x00B131B6,0x00000000,0x002AB16E,
+	0x00000000,0x00E96C4F,0x0559A133,0x09166258,0x00FC8D03,0x00000000,0x04DEAA00,0x09B2C265,
+	0x01292532,0x055D9190,0x02D25041,0x05B2BBAE,0x041E43DA,0x0335DD52,0x049559EF,0x04867E27,
+	0x00000000,0x03AA4AF6,0x03963DA2,0x00000000,0x04AE34E6,0x09CEA9FB,0x0531C5F4,0x040DC017,
+	0x00000000,0x00000000,0x00000000,0x04BE5F94,0x00000000,0x0188B8E3,0x023E8D8C,0x00000000,
+	0x00000000,0x003EABA6,0x00000000,0x085E4C92,0x00002C00,0x02711A86,0x00000000,0x08829983,
+	0x0212C8E8,0x00000000,0x00000000,0x03D64694,0x00000000,0x03F9A311,0x0321F2A0,0x07F608CC,
+	0x00000000,0x03EDC05B,0x00000000,0x047EB09A,0x067AD620,0x0151AA87,0x02B53FF3,0x081EC409,
+	0x04113DD0,0x096E7267,0x049A16DD,0x01A964E4,0x03DE7AE1,0x04612674,0x00000000,0x018A35B3,
+	0x03359C2A,0x00000000,0x05CDEBE0,0x00F9FA25,0x084AA55E,0x0252248E,0x066E30CB,0x018ACBA6,
+	0x02F2A859,0x02C9E776,0x00000000,0x00000000,0x06DA1554,0x03C2A0EF,0x05062C14,0x01AA7A49,
+	0x00000000,0x060E1CF7,0x05B28739,0x005690FB,0x0749F548,0x053684BA,0x082E59CF,0x093A8EEB,
+	0x0569E8EB,0x0552912B,0x075A7FC1,0x05564F01,0x0556C14A,0x00000000,0x017557D3,0x021E154F,
+	0x01E2834F,0x01B27757,0x012545B3,0x02B22418,0x03D9D82A,0x02059E5E,0x0662249C,0x00000000,
+	0x00F1DD51,0x0000AE38,0x0501700B,0x0356CBDA,0x006C4992,0x0391FF54,0x0095CA03,0x00000000,
+	0x03A5B19E,0x03C68CBC,0x01DD9F3B,0x001AC2CE,0x03E63C20,0x00000000,0x00C50B8A,0x00CACF26,
+	0x017E8D08,0x055628AB,0x00000000,0x00F5035D,0x02C165B7,0x00000000,0x01BA4E04,0x05A175DC,
+	0x00A43EC7,0x031668C7,0x01E13619,0x00C21922,0x0262A4F3,0x066EB682,0x03C23FE0,0x00000000,
+	0x02B27C30,0x00000000,0x01EE6ACD,0x00E04B6E,0x0276CF05,0x0485F175,0x00000000,0x00F9ACDF,
+	0x0542BB3E,0x02D4CBD5,0x026DE5E8,0x00ED59C8,0x019DD5F2,0x010ACE49,0x00000000,0x023EA8C3,
+	0x0158BD49,0x00000000,0x05EA9CCB,0x00000000,0x05BA5DC5,0x02523BA8,0x02517EC9,0x00A03D20,
+	0x01FD0304,0x006EA02A,0x00000000,0x0111AED5,0x013228FB,0x0199C608,0x02ECDAB0,0x0349CC6C,
+	0x03E6BF64,0x01C0E874,0x0178FE41,0x015C9443,0x000047F2,0x01EA83B3,0x044D5610,0x01B0A26F,
+	0x00000000,0x03ADBE03,0x0892B853,0x00000000,0x02051D8D,0x03159101,0x03D93D4B,0x04EDBA8D,
+	0x0052C397,0x0762A602,0x0786D282,0x00000000,0x0932BC27,0x00000000,0x004C73BC,0x02BCEF64,
+	0x005632C7,0x00000000,0x01989428,0x049A4DCE,0x04B9BD48,0x02912141,0x07524FB2,0x00000000,
+	0x049ACE7C,0x00000000,0x00000000,0x0045C2AE,0x019EB7D2,0x037CF50A,0x05D1AF39,0x00000000,
+	0x00000000,0x00000000,0x085634C8,0x00000000,0x00D0D32F,0x00000000,0x02A25B70,0x03D19AD5,
+	0x0315CA67,0x00000000,0x00DEA6D7,0x00000000,0x0052A602,0x0010F39D,0x00000000,0x00F173BE,
+	0x083278B4,0x07BA8FA9,0x01A99606,0x091AB266,0x03952782,0x048187A9,0x00018339,0x08C25FFF,
+	0x02550082,0x073227C9,0x00000000,0x00AE9511,0x09A29418,0x04998766,0x036A0CE5,0x05F997FE,
+	0x04E2098B,0x05265FDC,0x06F67801,0x0220FC70,0x07065042,0x00000000,0x0A26B4A3,0x0272577F,
+	0x00000000,0x0A6AB89F,0x01F9D50F,0x099ED39A,0x051AAA4E,0x024D780E,0x02ACDD72,0x00000000,
+	0x03865FAA,0x039E056B,0x02990D57,0x00000000,0x00894EFD,0x00000000,0x025E7F88,0x0439CA13,
+	0x00000000,0x0352BCC8,0x04D66EF2,0x00E07284,0x002201B7,0x00000000,0x0106BEEB,0x0756A5A9,
+	0x03DD0130,0x00000000,0x00000000,0x02489C7E,0x0439E3E4,0x0101777F,0x02B6A2F4,0x07D20E31,
+	0x04197F15,0x00000000,0x04968C0F,0x00000000,0x03857D7A,0x09065F9B,0x02C6314C,0x02C6A9B9,
+	0x00000000,0x07927EC7,0x00000000,0x00C5C751,0x067A89D1,0x012CF926,0x031226D9,0x00000000,
+	0x00000000,0x0415D30B,0x00000000,0x00000000,0x0085937F,0x02199305,0x045E9B00,0x075A841A,
+	0x073A0819,0x01360935,0x023DA42A,0x0171D2F8,0x00195BA8,0x04760852,0x01825EB1,0x00000000,
+	0x0019112E,0x00B1B0B6,0x083E6624,0x01A4A9A1,0x0272B134,0x00000000,0x08121A10,0x088ED227,
+	0x073DEC7C,0x04853EB0,0x0719E273,0x05A64C10,0x007CEEA7,0x084EB24E,0x02795738,0x00000000,
+	0x0559CEDA,0x04CD7EDE,0x0461B678,0x0326340E,0x01460D9C,0x01CA72D5,0x00000000,0x00000000,
+	0x0381D2D9,0x07FA3828,0x05DE7E31,0x04FD49AF,0x00000000,0x00000000,0x037EB5DD,0x04AE1A60,
+	0x03B250B3,0x08065D85,0x051E1ECA,0x02D52167,0x062DA47E,0x01A4DCB7,0x0086258D,0x06D246F6,
+	0x00000000,0x01DA101A,0x00B9249D,0x0695BEFE,0x00000000,0x08E26A0F,0x027A2AB0,0x040D6746,
+	0x020AB8F3,0x018991B3,0x021599B1,0x0246BBBF,0x00000000,0x0009CC63,0x00000000,0x051A6CC8,
+	0x004A763B,0x03FE8693,0x03B6D868,0x0030CA5E,0x06BEAA8F,0x01EDADCC,0x00AA006B,0x02BE946B,
+	0x00712EDE,0x04768A88,0x00000000,0x050A677A,0x038A2822,0x00000000,0x03AD18B6,0x020A916D,
+	0x0200E2E3,0x00000000,0x008D3A65,0x00000000,0x011C9502,0x00000000,0x00000000,0x0681F7E6,
+	0x01CC8C34,0x00000000,0x08AAC485,0x080616B7,0x0084D798,0x03F162ED,0x041D7CBC,0x036D031E,
+	0x00000000,0x00000000,0x00000000,0x024A5B1B,0x0365F96A,0x041ACA95,0x00000000,0x02FA0B8A,
+	0x00000000,0x00000000,0x0364F0D6,0x00000000,0x00000000,0x03C1CDEF,0x00000000,0x013D7858,
+	0x028E9D61,0x00F1E8AF,0x09E2BDDC,0x04720CED,0x010D8F6B,0x0419D981,0x06B63FAC,0x00000000,
+	0x00000000,0x00000000,0x0100A15A,0x0249C4BC,0x00C08B92,0x008E49B4,0x0706134A,0x014E17C3,
+	0x00000000,0x057E98BB,0x06ADFB9A,0x00000000,0x031CFC14,0x06AA20B2,0x00000000,0x037DA687,
+	0x05FEB445,0x01962651,0x00F56792,0x00000000,0x00000000,0x02C69F4D,0x02BD812A,0x0041AA83,
+	0x00503E8F,0x00000000,0x060E3650,0x039605AE,0x0064E2B1,0x03BE7510,0x004098F3,0x00000000,
+	0x00AD4777,0x00E9A31A,0x01646E21,0x073AD892,0x00000000,0x08261C2D,0x00000000,0x003DA5E5,
+	0x00000000,0x01DC8F6D,0x018D4776,0x0148AE66,0x00000000,0x033D0FBE,0x02ECD461,0x071AD6AF,
+	0x00000000,0x02B8CF78,0x03C20EEC,0x00E5A6C1,0x00000000,0x02F0C62E,0x0321A9D3,0x00000000,
+	0x01252C54,0x00000000,0x090A5FFF,0x04E62123,0x01B69B8D,0x00000000,0x01591CE8,0x03953A30,
+	0x027CE492,0x04AE7123,0x00000000,0x078E66F6,0x0291CEDF,0x0461C9CC,0x04212180,0x05222CC7,
+	0x006670D4,0x063A3DE9,0x00466909,0x06FEBEFF,0x01ED0A26,0x049A2AC8,0x08128E3B,0x04E2BF62,
+	0x0064A623,0x00000000,0x0096A859,0x00000000,0x00000000,0x0392C65E,0x013565A4,0x05C6540B,
+	0x00000000,0x03C1A6AA,0x010AA949,0x031DB633,0x003E98EB,0x009DD725,0x046ABAC6,0x0842AE59,
+	0x021CE420,0x00000000,0x02D8E280,0x021120DF,0x0351E3F6,0x00000000,0x03E237C7,0x0AA6C6F9,
+	0x00000000,0x00000000,0x00000000,0x00000000,0x00000000,0x0159F649,0x08CA6258,0x00000000,
+	0x00000000,0x07DECA62,0x009AD438,0x01E1CF8C,0x022507F9,0x00000000,0x00000000,0x006DCF97,
+	0x0812C36D,0x00EA89A3,0x00000000,0x00000000,0x02814D2F,0x0082BF83,0x00F95621,0x00669A5E,
+	0x071A3A3D,0x050266BD,0x065654BC,0x00C6AFBD,0x00B55BEE,0x09E690F3,0x004E18DF,0x029DDD6B,
+	0x002CE0D9,0x00000000,0x01DEB1A4,0x00000000,0x00000000,0x00000000,0x00000000,0x088A7238,
+	0x00000000,0x05CA00E3,0x00000000,0x04AEAE60,0x00000000,0x00000000,0x02A5C214,0x036D55A0,
+	0x0475E72B,0x076E0B3D,0x0128A632,0x018A1AC5,0x0816B620,0x01948252,0x00000000,0x0612CBE7,
+	0x0029F32C,0x0215CE19,0x00000000,0x015CEE71,0x00000000,0x00000000,0x06EECFB1,0x00000000,
+	0x00000000,0x00000000,0x00000000,0x01C4B6AE,0x00000000,0x06A9B9C1,0x03C1BEAA,0x00000000,
+	0x00000000,0x082258FA,0x0599A313,0x083A5228,0x0655F337,0x033DC75D,0x01F94C96,0x0045CEA8,
+	0x02B24948,0x02591FEB,0x0656A9E2,0x01AE3A9D,0x013078A5,0x00B55947,0x037D47D2,0x0260DE90,
+	0x00000000,0x02A5DC5A,0x0649BE28,0x053273A1,0x00000000,0x0279F693,0x00000000,0x05B1A500,
+	0x017A098B,0x01BA9511,0x077E45B5,0x02453DCA,0x097AA8AB,0x052A6035,0x042E9C50,0x073AB82E,
+	0x06B2325A,0x00000000,0x0279DE41,0x0351519B,0x0206BF83,0x0445B2BB,0x06BE6FFD,0x00000000,
+	0x04ADA3EB,0x00000000,0x00000000,0x05060EFB,0x00B2AC47,0x01B08209,0x02BDF79E,0x0324E126,
+	0x067EA53B,0x0846D5D2,0x05DA165B,0x015D3577,0x03B4FB97,0x00000000,0x00020353,0x0234B441,
+	0x02A1D09C,0x050695BE,0x00000000,0x0044CCA1,0x06CE8D72,0x0011097F,0x00000000,0x00000000,
+	0x00000000,0x00000000,0x00000000,0x0635A219,0x05CDCB7A,0x05459D10,0x00000000,0x0692BD5A,
+	0x032699F1,0x01E539C0,0x06D26E31,0x005EADD8,0x0226515F,0x00E5838B,0x0179DBFD,0x025AB232,
+	0x00000000,0x02E65FEF,0x00000000,0x032949D6,0x094EBA71,0x0509A452,0x051E6378,0x029A289B,
+	0x046E0295,0x06D66392,0x02FCFB09,0x01CD24D3,0x05D9F3BB,0x003E3DC2,0x07524E0B,0x037269B3,
+	0x05428513,0x00000000,0x0075113D,0x04E5F431,0x05CE0D60,0x028956EC,0x03A92C85,0x00000000,
+	0x0721FC39,0x06567534,0x00000000,0x08227745,0x0415A188,0x03B16C9F,0x07968379,0x00000000,
+	0x0742C7C5,0x0276A00D,0x0401FB59,0x00DCC567,0x00000000,0x03DE642C,0x0375AA8A,0x0010C1CD,
+	0x008DBAF9,0x00000000,0x01C87690,0x01B1CBE6,0x00000000,0x031642DF,0x00000000,0x051DB2DD,
+	0x00000000,0x00000000,0x012EC2DF,0x05118F79,0x032950F6,0x046137B6,0x00000000,0x00000000,
+	0x04319839,0x0042340D,0x07767663,0x00B219DC,0x060E879E,0x02368DCF,0x073A03C0,0x00000000,
+	0x00000000,0x05B19BB9,0x00000000,0x00000000,0x01EDD883,0x0892684E,0x01098B32,0x00000000,
+	0x055E257B,0x00000000,0x01E9B5C6,0x0509476C,0x00485D9D,0x00000000,0x04B6415A,0x0415B8A5,
+	0x0118EDFC,0x07A25AAD,0x010A1828,0x00000000,0x068E17F6,0x00000000,0x033638C6,0x01BDC560,
+	0x00599098,0x0096B8C1,0x0125DC7C,0x0158FCF4,0x094294D1,0x00000000,0x066278CD,0x00000000,
+	0x00000000,0x017A886C,0x02FDAB5D,0x041A989C,0x00D627F3,0x0014EC43,0x06067F9C,0x00C84B9C,
+	0x03BAD0CC,0x0432AC24,0x00000000,0x02E27AD4,0x00000000,0x01806A4A,0x0288E996,0x021948D9,
+	0x00000000,0x00000000,0x0191DE8A,0x00C51FDD,0x00000000,0x0310F76D,0x0169179B,0x05324FA5,
+	0x03EA2C0E,0x0401870F,0x04421A98,0x009DDC68,0x01622DCF,0x0088E7AB,0x02DA70A4,0x021DE037,
+	0x0239733B,0x0131F21F,0x072643E6,0x00000000,0x00000000,0x00000000,0x0081E5F7,0x06D278EE,
+	0x029D3135,0x00000000,0x00000000,0x020915D4,0x0109A4E8,0x00D25B70,0x065A9C57,0x03822613,
+	0x01FCDF8E,0x00A8F0C6,0x039DE0AB,0x01559E5D,0x04AA284D,0x0138E264,0x06F9CF94,0x00000000,
+	0x00000000,0x05867F05,0x08922653,0x0772541D,0x02B6AA12,0x0416752B,0x00000000,0x085294FC,
+	0x00888E90,0x05A5D4BD,0x00000000,0x0A9AADBC,0x03E50601,0x0922CDFD,0x02AA125E,0x01B613C4,
+	0x00000000,0x009526F6,0x00000000,0x05A5AB1F,0x00B99884,0x033913FF,0x05EA1B2C,0x00000000,
+	0x00A5EB12,0x09A28138,0x00FE6ACD,0x034277F7,0x02D54319,0x01CD148E,0x02F19F2B,0x0175DB56,
+	0x00000000,0x09EAB47E,0x01F1E3E1,0x03B2356B,0x04B2C21D,0x00000000,0x00000000,0x00000000,
+	0x04E2A8A7,0x00000000,0x07D1F89C,0x00000000,0x00D0F124,0x00000000,0x0419AF2F,0x000A93DC,
+	0x03A2D574,0x06461C0A,0x038920E6,0x00000000,0x01316140,0x03E11192,0x00000000,0x0078E247,
+	0x05B9FFBA,0x035289F5,0x00000000,0x05916C7C,0x00E14FCD,0x00000000,0x00F0D2AF,0x000A4B2C,
+	0x0080BA00,0x0150B529,0x00000000,0x02993575,0x006E5EE5,0x00000000,0x00000000,0x00000000,
+	0x04F670A4,0x024DF1B3,0x00000000,0x01E9D4C6,0x00000000,0x00000000,0x021494A8,0x00253612,
+	0x07CE2356,0x00000000,0x00552520,0x020E7FE1,0x0231CE16,0x00000000,0x08F6D8D9,0x0246C9D5,
+	0x01BA1781,0x002E5133,0x0471DD16,0x00EA7D1A,0x02B1319D,0x059E7E51,0x023E1E3A,0x06521B21,
+	0x08AED823,0x01DA716E,0x00000000,0x00000000,0x04A9FF7A,0x06D64A0C,0x048161D9,0x0691F73F,
+	0x059D9BB9,0x01A46CD9,0x068E855F,0x0836439C,0x00FACD87,0x0A36A286,0x02F1969B,0x060EA629,
+	0x00000000,0x00000000,0x00000000,0x00000000,0x00C47511,0x027914A5,0x04EDF106,0x00000000,
+	0x04AEC560,0x021CAEF5,0x02C6631D,0x026CF989,0x01190D06,0x024107C4,0x00000000,0x00385188,
+	0x01D095F0,0x04665AFE,0x00000000,0x00D21ECE,0x0531FF67,0x0475A793,0x01924655,0x00000000,
+	0x00C5DB19,0x0621C32D,0x0166669E,0x00000000,0x04D211CB,0x037D7C0A,0x004685A8,0x00000000,
+	0x027A55C2,0x008590E3,0x00000000,0x01B8F7A6,0x00000000,0x027A3477,0x008A3CFD,0x08B6C716,
+	0x00000000,0x021EADDC,0x00000000,0x022241B2,0x00000000,0x00C86403,0x00000000,0x00000000,
+	0x0339BED4,0x03B54300,0x005D87BA,0x00000000,0x00000000,0x00000000,0x069239F7,0x0255E4C5,
+	0x086AAF91,0x012AB368,0x02710CF4,0x0134F119,0x02010CCC,0x06365E40,0x040E5AB2,0x00000000,
+	0x0154DC12,0x0091C5AA,0x00000000,0x00000000,0x03D965A0,0x03C5FE37,0x068E595F,0x00000000,
+	0x033212F4,0x00E8F8C4,0x01E47E1C,0x04455EB7,0x04A93282,0x06CED5E3,0x01F214ED,0x02D6B431,
+	0x05B1FB13,0x036ABEB2,0x01C1D82A,0x00000000,0x00000000,0x01C96264,0x02F5CF94,0x00000000,
+	0x016D3443,0x09FA8909,0x00000000,0x00000000,0x026EA5BA,0x0062620F,0x09DA91C9,0x08EA9719,
+	0x050DC14A,0x0048C985,0x01F9B53D,0x03454C1B,0x02C59881,0x00000000,0x05023A57,0x00000000,
+	0x002C6E81,0x07A6A498,0x0489C548,0x0019EEC1,0x06FE02FF,0x00000000,0x04D9DF39,0x06363F42,
+	0x0256CE92,0x005883B2,0x067A20D0,0x00000000,0x00000000,0x00000000,0x04024A54,0x0085BB52,
+	0x00593D83,0x05CE69B3,0x033A0B4D,0x00000000,0x00000000,0x031D881D,0x02A23462,0x08D2B445,
+	0x023D5165,0x01313931,0x00000000,0x012E3CFD,0x04855C05,0x03F18DEB,0x04FECBE7,0x024CF1CB,
+	0x08AAA089,0x00ACE4B0,0x00000000,0x01AA5506,0x00D069AA,0x009147DB,0x00000000,0x070241DC,
+	0x00000000,0x051E0677,0x05663AB5,0x00E4D508,0x0605F255,0x0044855B,0x00469344,0x00000000,
+	0x00893CFD,0x00000000,0x002D7E7F,0x00000000,0x035574A8,0x072A3EE4,0x07A2B277,0x0472942A,
+	0x00000000,0x00000000,0x010D88D9,0x038ECBF1,0x00000000,0x03B58EE9,0x04E252FF,0x00000000,
+	0x04D251E4,0x020CBC97,0x06D24AF6,0x02F5F9C4,0x04024574,0x02E1ADC8,0x00000000,0x04557DF3,
+	0x0136AA17,0x04021483,0x018A89AF,0x0446090E,0x01CD57D3,0x045985D1,0x00000000,0x0199E226,
+	0x00000000,0x01A5169E,0x0AD2B819,0x0051EFF2,0x03928A54,0x04356221,0x00C67DAD,0x082E7534,
+	0x0259D19A,0x00000000,0x073E5F51,0x00000000,0x00000000,0x0435F170,0x0735D46D,0x00604992,
+	0x049DCB1F,0x007DAAD8,0x024E3C01,0x01EE318A,0x00000000,0x08E2629C,0x00000000,0x06D66BBC,
+	0x00000000,0x08E68D84,0x02016D4A,0x00000000,0x05CDA13D,0x00000000,0x02024FC5,0x050E1A16,
+	0x084EAA4E,0x02EDF35D,0x096ACCD8,0x06BDE372,0x048D58AC,0x0542536E,0x00000000,0x00BA8B30,
+	0x0692077E,0x034D46C2,0x092277A4,0x04555974,0x03A8FE5A,0x0174DCD3,0x00000000,0x02F28047,
+	0x01222A11,0x08568434,0x02B56510,0x00595082,0x0739FF12,0x00868581,0x03615CE3,0x002D7B1B,
+	0x02BEC409,0x045678E0,0x00000000,0x0041E1D0,0x00F05DF3,0x003DBD01,0x03E6638C,0x00000000,
+	0x00594710,0x00000000,0x00A16FC9,0x00C1A4A7,0x00F62A5B,0x0862CAB5,0x01506DC8,0x0826462B,
+	0x04021182,0x00000000,0x00000000,0x0012716E,0x040A882C,0x04069751,0x003E8001,0x08CA585A,
+	0x02F2116A,0x00FA0348,0x082A1E14,0x00000000,0x00000000,0x00000000,0x03B529E9,0x00000000,
+	0x04B19049,0x05F9A338,0x05858BAD,0x00000000,0x00D0F74C,0x0709DAF3,0x03B21A04,0x05D6A2E1,
+	0x00000000,0x02714C12,0x03B246C8,0x017DAC8C,0x04264033,0x040D1FB2,0x0005C190,0x01096093,
+	0x037DCD96,0x00000000,0x049A96D5,0x02893D66,0x0511E88F,0x00000000,0x0271273C,0x044623D6,
+	0x00000000,0x07F6CDBD,0x08FE98C0,0x0526A40B,0x024D9BBE,0x05929214,0x00FD5759,0x00000000,
+	0x01097C71,0x060219D3,0x010A3127,0x00000000,0x0268A674,0x035AB0FC,0x08922A96,0x00B1892C,
+	0x00000000,0x008A6430,0x05726A05,0x022D9191,0x0405EA51,0x0109F553,0x00000000,0x052D5142,
+	0x07EE0389,0x0569DC64,0x073E9751,0x019554D7,0x003016B9,0x0304C79C,0x04C6B4A3,0x03EEA971,
+	0x004DD3E3,0x01659827,0x00000000,0x037DE636,0x004AA5A9,0x080EC757,0x00000000,0x011C6FAB,
+	0x00000000,0x0375BD55,0x05B6BA4A,0x0A0E9E5C,0x0A4ED0BF,0x00000000,0x00000000,0x0595B37B,
+	0x08864A20,0x00000000,0x03B533C7,0x00000000,0x01861203,0x0822AB79,0x02C9BA07,0x06D2C8E8,
+	0x001913D5,0x0158613E,0x05129FC9,0x01A4CAF0,0x01FA763B,0x00129E48,0x00000000,0x0772C3A0,
+	0x014D72AC,0x08EA8CE8,0x026AB8F1,0x085E54C3,0x059E5673,0x02517A22,0x00D23C01,0x077A7D20,
+	0x00000000,0x00000000,0x02A98CBE,0x069E5A50,0x0562D13E,0x000922A5,0x0569E3DB,0x00000000,
+	0x01027CC4,0x04F9D8CB,0x00000000,0x00000000,0x010A0C44,0x00000000,0x02ACBE11,0x00000000,
+	0x084A8D69,0x05724979,0x01E17A77,0x03F95901,0x083EC753,0x0161966A,0x00000000,0x01F8F708,
+	0x00001900,0x09C2D209,0x00000000,0x02A6CAF4,0x01C99688,0x017EA546,0x03066EDB,0x041A87B5,
+	0x01E93BF9,0x00AA70E9,0x00000000,0x04D9576C,0x02A0B828,0x00D44146,0x00BA7A06,0x00000000,
+	0x08B2985E,0x00000000,0x04DA8812,0x00000000,0x06EE399A,0x01689E2C,0x04F6BC3B,0x02954302,
+	0x01310F9B,0x00000000,0x04060829,0x03AE6FB7,0x03FD1093,0x00000000,0x00286A2C,0x00000000,
+	0x095A888B,0x061E5579,0x039EB7B7,0x04D61A75,0x02FD65BD,0x063DBFCF,0x00000000,0x03FA4EFB,
+	0x02A4D78A,0x00000000,0x00000000,0x04352E14,0x06DE70D8,0x0238D0C7,0x00000000,0x01B1BA7B,
+	0x011A9D1E,0x03AD83A4,0x00821A7B,0x00000000,0x02D4CC92,0x00000000,0x09A27D38,0x0015454D,
+	0x016A387C,0x0489AA97,0x0070CF34,0x00000000,0x035E917D,0x026D925D,0x0281FF2F,0x00000000,
+	0x00343E97,0x019DAC5E,0x01289F36,0x00000000,0x031538ED,0x078E51E4,0x00969043,0x0125919F,
+	0x05F26867,0x04FA46B0,0x0912B584,0x0180864E,0x03894A9A,0x04FE7161,0x00D69094,0x0012256E,
+	0x07363ACF,0x00813037,0x0051EC02,0x08C2790B,0x0702D6B3,0x00000000,0x099AAB79,0x00C54811,
+	0x00000000,0x03BA811C,0x0318D6BB,0x052E2123,0x00000000,0x00000000,0x016090EA,0x0736C3D7,
+	0x00C8AB03,0x00000000,0x0035DF29,0x05858606,0x045D2FC7,0x00000000,0x0001040B,0x033E3E8E,
+	0x00DCE5DD,0x0018A70D,0x02CDC9A2,0x004D5DB6,0x00D09301,0x00000000,0x0258AA65,0x084616AD,
+	0x03F2B78B,0x0071D526,0x00000000,0x02D1A4D2,0x071A258D,0x00000000,0x0AA6CED9,0x0736556A,
+	0x0401E4D2,0x019E41FC,0x02552726,0x04B98386,0x01FE194B,0x08025B9F,0x00000000,0x000828EA,
+	0x08968B8F,0x00000000,0x0332C7B6,0x07C69075,0x08268298,0x00000000,0x0100D26F,0x00000000,
+	0x098EAC1D,0x00000000,0x098EB1C4,0x05BAAC96,0x098A8858,0x00000000,0x00000000,0x00327C19,
+	0x0916A485,0x045E8FEC,0x00987823,0x04D9969B,0x00000000,0x06E636FD,0x065E49AA,0x051ECF6C,
+	0x01507768,0x04122ED0,0x00E2873C,0x005DDF29,0x094ED763,0x00000000,0x0422B467,0x00000000,
+	0x01386E85,0x01194C95,0x012092EC,0x056E52EE,0x00000000,0x00E1266E,0x03222FF8,0x06D218FB,
+	0x03AD1FF9,0x0012C79A,0x00000000,0x04E188D9,0x02F5FB1D,0x0625E72C,0x0168DC2C,0x038D3D3B,
+	0x0101700B,0x08A65FE0,0x00000000,0x02469B27,0x03E5AB34,0x00000000,0x030AC8CC,0x00000000,
+	0x03CA0B45,0x0284C23B,0x00000000,0x08F2B2C8,0x04C9E0E0,0x011159BD,0x00000000,0x00000000,
+	0x0AB2B8C0,0x01989B33,0x0204EDC7,0x00000000,0x02823B50,0x00000000,0x00000000,0x0135D35F,
+	0x07327BB7,0x009D20B3,0x052E3503,0x00000000,0x03BA5689,0x00000000,0x06C1EB22,0x00000000,
+	0x09BEB8C0,0x047E67B2,0x00000000,0x0142989A,0x0972A904,0x00000000,0x08261DD4,0x03420A94,
+	0x00000000,0x00000000,0x01729F40,0x01A22C0E,0x00096DBF,0x0155E4AD,0x00000000,0x00000000,
+	0x00000000,0x00000000,0x01162576,0x0130CBD5,0x032EB620,0x00000000,0x01394ED0,0x02B52155,
+	0x0486584A,0x077699C4,0x0425922A,0x01DD47CE,0x00A63BE5,0x0646B343,0x0515D184,0x00000000,
+	0x037509E8,0x0039612A,0x063296B0,0x02994E33,0x01227A8F,0x061260AE,0x009EB85D,0x00852829,
+	0x00000000,0x05B23615,0x01A99949,0x01B5CEA3,0x02D91FB1,0x04FD9861,0x0316B767,0x004AD840,
+	0x0216AD53,0x00D0C9AA,0x0852A8B2,0x05D1E739,0x04B6333F,0x00000000,0x0605FD1A,0x0405E28F,
+	0x03650D41,0x03357D42,0x03CEB5FE,0x06EE22B1,0x0166064F,0x03268DCB,0x04152E78,0x01C13E24,
+	0x03E994F8,0x061E13BA,0x00000000,0x08824F63,0x00000000,0x092EA3ED,0x00000000,0x0382400E,
+	0x0232C56E,0x0349D431,0x06B1DA66,0x00D6776A,0x089E8739,0x02B6D50D,0x04054229,0x0254BE3D,
+	0x0035021C,0x00528997,0x021E22E1,0x00000000,0x00000000,0x0566C114,0x00D22909,0x0786AF91,
+	0x054E2AEE,0x016D2264,0x05A5A8C6,0x0216A5B1,0x00000000,0x00B1118D,0x0084B443,0x02FCFCE8,
+	0x00000000,0x00000000,0x00000000,0x02EE6EE7,0x07EA317B,0x00000000,0x017574EC,0x00000000,
+	0x066606C1,0x021D3B47,0x033DE8F1,0x043EC852,0x00000000,0x005E8A54,0x08BABD9B,0x00000000,
+	0x0084C9F1,0x07DA19FF,0x00320841,0x00000000,0x06C209B6,0x00000000,0x032ABBC7,0x0A0EAD41,
+	0x0605A57B,0x01592191,0x053610BD,0x01756106,0x0386990C,0x00000000,0x0319AF2C,0x00106376,
+	0x00DD4638,0x00000000,0x0326826D,0x03221190,0x0426108C,0x0378EA2F,0x01EA413F,0x012A8E3F,
+	0x03C55BCB,0x0842AFBD,0x0104F012,0x06B684AC,0x010908AD,0x01A9057D,0x00007032,0x027D8736,
+	0x04526035,0x063E0B7C,0x04427196,0x03D13FF2,0x00BD6755,0x019D1C3B,0x042652DC,0x00000000,
+	0x0299CABB,0x07EEBA71,0x00000000,0x00000000,0x057A8FF1,0x00000000,0x01489486,0x00000000,
+	0x006CC061,0x02292E4E,0x00000000,0x00000000,0x00000000,0x00000000,0x0071CA5B,0x00D20E70,
+	0x00000000,0x03B5AD76,0x08728478,0x00000000,0x070E50DD,0x00000000,0x06FA579F,0x08424259,
+	0x042D79D6,0x00000000,0x00000000,0x02E9CF60,0x02127A7E,0x079E84C3,0x05D29F3A,0x00000000,
+	0x0060E5F4,0x095ECA95,0x021D17AF,0x011AB072,0x02068F8D,0x070DEA2A,0x0272BA4A,0x0395DD68,
+	0x0069A367,0x00000000,0x012D6D26,0x00000000,0x010E3B5B,0x05C22974,0x04DD3B98,0x005E0CAC,
+	0x036126C0,0x003E8924,0x07D26F9C,0x00000000,0x09E6BEEA,0x0456403E,0x00000000,0x00000000,
+	0x05C26E7B,0x03DA2062,0x04EDAABC,0x010D3D1D,0x0589A5BA,0x00222064,0x06B1B399,0x03AD09F5,
+	0x034A74E5,0x053A9AB0,0x00000000,0x00000000,0x06F2C558,0x02E63ABE,0x054D8746,0x03125F51,
+	0x044973A2,0x00000000,0x03D95896,0x055DAAD4,0x052DF803,0x05CA80C8,0x00000000,0x00CD105B,
+	0x001597F3,0x00000000,0x00000000,0x00000000,0x0519DF39,0x00000000,0x0556199A,0x001562D0,
+	0x05457332,0x03428C4E,0x08FE5CDF,0x053D8389,0x00D46234,0x00000000,0x037E0164,0x07D6476A,
+	0x052E4209,0x079E150A,0x0359B02E,0x086AA2C6,0x038D5F95,0x069A4AC2,0x062A916E,0x05A23C46,
+	0x03D5A56C,0x00000000,0x04094F78,0x05F1E0AE,0x00000000,0x0071933C,0x00000000,0x00000000,
+	0x00000000,0x01D4965C,0x04B1C4BC,0x0675E755,0x0402D872,0x05C1D087,0x0336C045,0x02C268D1,
+	0x039DFCB8,0x0024428A,0x00000000,0x0449320A,0x0335903A,0x05616EE4,0x00000000,0x065A4688,
+	0x017A6D04,0x03251C06,0x0A32A60F,0x024E2AA2,0x004C88CD,0x08927860,0x00000000,0x00000000,
+	0x062E44C5,0x00B049E8,0x06566EDB,0x00000000,0x0655DAB3,0x0125407B,0x0009AA29,0x00000000,
+	0x0882886C,0x03A96785,0x00000000,0x01086E04,0x00000000,0x051EC265,0x07F644C3,0x00000000,
+	0x023CA967,0x08863513,0x00006CD9,0x085A8EB7,0x003269B0,0x0088E204,0x00000000,0x070A9AE9,
+	0x024EADD9,0x01A676F0,0x03D1B1EB,0x091A8298,0x00000000,0x00000000,0x00000000,0x0101EDEE,
+	0x0796690C,0x027D44B9,0x005ECAB5,0x07360F5D,0x00000000,0x041983FB,0x0626CB40,0x00000000,
+	0x053DAC89,0x011CB402,0x08F66652,0x00000000,0x024279EA,0x04FAD0E4,0x00000000,0x00592584,
+	0x011978C2,0x00000000,0x00016A18,0x017D34EA,0x05DE4729,0x00C9B11B,0x0019083C,0x08CEA956,
+	0x0148C38C,0x02C5B3F2,0x0108AEF5,0x0138B180,0x0486C0F5,0x0049DD6C,0x02666D8C,0x00000000,
+	0x00D2494A,0x00000000,0x00B91736,0x00000000,0x07EA94A2,0x04028F90,0x00000000,0x05F255C5,
+	0x05F602E1,0x064615C8,0x00000000,0x027E677A,0x002D0F33,0x0121B487,0x095A7FC1,0x052A934C,
+	0x00000000,0x062A2E93,0x00000000,0x01618241,0x018EB47A,0x01E0C876,0x00000000,0x08026A61,
+	0x029CAD0D,0x009E635B,0x001C0CC5,0x029CAEB4,0x00B06B79,0x079ACF4B,0x05DDF414,0x00000000,
+	0x063DA05C,0x06D69A6A,0x03A17997,0x026E31BA,0x00000000,0x0150647C,0x00623F2F,0x00000000,
+	0x0752465B,0x00B1270C,0x086624BC,0x01F4BDF5,0x0A46A9CF,0x0562380E,0x00000000,0x01C1232C,
+	0x08DA70A4,0x02DE3717,0x03855DF2,0x00000000,0x00000000,0x00000000,0x033D394C,0x056EAA00,
+	0x00000000,0x024CA501,0x067E9BA6,0x00000000,0x07E6C51F,0x00000000,0x03EA18F8,0x06965EBA,
+	0x011233CE,0x00000000,0x00000000,0x00000000,0x01A6D48C,0x039A6412,0x00000000,0x02A6D414,
+	0x0371DC98,0x00000000,0x054E8A9E,0x02A97208,0x0A7ED849,0x065A3667,0x01A1DFCD,0x00000000,
+	0x01E0DF38,0x01410B4F,0x00000000,0x04BDD61A,0x038A9CC8,0x01249245,0x00000000,0x03CA6A61,
+	0x036291CF,0x00000000,0x0366D402,0x062E3C94,0x088EBF59,0x0531588B,0x02C149F0,0x0A6EBC3B,
+	0x03A17074,0x02B5689C,0x0659AAFA,0x053581E6,0x096ED01B,0x0086A57F,0x00000000,0x01CA46B0,
+	0x025A755C,0x05A58860,0x00000000,0x03167967,0x00000000,0x00000000,0x01AACFE3,0x02F10C59,
+	0x00000000,0x002E377F,0x00000000,0x04BDAD80,0x00000000,0x06C6B429,0x00A6CF9D,0x0095DF8B,
+	0x08FA52D5,0x01A625B5,0x0539EB83,0x060DEDD3,0x06FA3AFE,0x02B93AF5,0x033A0410,0x00000000,
+	0x01184C95,0x00000000,0x01A599F0,0x09BEB142,0x015E4108,0x08567D99,0x04AE27F4,0x021E7B53,
+	0x07D24D31,0x015A8937,0x01B9AFA6,0x00000000,0x05BE31BC,0x042630BD,0x02EE8EED,0x04163216,
+	0x00000000,0x00000000,0x0A56A428,0x037AC50B,0x05D17B91,0x05EAA55C,0x02D24202,0x04AA95D8,
+	0x00000000,0x06CA12A8,0x00000000,0x00000000,0x00A96542,0x00000000,0x0184F8E2,0x01C12F9B,
+	0x075AD437,0x03556910,0x080AD418,0x07428379,0x095A7E62,0x01A184E7,0x00000000,0x088E78A3,
+	0x05C17635,0x0109711B,0x017A7AFD,0x0285C53B,0x043EB43F,0x00000000,0x00000000,0x0681BF57,
+	0x021A72AD,0x0316C889,0x01B238C6,0x01B16C4B,0x071AD208,0x00000000,0x00993B17,0x01F2283C,
+	0x01DEBA70,0x04E1CB7A,0x0101110E,0x00000000,0x028DA353,0x03C93A20,0x05CE2460,0x00DE46BD,
+	0x04ED9098,0x00000000,0x00000000,0x00C0ADED,0x01FDCEC0,0x03925133,0x0409CD78,0x00AE3A3E,
+	0x02E5308B,0x078E1AE4,0x00000000,0x0719FAE0,0x001C85AC,0x005AB769,0x00000000,0x00016E5B,
+	0x00000000,0x012C626E,0x01FA5DCF,0x02CA15C8,0x05266864,0x0381E481,0x00000000,0x00000000,
+	0x073EB8D5,0x0288F2CD,0x00000000,0x00B26A47,0x05957180,0x0299E963,0x00000000,0x03A6C3A1,
+	0x047D652D,0x06DDD4E1,0x04E18F8F,0x00E09EB6,0x00000000,0x048D4109,0x06060F7B,0x07EA52EA,
+	0x00000000,0x03C1F73F,0x0732214B,0x00BABBBF,0x0AA2C552,0x00000000,0x01128C0F,0x03C94CF4,
+	0x018DE6BD,0x063656BB,0x0986A4EE,0x00000000,0x04163F2C,0x07DAB866,0x02354FA8,0x049E70E9,
+	0x01892FCB,0x058DCA03,0x00000000,0x0000CD00,0x01EA0A7E,0x01A9037F,0x046294D1,0x00000000,
+	0x07B29002,0x05068027,0x04D63E59,0x00000000,0x04BA4FDE,0x09DEA28E,0x0034A0DE,0x09C2775B,
+	0x0569C751,0x00000000,0x00000000,0x0188D286,0x00CC874F,0x078A8DCB,0x0396CFAE,0x04154CE3,
+	0x021CF8F5,0x01B9021C,0x01C22F07,0x00000000,0x00000000,0x00000000,0x046A606F,0x00000000,
+	0x00000000,0x00000000,0x00000000,0x02A4C178,0x09FECB2B,0x0556A41E,0x0276A813,0x00311E8E,
+	0x0792A2EB,0x00000000,0x0409D469,0x00000000,0x01DA28E3,0x04F5550E,0x0A26D8D0,0x00C8CA03,
+	0x034E1C0D,0x03E265D3,0x01E0AE67,0x025E880D,0x00000000,0x00B25A24,0x00000000,0x0176A3ED,
+	0x01827D99,0x00068D72,0x00EE07EF,0x0311D2F8,0x059E2D46,0x013204DC,0x0755E1EF,0x0311BAF8,
+	0x055615FE,0x013119D4,0x00000000,0x019DC9F2,0x0086B3AA,0x0109FE28,0x00000000,0x004DFAEA,
+	0x05F9F8C1,0x0515C34D,0x0286132E,0x00000000,0x0239D4C7,0x037E978C,0x00000000,0x05D1B569,
+	0x00000000,0x03751C1A,0x07D284E4,0x0089629D,0x03352A45,0x020A5675,0x059DAC45,0x06863B50,
+	0x00000000,0x00000000,0x05CEC4C9,0x0351C5A0,0x042E221F,0x04DD942B,0x02656A3A,0x05C6AE55,
+	0x00000000,0x00729689,0x00000000,0x00000000,0x029A1D1A,0x0715D15C,0x00D25DB3,0x00000000,
+	0x00F9969B,0x030CFE41,0x007A1317,0x03228B67,0x0132D6AB,0x00000000,0x00593FE7,0x0192307C,
+	0x044DAEA4,0x00000000,0x00000000,0x01DCE38F,0x00DC62B0,0x0210E83C,0x08462682,0x05A2BD07,
+	0x02158FAC,0x01964D78,0x01B09E65,0x04565795,0x00162F07,0x00000000,0x0A26CED4,0x00608826,
+	0x0585CF05,0x014227B8,0x0ACACB94,0x035CDFB1,0x00000000,0x03D92EE5,0x00000000,0x00000000,
+	0x0796383A,0x00000000,0x07A218B1,0x009C3B63,0x00000000,0x0535FAA1,0x0231EFFE,0x0109B481,
+	0x056A51BC,0x069DC894,0x078E293B,0x00000000,0x07D63FAC,0x02A5162F,0x00467444,0x02ED4FA6,
+	0x02862909,0x00000000,0x04FE26BB,0x007CB9A7,0x0261CB57,0x00000000,0x00000000,0x00CA5626,
+	0x00000000,0x08F69A52,0x04095E65,0x07D249D8,0x005AD8E7,0x0311271B,0x045DE5BD,0x08F268B6,
+	0x03BA61C3,0x0671DF8B,0x02158B48,0x08F67D9E,0x02763513,0x00000000,0x0361C0FC,0x00000000,
+	0x00000000,0x047DF9DD,0x03C9541B,0x020130F0,0x00C57968,0x00000000,0x00000000,0x03F263BB,
+	0x04F58940,0x07723664,0x09F2A02A,0x0629B4FF,0x00000000,0x0621DDA5,0x001DA5C9,0x01168513,
+	0x00000000,0x00000000,0x0591FA5A,0x00000000,0x00000000,0x00000000,0x09126E4A,0x01B875F4,
+	0x017E1046,0x00000000,0x0315579D,0x05D65AB2,0x02C6C54C,0x01BA7CFE,0x00000000,0x03054F92,
+	0x00000000,0x00000000,0x09A2B1F1,0x00000000,0x00000000,0x04A21D86,0x0264CE40,0x081EA215,
+	0x033934A6,0x00000000,0x00B4528C,0x05D1BD63,0x00000000,0x01658F8F,0x00ACA98A,0x06B67BCC,
+	0x02DCEC93,0x00B54F8C,0x0592BDF8,0x02E574A9,0x00000000,0x001E544B,0x00000000,0x039227C9,
+	0x0242AD75,0x04C60811,0x05B6CB2B,0x0085F3DB,0x0351A961,0x0285C4E2,0x03ADFCDE,0x0349596F,
+	0x0722157D,0x04B6115C,0x056E3457,0x043A2613,0x01FAA96E,0x0842C1E0,0x05FA2834,0x00000000,
+	0x0272624A,0x06F64635,0x00000000,0x022D3DEE,0x055569C0,0x00000000,0x06063E55,0x00000000,
+	0x098ACF66,0x04A953D9,0x038ED8D8,0x02A2BE70,0x0349A431,0x08D2880D,0x000940DC,0x00000000,
+	0x01FA34C8,0x00000000,0x00C46C57,0x03D91FE0,0x00000000,0x017D6A9E,0x010D61F7,0x049A00BB,
+	0x099E7BDF,0x0274F446,0x00000000,0x003D9786,0x00000000,0x06922D0F,0x06FE069B,0x00000000,
+	0x089653EF,0x059E4239,0x0466351B,0x07A6B682,0x00000000,0x00000000,0x00000000,0x096A9850,
+	0x00000000,0x076EB3EA,0x098696EE,0x018D9BAD,0x03013F61,0x021D1A6C,0x04421F93,0x05A9881D,
+	0x00000000,0x07EEA3EC,0x043131BB,0x022DEF57,0x02C0CFDC,0x08262E5C,0x00A967E9,0x00000000,
+	0x03326AF4,0x021DD920,0x006A79E2,0x00000000,0x01FA1808,0x00000000,0x01351F2D,0x08FAB321,
+	0x01E492C0,0x02D2D1DD,0x00000000,0x0208C27D,0x0952841A,0x07760353,0x0361482B,0x00000000,
+	0x003D99EA,0x04260927,0x035D9ED8,0x00759BB9,0x03CD972E,0x00000000,0x00000000,0x00000000,
+	0x07362822,0x02DD0FF6,0x073E9194,0x00000000,0x07D62C4F,0x023CC989,0x00000000,0x03697461,
+	0x001D3131,0x0481E8B1,0x05F237FC,0x00B8E081,0x04DACF12,0x0345F930,0x00000000,0x03158991,
+	0x00000000,0x00000000,0x00000000,0x00000000,0x06BA4E11,0x02F120AA,0x014D68C3,0x055183ED,
+	0x00000000,0x067A989C,0x03D9E7AB,0x03F61C0A,0x00000000,0x0165074C,0x00000000,0x02D943C0,
+	0x05259CA3,0x0084D0DB,0x010D0023,0x0832D52B,0x07665381,0x029143A9,0x00000000,0x02F51F94,
+	0x00029235,0x03D60AB3,0x047E503C,0x04264B76,0x00000000,0x05E2946B,0x09E2B735,0x027E8ED2,
+	0x09365BF9,0x0174B0C0,0x061EB270,0x04B15132,0x04E6BCAF,0x00CA7444,0x00FCC9CD,0x01C2CD1B,
+	0x03626225,0x06061F40,0x00000000,0x030205C8,0x01EEA63E,0x00000000,0x035DDAD8,0x064269E3,
+	0x00000000,0x0289047D,0x00000000,0x05627075,0x06221913,0x00000000,0x070A7BEF,0x0211FEBE,
+	0x043D1A6C,0x05B17B57,0x00000000,0x00000000,0x00000000,0x06D6A6C6,0x04C6CE92,0x03D2ACF2,
+	0x00000000,0x040D987F,0x03811119,0x01C0C894,0x00000000,0x00000000,0x0179122B,0x00000000,
+	0x01F6A104,0x00000000,0x04B95575,0x04C5B2E4,0x00000000,0x00000000,0x04A5D6C1,0x0388ED36,
+	0x01714CA2,0x01E09E9A,0x038AA3F6,0x00000000,0x060E31F7,0x0008EBEA,0x088AB5FA,0x0026A017,
+	0x08667835,0x07EA3ACF,0x09D2A311,0x00607587,0x017A01CE,0x05327EF4,0x00AA515E,0x056A1E1E,
+	0x0691DC9F,0x028A512D,0x008CB8EA,0x06619F44,0x00E5C069,0x00111EA1,0x05094FB5,0x024DA2B3,
+	0x05F6415A,0x023D253A,0x00000000,0x00000000,0x0374F64D,0x00000000,0x00387888,0x00BA2EE2,
+	0x03995FF9,0x00000000,0x08FE8B41,0x01A8A4E4,0x00B53DEE,0x00000000,0x037E4FC5,0x04365DCA,
+	0x00000000,0x06B2B924,0x0230A0A6,0x00EA555E,0x06AAB6CB,0x009DFEAE,0x02D235B3,0x040DCA67,
+	0x051DF35D,0x00000000,0x04C13D4B,0x00C041ED,0x04E2BC08,0x02A6B95B,0x0626D31F,0x06960B9E,
+	0x00000000,0x0390F3B0,0x0231C9CC,0x00000000,0x019174E2,0x027D7292,0x02D1164F,0x01EDF3CD,
+	0x00000000,0x01CA712E,0x06723FE0,0x026137F3,0x081A92E6,0x03D1F6A4,0x00000000,0x0000FEF5,
+	0x04FA7833,0x025CF62A,0x02F5ECC4,0x03CD4CB0,0x07B696C2,0x0559D333,0x062A896E,0x01CEBDBC,
+	0x0626441E,0x01E65EB1,0x00000000,0x01CD6D8E,0x00000000,0x00000000,0x00000000,0x00000000,
+	0x06FE2C1C,0x056A8F8D,0x03D1EDEE,0x00509077,0x00000000,0x01E2C330,0x088A8D08,0x039DF8FB,
+	0x06F20458,0x0196058E,0x00C6868A,0x0485F2B8,0x00100C1E,0x00000000,0x00000000,0x067EAFA6,
+	0x00000000,0x080A3BAF,0x00000000,0x00000000,0x017E00E5,0x011A5D07,0x01224543,0x023C9B63,
+	0x06C62D7A,0x036AAF5A,0x00000000,0x0169C883,0x02C17DD1,0x006D7BB1,0x00DD7417,0x00000000,
+	0x004868CA,0x03B9D4BD,0x06C9F40E,0x00C1C2F8,0x00000000,0x04660B89,0x046194F3,0x03C1C73C,
+	0x0131AC95,0x06D67F0C,0x00000000,0x0385CE52,0x0029DA99,0x045230C3,0x02C28D36,0x026D9507,
+	0x00000000,0x00000000,0x0025C807,0x00000000,0x00000000,0x00000000,0x06023912,0x00000000,
+	0x00000000,0x0068FB63,0x074E9E1B,0x001C424B,0x00000000,0x068AC3B3,0x0045BEA8,0x013DF8C1,
+	0x066A7FC2,0x07364259,0x05869E70,0x07AECBF2,0x05EABF5C,0x00000000,0x00000000,0x029A4574,
+	0x09EAD15D,0x02D17802,0x08C6CD9E,0x09A6967C,0x03FA38C6,0x019505E1,0x04518F5C,0x06CDFC2B,
+	0x00000000,0x01E62B7F,0x042D8754,0x00000000,0x00000000,0x008AB303,0x0194F892,0x02520E4A,
+	0x003D6D47,0x00000000,0x06EAB696,0x00000000,0x065A5F9B,0x03DA3496,0x0489323E,0x00000000,
+	0x015657E9,0x0299ACAB,0x00652BEB,0x00000000,0x00000000,0x03CD2168,0x00000000,0x00A224BA,
+	0x00F6365F,0x088A2DFA,0x09DA9422,0x04DD76B8,0x0392A44F,0x064EA8E0,0x033DEC07,0x0012C56A,
+	0x05D635DC,0x06928D2A,0x0565AE70,0x00000000,0x00000000,0x00000000,0x04E57811,0x02B21B21,
+	0x005DF14B,0x00000000,0x0036C11C,0x0802D5E3,0x00000000,0x00000000,0x045EBFFB,0x05CDAFE0,
+	0x01C97C90,0x034E9E2F,0x08222AA2,0x00BDB97C,0x020E41E1,0x022A324E,0x0092B81A,0x00000000,
+	0x0011D691,0x036540A3,0x00497BA2,0x02A698F0,0x03E6D301,0x00000000,0x056AC1BB,0x06A668B4,
+	0x005545B7,0x003168EE,0x00000000,0x037E9528,0x016AB81A,0x05A9B024,0x0846186A,0x0004D823,
+	0x0729D44C,0x00000000,0x06BDD5A9,0x0631CA12,0x01B09FC9,0x01899978,0x0A06CED2,0x03A24E97,
+	0x02F5DF70,0x01E5B21F,0x008A2084,0x00000000,0x00000000,0x025189C9,0x088AA7D2,0x0211BD27,
+	0x021D706B,0x0274B369,0x01E211C1,0x02054532,0x03323D1F,0x00000000,0x00000000,0x07EAA645,
+	0x006E6BD7,0x0051BDF2,0x09329BF1,0x01B28E4B,0x00000000,0x05166721,0x08B63D78,0x0AEACE5F,
+	0x02C2B565,0x0051C839,0x00000000,0x083E1CBB,0x0256749F,0x07929560,0x02B663C4,0x0138FBCD,
+	0x03DA7B7D,0x05C21952,0x00000000,0x00000000,0x018081F5,0x00B284DA,0x089A3F35,0x0189E88E,
+	0x0302AA12,0x00563120,0x044127A3,0x086E4677,0x00C8E565,0x010A49BF,0x06921D8D,0x08F270D7,
+	0x00000000,0x084E7FDB,0x0020486F,0x053246B0,0x005AD7A4,0x015A161C,0x02D5B42D,0x0321A5DD,
+	0x00000000,0x05AA5E61,0x02FA248A,0x00000000,0x093A6D59,0x01AA25F8,0x00000000,0x02B94449,
+	0x005268E8,0x07364A20,0x04BE522D,0x06D9E15E,0x06C9F6F8,0x03F28CD3,0x0191812A,0x00000000,
+	0x0020377E,0x02EA1DE2,0x00000000,0x03E55B32,0x010A84BA,0x01E299D2,0x012CEEC0,0x01E6CC0C,
+	0x0639EC11,0x00C1A500,0x00000000,0x00000000,0x01E50E6B,0x00000000,0x050DEC90,0x0651B678,
+	0x03725937,0x00000000,0x00000000,0x04ADE334,0x0158E623,0x0012D7CA,0x01059554,0x0319D295,
+	0x00000000,0x0086047F,0x078A0A0F,0x01A9FA49,0x00107C60,0x01760927,0x010ECFF0,0x0198B104,
+	0x05EE8B6F,0x00000000,0x00000000,0x0211567E,0x00A47CCA,0x036154E3,0x02C1B5AF,0x00000000,
+	0x05BACED2,0x01D54527,0x02EA5772,0x082A3102,0x0649E6B8,0x00000000,0x00FA6AB5,0x0402B4B9,
+	0x00E230C3,0x04492EDA,0x07B2209B,0x00000000,0x00000000,0x02616CF3,0x00000000,0x004E23E3,
+	0x06F5FA63,0x01B907BF,0x098EB81D,0x077DFDAF,0x04A25D28,0x0030964C,0x01B280A2,0x00000000,
+	0x01222F43,0x03B60697,0x00000000,0x0046D884,0x01592903,0x01B9DF39,0x09EEC97B,0x00000000,
+	0x00000000,0x00269BBE,0x04655FD4,0x030D6986,0x00000000,0x04F6CB40,0x00000000,0x00000000,
+	0x00014F73,0x00000000,0x059DE185,0x00A28DEA,0x01F643E6,0x004E42DF,0x044DCABA,0x02E6CC63,
+	0x05E9EAB4,0x00000000,0x024E88F7,0x0359B3D5,0x02B9924E,0x0148D8FC,0x00000000,0x0195DA4B,
+	0x00E9C6FC,0x032E790B,0x00000000,0x027A09A0,0x074A97F8,0x015ECF76,0x01ECED1D,0x073AB2BE,
+	0x011A9407,0x00B50B47,0x00000000,0x056DFF46,0x00000000,0x00E10A44,0x021A4E1E,0x049A7ECE,
+	0x03B9756A,0x011DA3A5,0x02027306,0x005C9034,0x00A14C34,0x02B2AE97,0x02598D2D,0x044E4881,
+	0x098A6C66,0x00000000,0x04B6AAD6,0x028D4CB2,0x0481D2C6,0x09B6B185,0x0341F973,0x03F54A98,
+	0x01D1F3A1,0x020087CA,0x00AD5A88,0x010EA5F0,0x00000000,0x00000000,0x0599C5AA,0x06FE3A57,
+	0x00000000,0x021AD8D9,0x04622620,0x031EAA70,0x002D63D1,0x00D99241,0x00000000,0x0642BBE3,
+	0x00000000,0x00851866,0x04FABAE2,0x056624C5,0x00000000,0x07C6A906,0x00000000,0x009ABED6,
+	0x05DEC265,0x030A4C2B,0x0771F64E,0x00000000,0x078E08FA,0x03325EF4,0x01254E29,0x025291CF,
+	0x00000000,0x05F29865,0x02052C49,0x00000000,0x00DDE2F8,0x00000000,0x00000000,0x017463F1,
+	0x0159AD23,0x00000000,0x02AA657F,0x004D8813,0x00000000,0x00000000,0x0170C97D,0x063D968F,
+	0x01118FCF,0x05AE5559,0x043DFB59,0x00000000,0x017161AD,0x00000000,0x0561C2F8,0x016A23E0,
+	0x02D949A4,0x0009C9FA,0x065A4F67,0x0196CD3C,0x00000000,0x00000000,0x06EA4299,0x073E2786,
+	0x03915C8E,0x0AEEC330,0x00000000,0x00000000,0x00000000,0x037E6F3E,0x069E4259,0x00893D56,
+	0x05027A97,0x043E991D,0x02EA4666,0x08FE592E,0x003101C3,0x08566558,0x00000000,0x00569772,
+	0x034566AC,0x02F1938C,0x00000000,0x04E9EB22,0x034137F3,0x00000000,0x000A5BE9,0x00CAD0FC,
+	0x00000000,0x02398AFF,0x0966B82E,0x004617D6,0x00000000,0x00000000,0x00000000,0x03AD22A5,
+	0x01F90A25,0x033CDF0A,0x01EDF526,0x06F2A082,0x05F25CDD,0x0412710B,0x008A7622,0x00000000,
+	0x00000000,0x0599EBAA,0x02619191,0x00000000,0x051DAB5D,0x00000000,0x07B6279E,0x0A7ACA5C,
+	0x05F9CE3E,0x00000000,0x00503424,0x012E41EA,0x08163BF2,0x00000000,0x00000000,0x00000000,
+	0x00000000,0x00000000,0x00000000,0x00922113,0x00000000,0x04A98343,0x08B25348,0x026DFC72,
+	0x01588E31,0x02992ADC,0x029676A2,0x00000000,0x00000000,0x01A12CF7,0x01A9EC16,0x00000000,
+	0x04355941,0x054DDCA2,0x047DDC9A,0x010862C8,0x01F9E964,0x06AA620E,0x00000000,0x00000000,
+	0x034D0C0D,0x07E275C1,0x05BEA91C,0x06A658F7,0x06C66415,0x00000000,0x0A8AB3D3,0x059A84AC,
+	0x01208F22,0x00000000,0x01496254,0x00000000,0x00D17C1C,0x04CAAA64,0x00000000,0x039E58A6,
+	0x049DE70A,0x00000000,0x03E625B5,0x004A8027,0x082A49BF,0x02756105,0x00000000,0x01D54252,
+	0x021D57D3,0x00000000,0x02354962,0x04CEC7B9,0x00000000,0x0A32A573,0x06164EF5,0x00ECDE99,
+	0x02E97345,0x00000000,0x00000000,0x00E679C1,0x03796890,0x00CDF08E,0x053699D2,0x00000000,
+	0x041EBB79,0x04F6AE86,0x00D4F6F3,0x0632B3EA,0x049991DD,0x00000000,0x005A39D6,0x0781F808,
+	0x0621CAD4,0x04AEC82E,0x04864C27,0x0048563B,0x0519C022,0x01D5A4E2,0x00000000,0x0245031E,
+	0x0084A266,0x00000000,0x00000000,0x0279ED7D,0x077E9D44,0x04EDA5F9,0x011180D5,0x00000000,
+	0x06C1C319,0x0261F890,0x0539B966,0x0251A3F6,0x05BA79EA,0x096A7120,0x00B084CB,0x010C816B,
+	0x07EE5A43,0x058DD9A6,0x039D9AB7,0x00ACAF8A,0x05527CD1,0x0290DB1E,0x009163A6,0x00000000,
+	0x003021AA,0x031A2B2E,0x00220B45,0x00B53B47,0x00000000,0x00000000,0x00000000,0x00CD92C3,
+	0x007859A0,0x03B166C8,0x05CD8F0F,0x01CCBD62,0x02DA2F0C,0x00000000,0x035A5AFC,0x00000000,
+	0x0179CF51,0x00000000,0x00000000,0x00A033CF,0x02B9334E,0x00DA4AAC,0x020289D0,0x0495C28B,
+	0x058A3EED,0x00E87B49,0x021DC1BE,0x089E4050,0x04F1B471,0x00000000,0x074ECC1E,0x02556F8F,
+	0x010E051D,0x032E3551,0x00000000,0x01E21CDA,0x00B9FF84,0x00000000,0x011CD98B,0x02CD0B73,
+	0x0491E1E2,0x0822AECC,0x0596C11B,0x069E5BB5,0x086EC6F9,0x02A63892,0x03D955AB,0x015A2C28,
+	0x0005F071,0x02AE0B68,0x00000000,0x00905A83,0x00CAA273,0x06CE83B3,0x04DD4B09,0x032D4D4F,
+	0x06B2C889,0x00000000,0x04C97B37,0x00000000,0x0742149B,0x04666F7F,0x0150B4D0,0x06BE9881,
+	0x00000000,0x01813FBB,0x0058F261,0x083A39F7,0x047E9B43,0x059EAF83,0x075A663A,0x00000000,
+	0x07F2481C,0x00000000,0x0198B982,0x00000000,0x07E264C5,0x0166A927,0x02897F10,0x01997DD1,
+	0x039A41E5,0x0154A9A7,0x0082603E,0x00000000,0x00000000,0x00000000,0x0098C735,0x00000000,
+	0x029D5745,0x09C682BF,0x06A68348,0x02F9160F,0x011D6DB2,0x0A52C5FC,0x00000000,0x05C588A5,
+	0x018A4353,0x00000000,0x08F27530,0x00000000,0x0111FACF,0x049E0956,0x024240B1,0x0101D61A,
+	0x01B9FB0C,0x0091F51E,0x00BD870B,0x0371BA98,0x035D8B8F,0x010A476A,0x03261D85,0x001630AE,
+	0x0284BAE2,0x00506E15,0x059E9257,0x0691E4A1,0x00000000,0x00000000,0x0194D49A,0x05B2109F,
+	0x00000000,0x037EBB36,0x09C6BFAC,0x02EA2960,0x0006D823,0x00000000,0x05B1E1E9,0x00000000,
+	0x07B25506,0x00000000,0x08EEBA8D,0x01A9F51E,0x060DB558,0x00000000,0x00CA3BA8,0x0202221F,
+	0x02011BD0,0x080A4A17,0x04E5E7C9,0x00000000,0x047DDADD,0x00000000,0x035CEC5C,0x03DEAD08,
+	0x0304F3B0,0x037A45B5,0x022D1527,0x00000000,0x00000000,0x03E6A3B4,0x00490DCB,0x02D5DCD5,
+	0x04B9CF86,0x03A19C74,0x0716BC3B,0x03F90B6B,0x024ED16F,0x03F15BA8,0x01D9367D,0x02B62BF7,
+	0x0645F29F,0x048E0D2C,0x085A7472,0x033EA2D6,0x06767C26,0x063E7F88,0x02625570,0x03F6CA5C,
+	0x045A1C2F,0x0105CC30,0x00000000,0x005A97D6,0x00EE3EED,0x012EB7A6,0x00000000,0x063EC88F,
+	0x01628235,0x00000000,0x00F08F95,0x02B4BA7C,0x00000000,0x023AA91C,0x0060AE44,0x0419E8B1,
+	0x052D833A,0x03ED4B09,0x04621423,0x00000000,0x00000000,0x0139E02C,0x029A57A0,0x00000000,
+	0x02E13C7D,0x082E1806,0x062EA7D0,0x00000000,0x008CA1F9,0x00000000,0x05425F6A,0x01910D3A,
+	0x085E73D6,0x00000000,0x09BA8218,0x0525839E,0x030E9E21,0x00000000,0x0722705F,0x0791ECA1,
+	0x04BA11C0,0x06C1CFF7,0x0451529D,0x069A38B4,0x00000000,0x037CF8B1,0x09BE9194,0x018A2139,
+	0x02188E23,0x00000000,0x00000000,0x00F848D2,0x04613F78,0x03925FED,0x00000000,0x063E7196,
+	0x05C269AA,0x052D7594,0x0502927B,0x0050B98F,0x0666D66C,0x073690D7,0x02049665,0x0592C4C2,
+	0x0442A0B4,0x0140DFC5,0x01D4C34E,0x0000B956,0x00000000,0x00082EBF,0x08065AB7,0x0852679B,
+	0x099E884C,0x04CA5F37,0x00000000,0x03456105,0x02C2A0B1,0x0198EBEA,0x00000000,0x06AAD287,
+	0x05EE4D8E,0x00000000,0x00000000,0x010E791D,0x01613ABA,0x01208D86,0x029E595D,0x013969CD,
+	0x03B6B45F,0x00000000,0x00C268A3,0x03D2A50D,0x02F64138,0x0270C59F,0x025221D1,0x059E3C46,
+	0x023E72F6,0x08A2AA17,0x012E35EA,0x023659A8,0x00000000,0x00000000,0x02BCFCAC,0x00DD15A6,
+	0x034D7301,0x02EEBDED,0x05567C2A,0x00F2331C,0x07D29576,0x07328B30,0x01D55EDD,0x0942C983,
+	0x0916595D,0x06165C6B,0x02822B6D,0x0399D1F9,0x00696C19,0x03D53933,0x09F6BE43,0x053E51A8,
+	0x04F54EF8,0x05F1F1FC,0x03ED9771,0x0511FAAA,0x07523F72,0x09D6A2A7,0x00C47EB7,0x00395D9F,
+	0x033981E9,0x023A3C1B,0x092AC588,0x07D6D37A,0x00000000,0x017D11D2,0x041D7B79,0x00000000,
+	0x00000000,0x09C2ADE9,0x00EE7FE3,0x046236C2,0x05A65ABD,0x0232470B,0x004A5740,0x00000000,
+	0x00000000,0x002E4DDA,0x06019BBB,0x00000000,0x00000000,0x06BE3F69,0x00000000,0x00D86109,
+	0x053E3372,0x0946B47A,0x043E7C39,0x00000000,0x00000000,0x047AC83F,0x06DAD13C,0x00000000,
+	0x037D9C8C,0x00562D99,0x067A501B,0x00000000,0x09BE904D,0x000DB786,0x02156807,0x044A99C4,
+	0x00000000,0x01DDB362,0x05316DB5,0x00F05696,0x058A96FE,0x056A34BB,0x0571BF7A,0x07DA7F3D,
+	0x0071E824,0x0129A005,0x056AB080,0x0395233F,0x06B2AA2B,0x03921C2D,0x01D2D868,0x01A9567E,
+	0x077261A4,0x03927663,0x02B9A349,0x04B1C1EF,0x04612C20,0x00000000,0x037CFCC5,0x00000000,
+	0x02A511D6,0x097EAE60,0x04F95A95,0x008C9780,0x065E2697,0x00000000,0x03F26608,0x03CE7123,
+	0x00D4B531,0x00000000,0x06325414,0x00000000,0x071E31F7,0x0048E30A,0x03316CAE,0x00153443,
+	0x0752D4DE,0x00000000,0x02A121E8,0x0A2ECE2D,0x00510297,0x00000000,0x057A0B3D,0x097EC560,
+	0x0A4EC720,0x00D92DC5,0x008CF4A4,0x015D5418,0x027E8492,0x03B28815,0x059A1BAC,0x020CA3B2,
+	0x01593513,0x00000000,0x079211B6,0x03C5FA9B,0x036E4E1E,0x00000000,0x066DBAF8,0x04468EB7,
+	0x01ED6DA6,0x063AD858,0x079DF69D,0x00000000,0x014A785F,0x078A554F,0x00000000,0x00F8DFFD,
+	0x00000000,0x032999D1,0x03D1EA04,0x00A8D0C6,0x0016A9CC,0x050E5CA7,0x00000000,0x004D10A3,
+	0x00000000,0x05419182,0x06BE8B8F,0x02E26F40,0x00000000,0x00000000,0x029A9806,0x02325901,
+	0x00000000,0x00145D95,0x030575D5,0x017DDD7B,0x05A69486,0x00000000,0x00DDD977,0x00C9B4C2,
+	0x00000000,0x02853C6B,0x067ACCD8,0x099EA299,0x00000000,0x04DE955A,0x04814DE6,0x06625A15,
+	0x02151A29,0x03DD197C,0x04826F42,0x06AE7156,0x053184E1,0x00000000,0x0080E083,0x04E1F862,
+	0x013CC62E,0x00000000,0x04457D4C,0x01E8D9DF,0x0066439C,0x00000000,0x04E6B2BE,0x02197E38,
+	0x025549F0,0x03DA9043,0x0214B454,0x03E1C650,0x00000000,0x0496508B,0x0A56C155,0x032678B2,
+	0x022CBFE6,0x068E6313,0x032A1787,0x04F9675D,0x02E93451,0x04B1E6A2,0x00000000,0x00000000,
+	0x039E90FB,0x02499817,0x00000000,0x0312B31A,0x0449DE80,0x00F8F91D,0x03769F7B,0x0456C3A0,
+	0x037E1A3E,0x0245E21E,0x05896A18,0x05DA74F1,0x00000000,0x095660C2,0x06A5CEA3,0x04AE7F23,
+	0x00000000,0x00000000,0x03763611,0x0459DB1B,0x018098B6,0x05526721,0x0641AFEC,0x0089DACD,
+	0x05429E09,0x06769560,0x00000000,0x02E1B4D4,0x059D7CCC,0x00000000,0x00000000,0x05B5C256,
+	0x00000000,0x056E7858,0x09DE8137,0x01DCF0C4,0x00ED37E3,0x00000000,0x00000000,0x031CD114,
+	0x00CDE325,0x05F1BA95,0x05D27C95,0x00000000,0x00000000,0x03A5F179,0x03C60554,0x080E265F,
+	0x00000000,0x07E24BE6,0x00000000,0x0345F229,0x0094ED62,0x01099228,0x00000000,0x03E92CA2,
+	0x00000000,0x026A551B,0x03CEB857,0x00000000,0x023CDE6D,0x0451882A,0x04A653D4,0x00000000,
+	0x027E918E,0x00000000,0x04117D2B,0x066E16AD,0x00000000,0x00000000,0x047AAA9F,0x08925A9C,
+	0x07369A5E,0x00F50CA8,0x03A99C9D,0x0766C761,0x0308E306,0x00000000,0x0611F2A3,0x00000000,
+	0x00BDD4C0,0x032CD902,0x04EDDF44,0x0000CCA7,0x00000000,0x00000000,0x0AFAD856,0x00000000,
+	0x00000000,0x0499F211,0x01EAC9B3,0x033A9DA5,0x01C11F98,0x056A1464,0x05C643D0,0x00000000,
+	0x021E6E4F,0x0140BA66,0x07EEB6B4,0x00000000,0x0015D6D4,0x00B65D0C,0x00B62AB0,0x02454023,
+	0x06F28C82,0x01996F5F,0x067E43A6,0x01E12F4C,0x024E470A,0x0212863A,0x00C962FC,0x094AB8D5,
+	0x00000000,0x02BAD3B4,0x0541F37B,0x0502A7BF,0x00000000,0x00000000,0x02016466,0x01DE9A68,
+	0x00000000,0x00000000,0x02CA4191,0x00000000,0x01A5E759,0x00000000,0x03A9819D,0x00000000,
+	0x04856F8F,0x0142A8BE,0x02B27F62,0x01549FBD,0x0A7ED2AD,0x01569270,0x0701E132,0x00000000,
+	0x00000000,0x0105C988,0x04A26DA6,0x017C930F,0x0135E748,0x08EECA8D,0x073673EF,0x014269AA,
+	0x00000000,0x0441A57D,0x0239C017,0x01F90468,0x0461914C,0x00000000,0x03DAC84D,0x00000000,
+	0x0492D4DE,0x00000000,0x00000000,0x052E0B57,0x00000000,0x054E1B57,0x00000000,0x080A0AE7,
+	0x0115F4FA,0x01616397,0x00A1C114,0x0048DD55,0x043A81CC,0x064E4632,0x08E24677,0x05156284,
+	0x00000000,0x087E3899,0x00000000,0x07B21A6E,0x08AE7064,0x0419772F,0x073A6BC4,0x0009E91A,
+	0x0355921C,0x00E6BA8D,0x00E8E236,0x09E29865,0x06EA6481,0x02B55DF7,0x0159E106,0x030D7F93,
+	0x01E8BBC3,0x04E25A58,0x086A8C10,0x00B0A326,0x00000000,0x00000000,0x00000000,0x043D233F,
+	0x00000000,0x07C21558,0x0551CAE1,0x094E70C7,0x015CD0EF,0x00C1E6DC,0x00000000,0x00000000,
+	0x04155DA5,0x00000000,0x03FA6EF5,0x00000000,0x01C881B0,0x0219112D,0x070E967C,0x00000000,
+	0x00000000,0x005E1538,0x00A97709,0x00000000,0x005E4980,0x03954E11,0x0716C3A0,0x00000000,
+	0x0AAAD064,0x00000000,0x02555EF0,0x04A21636,0x00000000,0x02BE696D,0x01A87EF9,0x00D2274C,
+	0x00B0C2BF,0x0069FB43,0x0191D203,0x00000000,0x00081F85,0x055D9D59,0x0052722D,0x0592885A,
+	0x038D3AD7,0x02D5C55C,0x01E881C3,0x00000000,0x00000000,0x00D526E0,0x01749BD3,0x0258C4E4,
+	0x00000000,0x00000000,0x0169572C,0x015E91B3,0x097A7622,0x0131D939,0x026D84DD,0x02315501,
+	0x02752955,0x0A6AD106,0x0506B3EA,0x00000000,0x0272902B,0x08DE84BB,0x009E9A68,0x00000000,
+	0x0429D63E,0x00000000,0x006C2548,0x00000000,0x0651EC54,0x01F69DAB,0x00000000,0x0138E621,
+	0x00000000,0x01C60ED8,0x00000000,0x04EE9417,0x06EACE0A,0x03818B0D,0x00D529B9,0x00000000,
+	0x020219F2,0x00000000,0x00000000,0x01326301,0x05525144,0x05669F40,0x067A3258,0x01445FE5,
+	0x02CD1AD3,0x00000000,0x00D62F3B,0x027205E3,0x0138D585,0x054DE222,0x0A1AAA64,0x00000000,
+	0x0254E8A3,0x02529050,0x04520DC3,0x07A1F973,0x0762949E,0x06AA1807,0x08726234,0x02F96116,
+	0x03DACAF4,0x07EE4D3F,0x041E2D87,0x00000000,0x05AA8829,0x02C5C73E,0x05865C19,0x00000000,
+	0x00000000,0x00D5ADCC,0x069A06C1,0x01CE2169,0x07EA0125,0x01D2A7E5,0x024201A6,0x00E8815E,
+	0x04052B60,0x01CD947A,0x00B48310,0x00000000,0x01E1F169,0x04EE1254,0x00000000,0x00000000,
+	0x0342D7F4,0x00000000,0x00000000,0x06DA5736,0x02867528,0x00000000,0x049574CB,0x048E89B6,
+	0x0561780D,0x05E22834,0x0445E33D,0x00000000,0x018E9FAD,0x0036CB78,0x09EA89B0,0x00000000,
+	0x00000000,0x074A62F8,0x00000000,0x01217D43,0x01409788,0x09A6CA23,0x039666B9,0x00000000,
+	0x088636FD,0x041DC0FC,0x04956232,0x00542562,0x01B2476D,0x056D6268,0x0301E681,0x04B58BEA,
+	0x0B3AD0C6,0x00000000,0x0254CC92,0x00E2279E,0x00000000,0x0516B2BE,0x047AD2DB,0x083EB81D,
+	0x0416760F,0x0111250F,0x00000000,0x03E65286,0x010AC7AD,0x00000000,0x0565EE01,0x00000000,
+	0x01FE879E,0x00000000,0x05DA2677,0x02DD6893,0x00FA5EB1,0x00000000,0x07DA9D24,0x03C602FB,
+	0x009D5468,0x06869759,0x02AEC1B0,0x0765F9CA,0x04BE6ECA,0x00000000,0x0359EDAE,0x05A1D616,
+	0x00000000,0x022D1F08,0x0008A7B4,0x00000000,0x0106D688,0x0466B357,0x07DE2BF6,0x06DE9991,
+	0x01F9B2E4,0x0265CD73,0x00000000,0x0080B183,0x03D26ECA,0x04AD7D60,0x0886B37F,0x00000000,
+	0x00000000,0x01AA9A06,0x06F6A688,0x064A8F5D,0x032250B5,0x01AC8754,0x028AC62D,0x000EBAE2,
+	0x027DBDF2,0x00000000,0x02D6772D,0x0220A6AA,0x00000000,0x03B12F42,0x010E156B,0x03D5737A,
+	0x01261D28,0x0020FC4E,0x00000000,0x08B6D287,0x028568E1,0x04265635,0x00F190E8,0x00000000,
+	0x0445F65F,0x03D69BCF,0x0149476C,0x00885DFF,0x00000000,0x0166990C,0x08AE44F7,0x00000000,
+	0x088EAA2B,0x00DE94DD,0x07728974,0x069A3E0D,0x05796F3D,0x08DA4EA4,0x04EA33F1,0x00000000,
+	0x077EA95B,0x04BEBEC4,0x0532878B,0x0288CC6F,0x097A86EC,0x00000000,0x032D2B02,0x00A04A41,
+	0x0130849B,0x0661BE94,0x0028C599,0x03D6221F,0x00000000,0x034192C3,0x019D733B,0x0575F2A0,
+	0x01EDE935,0x0A7EB02C,0x00000000,0x00B08324,0x00000000,0x002281F0,0x050E3AFE,0x06EAD30C,
+	0x038256DE,0x0051396A,0x00000000,0x02B0ED00,0x00000000,0x039DA4AB,0x00000000,0x02B6C2F3,
+	0x01587EE4,0x035A7181,0x033174A1,0x00890002,0x0411190B,0x0565AD2D,0x01694EB4,0x00000000,
+	0x02597C65,0x00000000,0x00000000,0x03D90CA8,0x00000000,0x04617076,0x0020CF6B,0x03F29EED,
+	0x03B5EA65,0x0212B78F,0x00BCD493,0x0085E250,0x00A66118,0x0098BA58,0x00000000,0x02BE682A,
+	0x00000000,0x00000000,0x02B0FC48,0x00000000,0x02F62532,0x05B23989,0x07B2811C,0x03190695,
+	0x00520501,0x066EB212,0x00000000,0x00000000,0x0442657D,0x037E90AB,0x01787844,0x017D0B47,
+	0x05F9B5C0,0x042A2E7A,0x00000000,0x00000000,0x0265DE84,0x03A12E74,0x01096450,0x0A66ACF2,
+	0x00000000,0x047DE88F,0x00000000,0x00850994,0x07A6D6D7,0x048DDC08,0x00B5CFD8,0x045E1E3A,
+	0x01D2631D,0x00000000,0x0159F106,0x02D133F8,0x0049C79D,0x00000000,0x01406DE9,0x06E66164,
+	0x029E75B4,0x00E25B6E,0x02D291A0,0x0329BE2D,0x0A0EC066,0x057DFF17,0x020C9924,0x01C6AC6B,
+	0x00000000,0x00000000,0x01E0F32E,0x00000000,0x009E7773,0x019DF1C5,0x03BA84C3,0x082EB016,
+	0x01C630AE,0x04DA82BF,0x0039F1D3,0x00000000,0x073E9094,0x028DCAFE,0x00B03128,0x00000000,
+	0x01D5995C,0x00000000,0x01A286FD,0x0219BD8B,0x00000000,0x024AB87C,0x00000000,0x05A6C3A1,
+	0x09BAC105,0x03DD3C08,0x0290D2EE,0x03BEBF9C,0x0029506E,0x00145407,0x016D825B,0x057577B4,
+	0x00B9C118,0x00328DCB,0x00000000,0x03B943A7,0x01CD83A4,0x00167D87,0x023D348C,0x033EC28E,
+	0x00000000,0x052D7451,0x00000000,0x00000000,0x00000000,0x0006BC3B,0x082EC8FA,0x08DAA8FD,
+	0x00000000,0x03BECC10,0x036112C0,0x018E4698,0x07BAC2CE,0x01263300,0x059E9EB3,0x03450AB4,
+	0x00000000,0x01CD378E,0x0109E88A,0x025E12F1,0x00644500,0x04A19136,0x00000000,0x03F2744E,
+	0x00000000,0x0121E46F,0x0331A96F,0x07F6D7A0,0x02A2C6D4,0x0222AC35,0x00000000,0x009DA35F,
+	0x0265FDD6,0x0329C32D,0x0206D132,0x040D4C34,0x014EB505,0x00000000,0x030AA185,0x055EC7D1,
+	0x010CC926,0x00000000,0x020DACFF,0x01C97590,0x0234A403,0x024978B5,0x0495A3FD,0x00000000,
+	0x0071F334,0x00000000,0x03427B50,0x00000000,0x00000000,0x0665AC96,0x064DCF80,0x03BA601C,
+	0x036663CC,0x0176AF81,0x00000000,0x04313B98,0x00000000,0x05B24113,0x04B15F87,0x076ABFE3,
+	0x051DA571,0x00DAAB30,0x0206A13E,0x05563E15,0x08CED78E,0x056A79FF,0x00000000,0x0094BBFF,
+	0x013CA3D9,0x00000000,0x05861805,0x0236657F,0x00000000,0x00000000,0x00000000,0x0505EFAD,
+	0x00000000,0x00000000,0x00000000,0x02921672,0x00E69EC1,0x011E5AB2,0x00000000,0x00000000,
+	0x01F12BF3,0x0322ACF8,0x026A61F1,0x02E58A6E,0x06AA9EFA,0x09C68CF4,0x01B96221,0x00F90601,
+	0x018AD033,0x00386790,0x012ACF05,0x00000000,0x00AD7B88,0x00000000,0x038D5C4B,0x00000000,
+	0x069EB1E4,0x00000000,0x00000000,0x018A2A33,0x00884244,0x0205325E,0x0539C93A,0x07FECE7A,
+	0x00000000,0x01AA6A72,0x0285577B,0x0171F530,0x00000000,0x06E614F1,0x0615948E,0x007A0F87,
+	0x00198BE0,0x00458770,0x0211FF17,0x00000000,0x00000000,0x0004478E,0x023CC43F,0x02220AB2,
+	0x00000000,0x0281B94B,0x012D5626,0x02CD192C,0x02EA6148,0x030ABE87,0x0129A3AC,0x02DA696D,
+	0x03C5D248,0x00000000,0x086AB716,0x00000000,0x06A21BAE,0x00000000,0x029D635C,0x00000000,
+	0x05B57899,0x082A877E,0x00000000,0x0361921C,0x064E2861,0x0048D8FC,0x0536C0F1,0x05B6A1E6,
+	0x00000000,0x00000000,0x07727A06,0x0876CED4,0x0445700B,0x0099BDBB,0x08EA8414,0x00000000,
+	0x00000000,0x03D2110C,0x0532B117,0x014EBAAC,0x00000000,0x00000000,0x00000000,0x00000000,
+	0x01B5BDDF,0x00000000,0x01D4A9F4,0x0339CAD4,0x06BEC3D7,0x006DEA67,0x01D24CEE,0x00000000,
+	0x03BA956A,0x00000000,0x066E831B,0x0802BD0D,0x00000000,0x00000000,0x00000000,0x00000000,
+	0x00000000,0x04419B68,0x08FAD575,0x00000000,0x000018A7,0x048D2BAC,0x00000000,0x0255E776,
+	0x00000000,0x03226690,0x050A7CDA,0x00000000,0x00000000,0x00000000,0x0A6ABAE2,0x00000000,
+	0x02FA4ED2,0x0312D031,0x05665647,0x08BA90B4,0x04223B90,0x050A50AB,0x0131739B,0x02692C98,
+	0x00F1DE0E,0x01C0EE6A,0x004E7170,0x00000000,0x0109096A,0x064EBD3C,0x02B1FA56,0x01C1AA63,
+	0x00CA57CD,0x045ED134,0x05620AE4,0x03E59151,0x020CCAA4,0x00000000,0x0189F3D7,0x00000000,
+	0x041A830E,0x00000000,0x00F2C395,0x04118952,0x008A5449,0x064663E7,0x0110AE4E,0x000254F2,
+	0x00000000,0x08D2B6DE,0x01D27DBB,0x00000000,0x008186BB,0x062A4B4E,0x08C66EE7,0x04FE02E7,
+	0x03065860,0x04E2B117,0x0749E0C6,0x00000000,0x0231E9D4,0x00B9BBBF,0x00000000,0x0348E582,
+	0x00000000,0x021CD120,0x081E7AEC,0x003E85EB,0x003D739D,0x045E2CC7,0x003A42E2,0x00CAB016,
+	0x00ED1542,0x02A5AB04,0x00000000,0x0355BB5A,0x002E483B,0x0966A32F,0x02016AF1,0x00000000,
+	0x00BDA043,0x090E6A72,0x099E7FDF,0x029198A9,0x0689CE19,0x008E4E0D,0x00000000,0x03123120,
+	0x02B633F3,0x0446A37C,0x0149264E,0x010CC55B,0x058DF197,0x00000000,0x0010DDCA,0x00000000,
+	0x0330F611,0x0432180D,0x01011BA7,0x0852C70E,0x042DAED6,0x0176229D,0x01B24209,0x007064D0,
+	0x00000000,0x00000000,0x00000000,0x00000000,0x00B15624,0x04665657,0x0125E0D3,0x0095C346,
+	0x01B48977,0x077A400E,0x04A20299,0x0822CAFE,0x0135EC8B,0x08EAC27B,0x035D6CB7,0x0772B87C,
+	0x01FEA1E5,0x03E6686F,0x00000000,0x010224F3,0x00000000,0x069A54C3,0x008D9C76,0x04DA704B,
+	0x00000000,0x014CD105,0x0010B613,0x053A7155,0x05F5E80D,0x0691ED7E,0x0A6AC066,0x05718A9B,
+	0x00000000,0x00408983,0x01920C65,0x00000000,0x00000000,0x0025755A,0x032588C4,0x08865B98,
+	0x00000000,0x03B2B53A,0x01B5BF38,0x03396093,0x0189106E,0x01B10718,0x00000000,0x00ECB285,
+	0x00000000,0x028DCE38,0x0082884C,0x02350852,0x06026F6C,0x07925B9F,0x00123C60,0x011CA58B,
+	0x00000000,0x0752A3EC,0x01E1BB9A,0x00F948DF,0x03156FC9,0x00000000,0x00000000,0x00000000,
+	0x024DF40C,0x089A3EDC,0x0324E8CD,0x025634F0,0x03B91D52,0x0722AE40,0x00000000,0x011DF510,
+	0x086E7F77,0x0208FDAB,0x023EA222,0x06DE484F,0x00000000,0x00000000,0x00000000,0x00897590,
+	0x00B48FF6,0x025A90B4,0x00000000,0x06E2C741,0x00000000,0x01C9EC47,0x035E4F15,0x00000000,
+	0x03DA8DEA,0x00E6D678,0x00000000,0x00000000,0x007121D0,0x076E1A8E,0x00612C44,0x019DBDB6,
+	0x0232300B,0x0338F9B8,0x04B1F46D,0x066EB4B9,0x010A1F8F,0x00000000,0x035E5355,0x094679AF,
+	0x0392B610,0x0044F6A8,0x0018B7BA,0x0149848C,0x00160410,0x036CE8A0,0x02F215FA,0x00000000,
+	0x05BA41BA,0x00000000,0x00000000,0x0585AFEC,0x03591FFC,0x07167B48,0x03B16A5C,0x0A96BA6C,
+	0x00000000,0x0140D342,0x01897388,0x01B28AF2,0x03F11A6F,0x00000000,0x00000000,0x00000000,
+	0x00000000,0x09D2B819,0x044D2F76,0x045136B1,0x00486C58,0x07B29B02,0x0255A852,0x0254B567,
+	0x03590006,0x00000000,0x00000000,0x00000000,0x00000000,0x0806BBC7,0x00000000,0x00000000,
+	0x00A983D3,0x00000000,0x00F058D9,0x00000000,0x072A8478,0x0041EB83,0x082A5018,0x0088AC40,
+	0x0352837F,0x01016A6F,0x02E643A9,0x01A4CB8C,0x01907032,0x0044486D,0x04852B05,0x01E25642,
+	0x0022A389,0x00687F0B,0x00000000,0x00000000,0x00561205,0x00000000,0x00000000,0x01A61E07,
+	0x025665C1,0x01A8DC10,0x03E57B6F,0x00000000,0x0244D61E,0x00960DF5,0x0336918D,0x00000000,
+	0x081249BE,0x00000000,0x00AEB18A,0x00F9C955,0x00000000,0x04A2A8F4,0x00000000,0x02F15F59,
+	0x07166096,0x00000000,0x04857167,0x00000000,0x0A9EAF15,0x03A1919F,0x04EE2026,0x010AC444,
+	0x00000000,0x00000000,0x01E257E9,0x0499BA81,0x02E15B07,0x00000000,0x00D1921C,0x09EEAB7B,
+	0x0722AF83,0x05E99075,0x00B8C169,0x0186003E,0x03EDB544,0x00000000,0x00517415,0x06AA08FA,
+	0x00E6AE02,0x07A6921B,0x04099144,0x00000000,0x00D6BE56,0x00000000,0x00000000,0x0391B45B,
+	0x0079FE2C,0x0114FB9B,0x05F9FC7E,0x077EB95B,0x00000000,0x0289A0FA,0x019CE4A5,0x0199A622,
+	0x0165B19E,0x002C33EF,0x00000000,0x02518A22,0x0032253F,0x02B5D184,0x03AEC8FA,0x01A5653D,
+	0x00615E0F,0x00B15BCB,0x03651BC1,0x00462084,0x00000000,0x00000000,0x071ACC65,0x00000000,
+	0x0090C999,0x00000000,0x00000000,0x01F979D2,0x00000000,0x026551D6,0x0148CCE1,0x015955BF,
+	0x02FEB4C1,0x05FEADEC,0x00000000,0x00000000,0x042A01CE,0x00000000,0x055E419E,0x017CA84F,
+	0x03623DC2,0x03BAB7E1,0x039DC342,0x040297F8,0x0916C268,0x00000000,0x00000000,0x02269FC9,
+	0x08F24C94,0x0586AE40,0x00000000,0x0194EEA7,0x00000000,0x035A363D,0x07A63803,0x085A591C,
+	0x02ED7E34,0x0342A645,0x00000000,0x02D9B570,0x00000000,0x014A82DC,0x00000000,0x08F25371,
+	0x01A8C849,0x0096C52F,0x01E6A7E5,0x00000000,0x05568556,0x00000000,0x03C69E48,0x02BE386D,
+	0x02B8FDEF,0x0429E70A,0x02EEB495,0x029A0994,0x094AAAD6,0x05164EE8,0x04022D99,0x00000000,
+	0x01E6630A,0x075A73FB,0x09D6CE30,0x00000000,0x00459F3E,0x0425DCE5,0x01019CFB,0x00F16DF6,
+	0x097E8BFF,0x00000000,0x00F8AA1D,0x00000000,0x07BAD230,0x037A2E75,0x01893F78,0x068DFB9A,
+	0x00000000,0x0064ADEB,0x00000000,0x06F1E9C7,0x0141C2E9,0x0018BE8A,0x00000000,0x00000000,
+	0x00000000,0x00000000,0x01F248A3,0x03D5ACDB,0x02C28CDD,0x00000000,0x00000000,0x032ECE0F,
+	0x00000000,0x00000000,0x04718838,0x00000000,0x0655C48A,0x03DAD5A8,0x05697080,0x01A98940,
+	0x00000000,0x0009984C,0x00957022,0x020620C6,0x047E6D3C,0x06C61EA4,0x085E9555,0x055DFB89,
+	0x03A22270,0x003DCC01,0x0215F65B,0x06BDE72C,0x00000000,0x00000000,0x00000000,0x01C0B807,
+	0x047DA398,0x00000000,0x00000000,0x03D15204,0x09D6D7B0,0x016909EE,0x090E728D,0x00000000,
+	0x02A5490B,0x03C90D4E,0x00000000,0x07A27373,0x0679AD27,0x06565F37,0x02BA76D7,0x0816913F,
+	0x0154CD3A,0x01DDA825,0x010E7F77,0x00000000,0x05FE057D,0x0055C6E0,0x00000000,0x00E1333C,
+	0x0094DE46,0x00000000,0x0561E334,0x0245C3FF,0x019E8918,0x044520E6,0x042E37D3,0x00000000,
+	0x014997CE,0x034EB521,0x02B2CB6F,0x00000000,0x05959C76,0x0050504F,0x00000000,0x01464B93,
+	0x00000000,0x055A9BE7,0x089E5E39,0x055AC92A,0x04E62B6D,0x00000000,0x00000000,0x07DE6D59,
+	0x00959B82,0x00642AC6,0x009941BE,0x06E69825,0x0109E5D6,0x0661A3EB,0x00493227,0x015D05F3,
+	0x0A7ED1F0,0x00000000,0x00000000,0x0692CB31,0x02760ABE,0x09667F5D,0x006A5F52,0x00D5DECC,
+	0x00000000,0x00000000,0x00000000,0x00000000,0x00C55D8A,0x00000000,0x011689B6,0x009C6636,
+	0x00000000,0x04AD9736,0x00000000,0x00000000,0x02455FBF,0x00000000,0x00788047,0x0401806E,
+	0x00318C1E,0x0359F5D0,0x009AD1DF,0x00000000,0x00000000,0x016AD3DA,0x06A5EC90,0x07526AE1,
+	0x04BA9E48,0x02861F30,0x03B2D525,0x00000000,0x052AD835,0x01546939,0x0712BAE2,0x02CCE874,
+	0x00000000,0x00315F8E,0x016A0BAA,0x06CEB7A6,0x0662077E,0x07667DAD,0x078E8924,0x00000000,
+	0x04010AAE,0x00000000,0x00000000,0x0361EF16,0x07D26BC4,0x009E0133,0x041DD3B2,0x0572C5FE,
+	0x08C6879E,0x0361F2BD,0x029E6F0D,0x01457469,0x04BE7B42,0x00000000,0x00000000,0x06AE10DD,
+	0x00000000,0x00581CD6,0x01466388,0x0322C6EC,0x050E58A7,0x00C8FDA5,0x01D204EE,0x06124561,
+	0x0236D445,0x066DEB12,0x0786991D,0x03BD0F88,0x057D6DBF,0x00000000,0x00000000,0x03ED2A3E,
+	0x00C477B8,0x00B2501B,0x053ED789,0x0562612F,0x0535E9C6,0x05C191D5,0x07CEB37F,0x016487D8,
+	0x01715150,0x00000000,0x0259684C,0x03C64E4F,0x01A4AEAB,0x0531E09D,0x00000000,0x00000000,
+	0x00000000,0x00000000,0x068E27B6,0x03227154,0x0231FA05,0x024E15FA,0x0129470C,0x02359ED1,
+	0x025DB613,0x0334D3FE,0x0616D0C6,0x074E1FF2,0x00000000,0x03112C65,0x00000000,0x000AB429,
+	0x07D202A9,0x015CDD55,0x00000000,0x070EA9E7,0x05419AB7,0x093E52B9,0x00000000,0x00000000,
+	0x00000000,0x00000000,0x00000000,0x00000000,0x07B60AA0,0x0254D3C5,0x03D20247,0x018CA076,
+	0x063E0593,0x06D5E4A1,0x00000000,0x00000000,0x0406A8BE,0x03F515F7,0x03C10791,0x03D96C00,
+	0x07728EB7,0x00000000,0x01C9E0E0,0x02F66B84,0x00000000,0x02C4CE6E,0x0326D07E,0x0776BFA5,
+	0x05C18D2E,0x00F85E4C,0x00000000,0x00C894A8,0x00D201A6,0x00B0A2CD,0x01228EE6,0x037D7BB1,
+	0x00000000,0x00000000,0x04DDA70D,0x0555A408,0x009A1935,0x0116B633,0x01A8D874,0x02B5B708,
+	0x00000000,0x03BE19AB,0x00000000,0x03A97D5A,0x021E33F5,0x0511BFC9,0x00000000,0x033DF18D,
+	0x00000000,0x0122826F,0x0266C29E,0x00000000,0x06065A1E,0x04251885,0x04895948,0x08FEA56C,
+	0x00000000,0x01FEBB4C,0x00000000,0x00B23DA2,0x00000000,0x00ACF11F,0x004C92DB,0x0226B875,
+	0x008AA0D1,0x00000000,0x0341A474,0x00A51818,0x04B1C715,0x019E90BF,0x00000000,0x082EBF7C,
+	0x03856252,0x05DE4ACC,0x04110F50,0x00000000,0x0751DE48,0x03F26214,0x00000000,0x00ACCF1F,
+	0x02262755,0x00000000,0x014D7405,0x00000000,0x0479F151,0x07B25190,0x0030D165,0x07E2D13C,
+	0x040A7A97,0x091E88A1,0x002D0791,0x06F5F135,0x00000000,0x02F5A340,0x09A2BC6F,0x00000000,
+	0x02C58580,0x00000000,0x01015E16,0x03A51885,0x003133B9,0x033D2837,0x00000000,0x0112B997,
+	0x00000000,0x060A7D9E,0x09B2CF66,0x050E5A00,0x049630EF,0x0065B79C,0x06E631BA,0x00000000,
+	0x026E18D5,0x00000000,0x0149D295,0x00000000,0x022DEC91,0x00000000,0x04A595DD,0x047975D5,
+	0x00000000,0x03F23862,0x0160F473,0x02D51192,0x00000000,0x00000000,0x00E2AFA9,0x06C61697,
+	0x03B1D616,0x01596F1C,0x07DE5559,0x026CDFBA,0x00000000,0x02AD765C,0x05AAB8F1,0x07E2935F,
+	0x01AAA4E4,0x00000000,0x00000000,0x099E8B8F,0x00000000,0x0666C421,0x058A61C0,0x0475BE28,
+	0x04764CE3,0x035D3F64,0x037136FA,0x00DCA10F,0x0411E42B,0x00000000,0x00000000,0x0A46BA71,
+	0x00F54F26,0x01ACED00,0x0015AAD0,0x089E568B,0x014AC48B,0x0862A40B,0x01758763,0x032E2BD5,
+	0x015521F5,0x0149B7E1,0x00000000,0x00000000,0x04C18FF0,0x0135F4C6,0x00614853,0x06D1E7C9,
+	0x04556174,0x03029CC8,0x00000000,0x00000000,0x029537B8,0x030E0521,0x004EB8CD,0x00000000,
+	0x00000000,0x0902CA56,0x02C1A4F0,0x0475C725,0x03D58F94,0x00000000,0x00000000,0x02BDC7E5,
+	0x030A7534,0x01A09ADA,0x044AD3DA,0x013C8658,0x02490A1D,0x0065989C,0x04ADBE05,0x04B5B53D,
+	0x00000000,0x00000000,0x098E76C9,0x00000000,0x0219A958,0x00000000,0x05F2D868,0x00000000,
+	0x06AA2AA2,0x00000000,0x02B202FB,0x02959502,0x06621095,0x0228F64E,0x02D5BD10,0x00000000,
+	0x05323717,0x00000000,0x00000000,0x063EA2A0,0x0461CEB6,0x02258358,0x00000000,0x06DE4E4F,
+	0x01947CE1,0x02854028,0x0742439C,0x0006A0EF,0x00000000,0x01329B56,0x07522D88,0x00000000,
+	0x00000000,0x01F1FC39,0x04B15763,0x026E72CF,0x00000000,0x00000000,0x00756A69,0x087AD1CE,
+	0x009C98DD,0x007DFDD3,0x039D075F,0x00000000,0x00000000,0x070A2297,0x02D64EE8,0x00000000,
+	0x00000000,0x01360D6B,0x00000000,0x04C6B562,0x011A6F63,0x00000000,0x012D7DEA,0x0329E0F9,
+	0x00000000,0x004D59F9,0x029D6FCE,0x062278B1,0x063E3499,0x04728682,0x0151BD8A,0x01E67F0C,
+	0x05BA6703,0x012116DE,0x009D300F,0x0374EB72,0x00000000,0x00D4C846,0x00000000,0x005C4BF6,
+	0x04F57BA4,0x0762CC1E,0x01AE5B54,0x02527D43,0x00000000,0x00000000,0x0926A24A,0x00000000,
+	0x031D1CAD,0x00000000,0x000934EA,0x00BA98F0,0x00482B1F,0x056DFBED,0x018672AB,0x050AAB18,
+	0x02B1E09D,0x00000000,0x013CFA69,0x00000000,0x035DE24F,0x071E56DE,0x07BA9BA9,0x00C6C98A,
+	0x00000000,0x0090D9ED,0x04016F54,0x06023BB9,0x01E15C79,0x07BEA7AD,0x01857345,0x004C9BAB,
+	0x00000000,0x0A6AA669,0x00080F82,0x0596312D,0x0962CB31,0x0565B570,0x038A0107,0x00000000,
+	0x07467C10,0x04E17D7A,0x02555082,0x00000000,0x00000000,0x0208B8FB,0x0531DB96,0x00AA6F42,
+	0x00000000,0x035E1A62,0x01298B32,0x0168B53C,0x05165041,0x0055DB62,0x011218CF,0x00F25105,
+	0x00493D40,0x00000000,0x00000000,0x00000000,0x09C6A454,0x00000000,0x006E90F3,0x01A91E7E,
+	0x055EBC9D,0x042620C6,0x008C3880,0x028AAC96,0x034A51FF,0x00000000,0x00000000,0x04BE2042,
+	0x00000000,0x02961EC1,0x00000000,0x0526D0DC,0x00E21C11,0x0211C717,0x00000000,0x00D24CF1,
+	0x03951141,0x06F23513,0x00ADE776,0x058E9A0C,0x04255EE8,0x00C942AB,0x01DCA24D,0x07EE1D7D,
+	0x040DF146,0x010E5018,0x02CAAA76,0x00241512,0x01E9E6DC,0x00C6A7AD,0x05FD9EDF,0x008CE01F,
+	0x01007A14,0x09669A2E,0x00922D7A,0x050DC869,0x0225ADBD,0x02BCC2B8,0x06565003,0x03411217,
+	0x0142C638,0x02A4EA8A,0x03F96AFB,0x0021A622,0x02F0BE68,0x01B19FCD,0x00000000,0x034E07DF,
+	0x0842C239,0x00000000,0x05620775,0x027A24D7,0x08D27F5B,0x024E5089,0x056DE6A8,0x01D14771,
+	0x01D48480,0x037E0C3E,0x035AD74D,0x0555ED1F,0x01CCDF8C,0x082E9A34,0x00000000,0x00013F78,
+	0x00E11811,0x09565B1B,0x00000000,0x00000000,0x00000000,0x0019E3BA,0x02F17ADB,0x00FA630A,
+	0x071E6CA9,0x01A4AA3D,0x08325C93,0x0000335B,0x01092984,0x051153E9,0x0721E8D1,0x00E19537,
+	0x04EDB9F1,0x03913973,0x03224390,0x098AA1E6,0x0802165B,0x04E2D8A1,0x00000000,0x01FA3664,
+	0x02F4FDA5,0x0A22D429,0x04CA0927,0x00000000,0x00000000,0x00000000,0x0168AD19,0x054ACF59,
+	0x075A993A,0x01A4799E,0x00000000,0x004E5BB6,0x02462B3E,0x0322568B,0x0852712F,0x07C678D3,
+	0x003D7EC2,0x03812619,0x01E5606B,0x00000000,0x00000000,0x00000000,0x00000000,0x0319F9CA,
+	0x020A6C82,0x0039B4FF,0x01D1EDEE,0x067A30FF,0x03829004,0x032DB7DA,0x00000000,0x00000000,
+	0x09468F44,0x00000000,0x00000000,0x00000000,0x0441D5C2,0x076E225D,0x00B43BBC,0x004DB2DF,
+	0x04F94D51,0x0732A821,0x03DA7A3A,0x00CC3E15,0x033ECF33,0x04A56DBF,0x04F28BFF,0x0396403E,
+	0x00000000,0x077ABF62,0x00000000,0x0AFED0FD,0x03FAA55A,0x00000000,0x00000000,0x02F5ED1D,
+	0x00000000,0x05122EAA,0x0326510E,0x00000000,0x00152995,0x00000000,0x04414355,0x04BA684E,
+	0x0946942A,0x009A4845,0x00000000,0x0946D05F,0x00000000,0x00000000,0x02D9B9B7,0x00342678,
+	0x03426F3E,0x066DA953,0x043D40FC,0x091A9CCA,0x00000000,0x01AE8556,0x06CE5A9A,0x0522733D,
+	0x0355165A,0x0401E8F8,0x011DE32F,0x04EE85CE,0x04A2D437,0x00000000,0x02664073,0x03DE4730,
+	0x02A11E70,0x05D2A5B1,0x06FE0CA3,0x044241E5,0x071E413F,0x00000000,0x01B1D5D1,0x00000000,
+	0x058DE903,0x060A22E7,0x02C10405,0x037A7790,0x00000000,0x0389A004,0x00DDF58A,0x00000000,
+	0x00BA4907,0x023E877E,0x0A0E905C,0x0211B380,0x00000000,0x088E5FDB,0x04B56A18,0x00D9717B,
+	0x00000000,0x00000000,0x039ED031,0x0531819D,0x003E2EB4,0x05EDDCA2,0x012AC9AC,0x00000000,
+	0x08AE7671,0x093E7908,0x0138DB1E,0x00000000,0x00000000,0x02395790,0x095A6C75,0x0172C265,
+	0x00000000,0x06A21236,0x0555621E,0x00413D0D,0x00000000,0x03157022,0x00000000,0x031523C2,
+	0x07126A02,0x084666CB,0x0A46AD8E,0x009DFF07,0x0AC2BF84,0x05B9B9E8,0x01A93227,0x07568C10,
+	0x00000000,0x045DFC36,0x054D7CA3,0x011C645D,0x00000000,0x064AA839,0x06120B68,0x0481E47E,
+	0x024D166D,0x03E64B86,0x056AA8A7,0x023C93B7,0x0175B9F1,0x069DDFB5,0x00000000,0x00000000,
+	0x00D243DA,0x07EE8F5D,0x0191BD0F,0x08DAD4D1,0x00000000,0x00A9E8B9,0x047EA55C,0x011523B6,
+	0x01586E85,0x00000000,0x0252C5B8,0x07E2A062,0x082E3B50,0x00000000,0x0095F182,0x00000000,
+	0x036620B9,0x085E6079,0x00000000,0x00000000,0x00E5E808,0x0AD6C3D7,0x069A6F48,0x02A0DFC5,
+	0x00000000,0x034E8A91,0x0695C2CA,0x00000000,0x00000000,0x0796705D,0x05A65D1E,0x0181C14A,
+	0x02A1EFBC,0x060E74C2,0x00000000,0x00000000,0x08A684E0,0x00C57D80,0x00000000,0x00000000,
+	0x0609C52F,0x00000000,0x00000000,0x00B17ACB,0x00E8953A,0x07AA855F,0x0184EC9F,0x00000000,
+	0x07A60879,0x046D772E,0x03BAA389,0x00000000,0x01A9BAF9,0x02EDAA21,0x00D8ED30,0x0132B763,
+	0x010EA1C5,0x015D1008,0x020212F0,0x045DBFCF,0x0409F89C,0x00000000,0x034E2542,0x00000000,
+	0x00000000,0x018555F7,0x0301FA81,0x00000000,0x0095234F,0x00000000,0x015981E8,0x0141FD1A,
+	0x01D8A590,0x036233B8,0x0362377F,0x03612BAD,0x05C60F1D,0x00000000,0x02996E27,0x00000000,
+	0x01DA0237,0x05520B16,0x09CE9B00,0x00000000,0x03F547DB,0x00000000,0x05327790,0x020264C5,
+	0x01E0F4D5,0x01992191,0x00000000,0x058A43E0,0x0499E33B,0x0335CE52,0x08CE6F40,0x00000000,
+	0x00850EE4,0x0A96CD75,0x03F6B212,0x03B994A6,0x00000000,0x021CB5E8,0x03C24A0C,0x027E4C92,
+	0x01D4EA81,0x000A08FA,0x019DAEC2,0x06623E39,0x09425F52,0x00000000,0x045A5FDC,0x0226035F,
+	0x04823F69,0x00000000,0x00000000,0x04624811,0x02A1F215,0x028CF026,0x01495AA2,0x04EA67DF,
+	0x03212698,0x00D24033,0x00000000,0x03F1348C,0x03D204EE,0x0496B584,0x021AB1FA,0x00000000,
+	0x0592D763,0x0331A536,0x01BAB615,0x00D05ADB,0x03F1A3B8,0x00000000,0x00883399,0x00000000,
+	0x02AD507B,0x03318EE1,0x0149C4A1,0x00024778,0x0042618B,0x05DE8321,0x051D96E6,0x04158311,
+	0x035D1D55,0x00000000,0x034CE2E6,0x0305A383,0x04063429,0x049A776A,0x053620C6,0x06BEA7D2,
+	0x00000000,0x014C64E0,0x00000000,0x00000000,0x0269DAE7,0x03D10073,0x094A9ED5,0x021609B1,
+	0x00000000,0x02060D49,0x0AF2D359,0x034DE74A,0x05B61CF4,0x01260E28,0x0030AC6F,0x00893A22,
+	0x011A96AE,0x004A85CE,0x00000000,0x00000000,0x0088E1EE,0x01CA0247,0x02FDDC7B,0x02DD2703,
+	0x006A213C,0x024E651A,0x00AA315E,0x02117FDF,0x00017556,0x08260B40,0x021099CB,0x016E58C9,
+	0x00314B4A,0x00000000,0x05924F63,0x00000000,0x02E29F28,0x0822C1B0,0x078E3EE1,0x043E4BFA,
+	0x006040A7,0x03759F44,0x043DB414,0x00000000,0x00000000,0x00000000,0x04CDA9B4,0x00000000,
+	0x0669EAFC,0x00626741,0x07F6879E,0x016247AF,0x06BAC330,0x071A3650,0x049932E5,0x00000000,
+	0x01760CED,0x01B20E31,0x023D0695,0x0169520D,0x00000000,0x06C26679,0x03220974,0x0492A34D,
+	0x055A60D6,0x05520819,0x05BAC5E4,0x03A13EE2,0x046EA2A7,0x02462F49,0x06126A96,0x036AAB79,
+	0x04D57074,0x0140E2C8,0x04928607,0x00000000,0x00000000,0x05D25BBC,0x0755F3F5,0x01C101C3,
+	0x03928235,0x00000000,0x03DE7187,0x00000000,0x0509AB79,0x0655DB0C,0x044D1BD0,0x0284C0E2,
+	0x0A1EBA71,0x027DBB8E,0x05116E0E,0x05C6D8D0,0x0416A0F7,0x00000000,0x00000000,0x015ECBDA,
+	0x00E1CEEE,0x03890F84,0x0100FC66,0x00220EEC,0x00000000,0x056264AC,0x0481987F,0x00000000,
+	0x04F60940,0x036DA3EB,0x022ACADE,0x03B0F6DA,0x07EEC716,0x00000000,0x058272D5,0x0662AA7E,
+	0x00000000,0x0288F69F,0x0436ACCB,0x03DA93A5,0x000E6886,0x025AC554,0x00000000,0x00000000,
+	0x00000000,0x00BD2955,0x00D69549,0x0A52D418,0x0339472F,0x003545F1,0x0219E481,0x00000000,
+	0x0302802F,0x046222E1,0x0048D0CA,0x0034EB87,0x020E3321,0x096E9F3F,0x01B2C9C2,0x00013C35,
+	0x04A16496,0x00000000,0x01D63717,0x00000000,0x005A06A8,0x04E251A1,0x00000000,0x00000000,
+	0x05F5C7D6,0x0318FCBB,0x00000000,0x01CD1734,0x01DA8D9F,0x0372AD9D,0x037A863A,0x0461380F,
+	0x056A878D,0x03461DB4,0x03D96FA7,0x022236B2,0x00000000,0x04A1A8DA,0x037D8BB4,0x02E29BCF,
+	0x0304DEDB,0x0681CEC0,0x00000000,0x01AABB2B,0x0A62C9C7,0x00000000,0x01259C45,0x00000000,
+	0x00000000,0x0366A6BC,0x0A52B34B,0x03F584F9,0x07229094,0x0739F8B9,0x06C2030F,0x01BAA63E,
+	0x00000000,0x023693A3,0x08B6D7CA,0x00000000,0x00000000,0x01395ACD,0x058AAFE7,0x023DA16D,
+	0x00000000,0x00000000,0x00000000,0x0150E487,0x074A6F48,0x012E9EFD,0x0259394C,0x03DDB487,
+	0x02E2ADD8,0x035667D2,0x00000000,0x01CA4947,0x05E19C76,0x08DA5D15,0x0806AD25,0x054240E4,
+	0x05BE1BF9,0x00000000,0x00000000,0x00AD73B0,0x0412540B,0x0299C957,0x00000000,0x037E867D,
+	0x0A529B4B,0x00A4C318,0x03B1C6E3,0x03417A94,0x00000000,0x00000000,0x08CA68FA,0x03613006,
+	0x025A9CCE,0x04B268F5,0x00DD7E38,0x0681C666,0x070A4082,0x021A2974,0x0701F3DC,0x0009CFA6,
+	0x00F5D347,0x031632C7,0x00000000,0x01923C5A,0x04694114,0x0355AEA4,0x00000000,0x02E19049,
+	0x01C6356B,0x0212463A,0x00000000,0x017E4E73,0x034E2AE9,0x02A24BD4,0x00000000,0x05DE2ADB,
+	0x04B17332,0x00000000,0x08966406,0x01A0BEEC,0x0595FEBE,0x02F95E5A,0x053E34B5,0x00000000,
+	0x00000000,0x09C2C8D7,0x064DAC45,0x00000000,0x00000000,0x0416A478,0x00000000,0x042ACB31,
+	0x036A74E5,0x06DE7847,0x00000000,0x07CA8DB8,0x03150BD6,0x04755C30,0x017CBA7F,0x0110EDB9,
+	0x05DE690C,0x00000000,0x00000000,0x057E34D4,0x05B5C699,0x048D543F,0x03A5D98D,0x0275B569,
+	0x035251BC,0x0496962E,0x0329D1F9,0x0076D872,0x01B63D1F,0x00FA4816,0x07FE0B67,0x00000000,
+	0x03CAC753,0x02EE08F1,0x05F6315E,0x081A7161,0x05517F3F,0x0192907C,0x04721046,0x00000000,
+	0x00000000,0x07022CC3,0x00000000,0x004D1EDB,0x0792C99F,0x01C09149,0x00EDB761,0x03666089,
+	0x02454449,0x0358F581,0x023DB47E,0x063EC099,0x00000000,0x047D496A,0x061592D1,0x01C89B22,
+	0x021D2CF5,0x02FAD8D8,0x027A78A5,0x04D9C5A0,0x02096CAE,0x01719A14,0x00000000,0x05FE9D24,
+	0x00000000,0x00000000,0x00EC6789,0x05E58CB6,0x06EA7F77,0x01245897,0x04F6A1D0,0x00000000,
+	0x061E6F1B,0x00000000,0x00000000,0x04299CE0,0x01C66C6B,0x00000000,0x01D65EF4,0x00A20C4E,
+	0x00000000,0x02E60B27,0x00000000,0x05366CC8,0x00000000,0x04FA345B,0x0661FB1D,0x0856257D,
+	0x00000000,0x00000000,0x01649F16,0x00000000,0x044E8C10,0x04EA4D3D,0x03924DDA,0x036D5DA0,
+	0x003CF901,0x04EA8218,0x04EDA715,0x00000000,0x059608A1,0x090E9E87,0x05D9DF77,0x00000000,
+	0x00065B42,0x00000000,0x049603FD,0x069E7F05,0x0672CAA3,0x03DAB4AF,0x08764EC7,0x00000000,
+	0x09029865,0x070A4A9A,0x0311CF51,0x00000000,0x01892924,0x04962A85,0x00000000,0x00000000,
+	0x014AABCE,0x012E5AFE,0x02D6B946,0x07160677,0x08C66A53,0x052E96F3,0x0288AD71,0x073A3828,
+	0x00000000,0x051690BA,0x02267BFA,0x01493ECC,0x029D8525,0x06CA30A0,0x00000000,0x00027DEB,
+	0x02F10297,0x00000000,0x09A66FA2,0x030DEF86,0x044A374E,0x03963061,0x00000000,0x04623CB6,
+	0x03D9FC91,0x00000000,0x04795BD7,0x007676C9,0x0406C62D,0x0221652D,0x003A56C1,0x00000000,
+	0x00000000,0x00000000,0x0766D37A,0x00000000,0x0395E73E,0x0221EC2D,0x03E4FDD7,0x00000000,
+	0x04CA689C,0x0235C725,0x06F21F6E,0x00000000,0x0389DD04,0x00000000,0x06EA1C0A,0x00000000,
+	0x00000000,0x0035297C,0x00C53112,0x06626539,0x028538E2,0x00000000,0x016A2F0C,0x04459925,
+	0x00000000,0x023E17F6,0x03ADA203,0x0A1A978F,0x01DD23A4,0x0696591C,0x02DAD836,0x00000000,
+	0x02A29549,0x00000000,0x07EABFC6,0x05C1E776,0x00000000,0x060DCE89,0x00000000,0x00000000,
+	0x022974E2,0x02611E44,0x00000000,0x04560972,0x0271C29F,0x00000000,0x088A4570,0x072210D1,
+	0x05D6C222,0x06E6B266,0x00A50571,0x009E8333,0x05DA3235,0x00000000,0x00000000,0x08C2ADEC,
+	0x019A1982,0x01769100,0x00000000,0x00000000,0x030AA9B9,0x04F5FC0E,0x00000000,0x0676C6C8,
+	0x015CE4CA,0x01A5FCF0,0x0195BB52,0x01260BCF,0x08BE487F,0x04BE84CA,0x0285333B,0x0278CF77,
+	0x00000000,0x00AC8D98,0x00000000,0x065E697C,0x067A515E,0x01AA5EFB,0x00000000,0x00D8B4A0,
+	0x0321B476,0x0556221F,0x05764F15,0x02455949,0x03B9DE89,0x02958ACC,0x05DE6E4A,0x0275A554,
+	0x00000000,0x01E4BB1C,0x0586AA70,0x00000000,0x06D6C674,0x00000000,0x0222D5EC,0x035EA3EC,
+	0x00000000,0x002E0B67,0x06A69F53,0x00000000,0x0080ED00,0x00000000,0x00096429,0x0496358B,
+	0x01EE06DE,0x017C6CE6,0x00000000,0x00000000,0x09B2AE4A,0x04E26838,0x00E85832,0x00000000,
+	0x010C5E21,0x0471BB6B,0x00542E88,0x00000000,0x04326608,0x070EAFE7,0x00000000,0x017A65C1,
+	0x02DE1753,0x00000000,0x024AC82E,0x00D9817B,0x03054BE7,0x04315165,0x00000000,0x00FD7A2E,
+	0x00000000,0x054A483D,0x00000000,0x0162CF33,0x011CB9A9,0x06B20496,0x04C9BD8A,0x00CD7EC3,
+	0x00000000,0x000105E3,0x01B0B1A0,0x003606E8,0x03BA258F,0x00000000,0x00000000,0x05B684E0,
+	0x0582B70E,0x0772516F,0x0549DDC9,0x00000000,0x00000000,0x00000000,0x00000000,0x0535CEBD,
+	0x05D6C872,0x0365175D,0x00000000,0x0422C3D7,0x00000000,0x0044A137,0x058A20A5,0x0129CFA2,
+	0x02CCD335,0x039A9E21,0x0672051A,0x00000000,0x08D6C11B,0x00000000,0x00A6B3EE,0x0459886D,
+	0x03328924,0x017AB8C1,0x011DA541,0x088289AF,0x05C2056B,0x0161014D,0x03721C12,0x01E5DB1F,
+	0x00000000,0x00000000,0x06B64E75,0x05B9EF96,0x00000000,0x013E5657,0x0441455B,0x012D0CF6,
+	0x04D6354A,0x00EA9C1A,0x058E3803,0x0425BD55,0x00000000,0x01A94302,0x013901CD,0x01DD8C34,
+	0x01C1782C,0x00000000,0x02B0EEA7,0x04399DCC,0x099A93BF,0x00000000,0x01B4D725,0x0026BA5F,
+	0x0001C353,0x05A59B12,0x00000000,0x0311BF51,0x0031F36F,0x00000000,0x0160D9B9,0x06D2CE84,
+	0x05EEBA37,0x00000000,0x01451CA5,0x03E61951,0x06FA77A8,0x0040E859,0x00000000,0x00000000,
+	0x00000000,0x00359643,0x08D691B3,0x00AE0376,0x00000000,0x039A91B3,0x0165E64B,0x094EA271,
+	0x00898B14,0x032601B7,0x0429906D,0x00027844,0x0250F6D1,0x00000000,0x068E7983,0x056967CC,
+	0x078EAB18,0x00000000,0x00000000,0x05B250B7,0x0255B1BA,0x0069CE3C,0x00000000,0x05662F14,
+	0x017A878B,0x016CA8F6,0x00000000,0x03D93FAF,0x058A2AED,0x00000000,0x00000000,0x00000000,
+	0x014A3A8C,0x08C65BBC,0x02918468,0x047DA9F7,0x098EB6C4,0x00000000,0x00000000,0x00000000,
+	0x065251DC,0x031262F8,0x0265DC40,0x04C99ED8,0x01A935CE,0x08F65B38,0x00000000,0x01F0C591,
+	0x011AD445,0x00000000,0x056E3F72,0x0019FF7A,0x00000000,0x05BDBB41,0x0161FA33,0x01A094CF,
+	0x00000000,0x01612BAA,0x042E6AAF,0x00000000,0x0390F74C,0x0204F220,0x00000000,0x00000000,
+	0x00A9CD5E,0x00000000,0x00000000,0x049ABF4E,0x0000E544,0x00000000,0x08DAC4C2,0x03756254,
+	0x00000000,0x075296E1,0x00000000,0x00724675,0x02C5434C,0x056ED6E5,0x00897C0A,0x00000000,
+	0x00000000,0x078A247C,0x05261373,0x07E2671E,0x082E253F,0x03C8F533,0x088E3356,0x00000000,
+	0x02259D7A,0x080647D2,0x00000000,0x039CFB09,0x024DA40C,0x02F1D82E,0x06299693,0x02DEBF84,
+	0x00000000,0x0115BF9B,0x028AC84D,0x012E3E13,0x04A9F02A,0x00000000,0x02C15D50,0x00000000,
+	0x08629F1F,0x0B22D31F,0x00000000,0x01A6C409,0x00BABA18,0x0445BFD9,0x00F078F0,0x006167A7,
+	0x00D4AF46,0x04CE5EDE,0x00000000,0x0269074D,0x00000000,0x04C9F4D8,0x00000000,0x0A669DF2,
+	0x05CAA96E,0x030CE3AD,0x07C634FC,0x01A26F9C,0x088E73DF,0x03B9454D,0x009C9036,0x0621922A,
+	0x00C1E935,0x00AD836F,0x00000000,0x00000000,0x04AEC9D5,0x00000000,0x01A15030,0x0565BBBD,
+	0x08426EDB,0x00000000,0x00A8E709,0x059EBC51,0x060E2350,0x00000000,0x0601ADC8,0x00000000,
+	0x0030A280,0x024EA609,0x00000000,0x00000000,0x01B9FEB3,0x011D394D,0x00442203,0x012E6703,
+	0x05163ABE,0x0066C70E,0x096294F7,0x00000000,0x03D557D9,0x0456B88A,0x0335A179,0x016220B5,
+	0x00FA0F15,0x00000000,0x07CA5FBD,0x0389BB80,0x04F165A0,0x048686AE,0x02199169,0x00000000,
+	0x01E2787A,0x0169910C,0x05A25216,0x01F17675,0x07E2415F,0x0615D5D1,0x037592C3,0x00000000,
+	0x02597572,0x04268885,0x00CCCFD6,0x00000000,0x00C0CCED,0x01261FCF,0x0161DACD,0x00FEC2A8,
+	0x05E9845C,0x04666A0B,0x005580E5,0x00000000,0x01D2256C,0x02053C6B,0x00CE16C9,0x00AD407B,
+	0x05028858,0x0372C0F5,0x00CD8AB2,0x00B53E04,0x01623ACF,0x093E9B8D,0x00000000,0x044608B5,
+	0x00000000,0x008A3F56,0x069EB03D,0x00000000,0x01AA21AE,0x00000000,0x03DA9F28,0x03061672,
+	0x02C254AF,0x0289FB3F,0x0178EC5C,0x02223DC2,0x00000000,0x01E1FAE0,0x05B2D783,0x0276CE69,
+	0x01E1E53A,0x041241D8,0x06D5C9C0,0x07322EE9,0x028D08FE,0x03821781,0x0542916E,0x09FEB3E8,
+	0x00000000,0x02A173AF,0x07DABA02,0x051EBA78,0x07A258F0,0x0021DC2D,0x00000000,0x08BE990C,
+	0x029A8EA0,0x062EC594,0x054A2A04,0x011C5841,0x093E5BB6,0x013E6E78,0x00000000,0x01819BBB,
+	0x00000000,0x0334D9A9,0x014A76A2,0x05516C19,0x00000000,0x00000000,0x01B566C8,0x04BA6D66,
+	0x00000000,0x00000000,0x01085D85,0x00000000,0x0134645A,0x00000000,0x0309C5AA,0x036ACC79,
+	0x05668E4A,0x056AAA64,0x021ECE37,0x07AE3FAA,0x0621FE83,0x00849C52,0x03BA7A4D,0x00E199DE,
+	0x03A682DC,0x032EAD51,0x00000000,0x010611EB,0x00000000,0x038EB17D,0x00000000,0x04C24E37,
+	0x0188FCE8,0x00B4B8D8,0x06C9D350,0x00D5CE31,0x07BE9CCB,0x049D4A9A,0x076AA260,0x00FDAD2E,
+	0x00F8C79B,0x0852B5BD,0x00000000,0x002E3C1B,0x016116AF,0x04E152E8,0x086A3BF3,0x083A9329,
+	0x009E93B8,0x0692A9E2,0x03F124D3,0x06CA5ADD,0x0086749F,0x00000000,0x0226CE7A,0x00000000,
+	0x00000000,0x01CEC28E,0x01407762,0x01A887FB,0x00E5FDCC,0x00000000,0x08D6D12A,0x00000000,
+	0x065DD399,0x00000000,0x03CE8060,0x0010A06E,0x0245C715,0x01CD78D3,0x024E16B7,0x015DADCA,
+	0x00000000,0x00000000,0x00000000,0x06229F7F,0x037DC5B4,0x00000000,0x0252318C,0x00000000,
+	0x037DEEDD,0x00000000,0x00000000,0x01209BDD,0x05C5F83C,0x00000000,0x0389C2E9,0x016A2CDA,
+	0x00000000,0x04F1D31A,0x0A269624,0x014AC2CE,0x07660AE4,0x03F2B176,0x00000000,0x00EC96E1,
+	0x058D7F25,0x079E6A96,0x0144BDF0,0x07FA78F9,0x09C2B87D,0x048A8C73,0x00000000,0x089A4DA0,
+	0x0254FCC5,0x079ABE27,0x0272083C,0x096AB09A,0x00000000,0x072DE51F,0x04157572,0x0318F2A3,
+	0x02D4DD09,0x05899338,0x08322CE6,0x00303348,0x02F4DC98,0x02EE935D,0x033A5E4D,0x00000000,
+	0x08D69270,0x00000000,0x02BE9E0A,0x03CA7EF4,0x00000000,0x00000000,0x0409B365,0x041208D0,
+	0x083AB6C4,0x00000000,0x019A9A6A,0x0044B8D4,0x0671B677,0x07DE2113,0x012CFD26,0x00000000,
+	0x03A588A1,0x00000000,0x02721217,0x0666A53B,0x00000000,0x03B60AA9,0x0816A0BC,0x00000000,
+	0x034924DE,0x0896AE8D,0x04C63862,0x00000000,0x086AABF5,0x047E7D9A,0x00098C66,0x00000000,
+	0x03FA6AAF,0x00000000,0x06EE9F3F,0x075EC7C5,0x00000000,0x0125B97C,0x00854CB5,0x00000000,
+	0x049E68C7,0x05756325,0x04E56290,0x03669BBE,0x018E5AAD,0x03BDD97A,0x026D3FCF,0x08FE9FF9,
+	0x00000000,0x0259A7F9,0x00CAC8CD,0x0922AC23,0x0422D55E,0x02F54840,0x078E4D3D,0x02699BAE,
+	0x00000000,0x00000000,0x06466F53,0x00000000,0x01C1572C,0x004CA004,0x00000000,0x05AA7D43,
+	0x00000000,0x049E2521,0x0136D404,0x01F0D495,0x000A8249,0x00000000,0x00000000,0x0195C54B,
+	0x086AB1F5,0x06D291D4,0x0201722B,0x008C41EB,0x046E8845,0x056DF35D,0x032D6807,0x02109301,
+	0x00000000,0x04B1CD22,0x00000000,0x00000000,0x05ED9657,0x03FA7DFB,0x0A52CE84,0x00000000,
+	0x08162E9F,0x0320DBEC,0x00000000,0x008DB17A,0x00000000,0x0532A8B2,0x06665EDE,0x04A978D9,
+	0x063E4D99,0x00000000,0x0141C342,0x05395C11,0x0241BB5F,0x038E107D,0x0086B66A,0x0158A600,
+	0x00BD6C7C,0x01587D04,0x00A4F63A,0x032D1058,0x00021FA7,0x0389AD75,0x04C29057,0x01E5C571,
+	0x06DAACD0,0x0172630A,0x00000000,0x0A96CE32,0x0394F6F3,0x042DCA6C,0x02C60554,0x00000000,
+	0x03E646D7,0x00000000,0x00000000,0x0461D30F,0x05BDB467,0x0922D24F,0x01D2855F,0x00000000,
+	0x0639A0C0,0x060DDE62,0x00C6218B,0x0529F61A,0x01B1B1A2,0x03E51C39,0x00000000,0x032234B5,
+	0x01DE5E4D,0x0141EDEF,0x00120A02,0x03EE58C9,0x0045FA04,0x0509D488,0x033A6C4D,0x065EA2FE,
+	0x027EC9AC,0x00000000,0x07F62DA3,0x00000000,0x063597D2,0x0701D692,0x00000000,0x0B12C985,
+	0x00000000,0x01465642,0x0822D0BF,0x0675F9C4,0x044A121C,0x03EDD382,0x06AE1C0B,0x009D9075,
+	0x09FA84DE,0x02EAC720,0x04FD84E7,0x0194EC43,0x00000000,0x00B0CCDC,0x0511F2C1,0x045DEAD2,
+	0x023D779F,0x047EBD1F,0x011ACC06,0x00000000,0x00000000,0x06D263F6,0x05D25D15,0x04AEA32F,
+	0x0556340E,0x0982D1C2,0x01ADAC1B,0x03EDE59B,0x00000000,0x074EB117,0x097AC3A3,0x007870EC,
+	0x00000000,0x083A790D,0x00000000,0x041966C8,0x01151700,0x00DCDB56,0x004DAB04,0x0301062F,
+	0x00000000,0x04E55241,0x00000000,0x038A57E9,0x063E70FA,0x00000000,0x026AB1DB,0x05518CC5,
+	0x013489C9,0x00000000,0x00000000,0x00000000,0x0486AF5A,0x0559A2DA,0x0599D2AC,0x00000000,
+	0x01661F0E,0x00000000,0x011A60AE,0x00000000,0x00000000,0x00000000,0x040171A3,0x05927CF8,
+	0x00000000,0x08E6D132,0x0369B8A5,0x003E75EB,0x010856E8,0x08729A5E,0x01EED5A6,0x00000000,
+	0x00000000,0x05D5C596,0x00607F6F,0x0102C501,0x00EE22B8,0x00000000,0x0288D647,0x0551B096,
+	0x00000000,0x098AAB47,0x00F4429A,0x01B6598D,0x00000000,0x00000000,0x00000000,0x04865DCF,
+	0x00F61866,0x00000000,0x00000000,0x04829A69,0x00B042BF,0x00000000,0x00895556,0x02149B67,
+	0x06127B0A,0x005527C7,0x01B663C4,0x01423BBA,0x02B9A87F,0x02E20107,0x00000000,0x00000000,
+	0x00000000,0x010E1C4C,0x04E5A431,0x024A1A10,0x0405C1BE,0x00000000,0x0446A00D,0x0309772E,
+	0x04D14CB0,0x009DB00F,0x0030B52D,0x010142C4,0x00B96298,0x00000000,0x00000000,0x00000000,
+	0x00000000,0x00000000,0x04BE8CFE,0x04E29B79,0x00000000,0x0635DD6A,0x03AA3A9D,0x0250A9A8,
+	0x005DB94B,0x051AA222,0x0312C725,0x035CE53F,0x00EA9336,0x03213DF8,0x0489B5DC,0x015E728D,
+	0x00000000,0x00000000,0x00A640EC,0x093E8068,0x091AD2F6,0x0A16D05F,0x015DFEDA,0x039D4EB8,
+	0x04E24362,0x011C97A9,0x00000000,0x07BE21FD,0x00000000,0x043D2E33,0x019118E2,0x00369687,
+	0x047671E3,0x035EAE55,0x045E3A73,0x06162DFA,0x07BE19BC,0x00000000,0x02B990F5,0x021D10CE,
+	0x00ED2A1E,0x01B9CE3F,0x00000000,0x0192C1B0,0x00000000,0x00000000,0x0394EDDD,0x00000000,
+	0x00000000,0x07F6701A,0x067289A3,0x01A9B487,0x070E102A,0x07D2C36D,0x050AB343,0x06CE0C01,
+	0x058A5B7D,0x04FE6164,0x060E0486,0x05F231A1,0x0A3697CB,0x078E193D,0x025AA44C,0x0735F3EF,
+	0x059A3FAA,0x049DFE14,0x05BDA4A7,0x0611AC96,0x00000000,0x038A1DD4,0x0145EFF0,0x01DD5636,
+	0x01A5CBAA,0x00367BC0,0x010DCDD7,0x05328E13,0x023DFCAC,0x00000000,0x00000000,0x0146B193,
+	0x073E4EC7,0x06BA82EF,0x000903B2,0x037E2BBA,0x057233FE,0x00000000,0x06369609,0x02B9C0AE,
+	0x0309512E,0x03C55624,0x00000000,0x063A22B1,0x06CE50B3,0x04FEC2D9,0x00000000,0x05DE0EC4,
+	0x0184F69F,0x089EBC59,0x00000000,0x005A54E7,0x0080C94A,0x00C275EB,0x00000000,0x00000000,
+	0x03A9180F,0x028A8771,0x00000000,0x051DB636,0x00000000,0x02719EFB,0x008DA676,0x00000000,
+	0x0015AF8D,0x01C51EDB,0x045DCD73,0x026D58DF,0x00D0A9A1,0x00C92239,0x086E3321,0x00000000,
+	0x00000000,0x08C69A8C,0x00000000,0x00000000,0x0212A40A,0x001A132E,0x016A651A,0x00453C71,
+	0x00000000,0x016A9BE7,0x093EA546,0x00000000,0x00EC4C2B,0x0A36BC72,0x00000000,0x0278E077,
+	0x00000000,0x00890530,0x01ADA5C9,0x03255D9F,0x000173FD,0x00208605,0x00000000,0x08123B4B,
+	0x03A2C74A,0x05FE043A,0x009471F1,0x0006AB25,0x03C5F7E6,0x00CC6EFE,0x0202C741,0x04C2D04B,
+	0x01D64980,0x0572917D,0x05CA7AD8,0x05E28E99,0x004D1560,0x00000000,0x00000000,0x0556095C,
+	0x03520638,0x02F0F56C,0x01EE2233,0x056D8261,0x0238EBA5,0x0599B989,0x03967593,0x0446ACE6,
+	0x00000000,0x04391A29,0x012C83FF,0x00000000,0x00000000,0x023628FC,0x031683D6,0x0432CCD8,
+	0x00000000,0x05DED429,0x01607E3D,0x00000000,0x0141A4EF,0x075AC53C,0x00DD0BEB,0x00ED1BB8,
+	0x008C7877,0x046E19CA,0x049A54F1,0x00000000,0x0188E48E,0x00000000,0x00000000,0x0232A380,
+	0x042D6C6C,0x00000000,0x0031257D,0x00B11DBF,0x00000000,0x00000000,0x00000000,0x00000000,
+	0x00000000,0x04CE8DB9,0x08429ADB,0x03753A53,0x02529528,0x010CE264,0x07567154,0x02A58E17,
+	0x04D601CE,0x0231AC16,0x01B293B8,0x00000000,0x0916504B,0x00000000,0x03ED8461,0x06A5EDD3,
+	0x0399ECE5,0x0305A0E7,0x021D23E1,0x046EC9E6,0x06A650B8,0x05CE029C,0x0086CC63,0x0229E0DE,
+	0x076255C4,0x00000000,0x01B8BDA5,0x03A4EA79,0x00000000,0x04717B91,0x078EA7BF,0x00000000,
+	0x00000000,0x086A1C15,0x00000000,0x00000000,0x0622BBA5,0x0081AE31,0x04063D1F,0x038ACC07,
+	0x0264EFA1,0x00000000,0x067A1C29,0x051E5577,0x00000000,0x00000000,0x06CAA91F,0x08623C8F,
+	0x0060202F,0x04A18186,0x00000000,0x04E18DD2,0x00000000,0x00022300,0x003D42B4,0x00000000,
+	0x00000000,0x00000000,0x02C28F79,0x04AE1019,0x00000000,0x00000000,0x00000000,0x08666BFF,
+	0x00000000,0x00000000,0x024EC411,0x03F5AC5E,0x0936B8CE,0x00B5A0EA,0x01EE6726,0x0862D8A1,
+	0x00000000,0x0205E43E,0x01A67C8C,0x01221886,0x03C21E91,0x00887FEE,0x056EC357,0x01266044,
+	0x050E6A16,0x0274E013,0x00468244,0x072618C0,0x06A27E25,0x00000000,0x002631F7,0x00F63C46,
+	0x011D55AB,0x01958F4B,0x030A3DA2,0x0105F3B7,0x00000000,0x04E5B568,0x08263E08,0x082689E2,
+	0x001A18D5,0x03424666,0x04C2D7F2,0x013D88D9,0x0695B471,0x0692C00C,0x00000000,0x06427E6D,
+	0x00000000,0x00C53237,0x008A58F0,0x064E663C,0x01407142,0x01095204,0x00000000,0x00846329,
+	0x04167DB6,0x033A73FB,0x08FE6BF9,0x0842548C,0x005D1734,0x00000000,0x04C65291,0x00000000,
+	0x00000000,0x05564EEB,0x00656200,0x00D533DE,0x00000000,0x053672FC,0x00000000,0x0505D07E,
+	0x03212BEC,0x04B5F3D1,0x00000000,0x0071E9CB,0x00000000,0x0239BB5A,0x0049EEA9,0x03B18D42,
+	0x00000000,0x00000000,0x040EA246,0x01494089,0x03519667,0x00000000,0x07BE5E16,0x02FDB863,
+	0x00000000,0x00F2670E,0x025A1753,0x04017FD2,0x00000000,0x00117B91,0x0381C40D,0x01DE83F6,
+	0x04A1A599,0x00BE90D7,0x0014DF87,0x00F5B1A4,0x02BA51EF,0x013D20D9,0x00C6BFFD,0x00000000,
+	0x0155B63A,0x05F65B9A,0x03B24021,0x06FE3356,0x00000000,0x00000000,0x05CA4C25,0x03F1F7ED,
+	0x00000000,0x03D9D5D1,0x0278FE95,0x02E9F860,0x00000000,0x02192738,0x00000000,0x00000000,
+	0x00000000,0x01D553F4,0x005E4138,0x00F9DFB5,0x023CD384,0x00FDD6C1,0x025A26E5,0x029DBB6B,
+	0x00000000,0x00000000,0x02A554DA,0x00000000,0x0011DF13,0x0A2EC5E4,0x0025F8AB,0x0258DD4D,
+	0x08125410,0x02C111D6,0x00B1AFD7,0x01E21846,0x05558C1E,0x00000000,0x0258F124,0x075A0582,
+	0x00000000,0x02556218,0x00000000,0x053A86FD,0x07BA5C93,0x0054C46D,0x03DA8AA7,0x00000000,
+	0x0212070A,0x02D68846,0x00000000,0x074A3442,0x05FDAA21,0x00000000,0x0052B431,0x00000000,
+	0x0326984A,0x00000000,0x085E3E4C,0x0254AE25,0x02F2223B,0x03D1EC47,0x0032541A,0x01FDE0E5,
+	0x08C63C81,0x00000000,0x0102540B,0x05E9B019,0x07A64698,0x02E90516,0x05064209,0x04B1CCC9,
+	0x05B6244D,0x00510C24,0x06FDC7A3,0x0335EC80,0x00B17F24,0x00E13D86,0x0161D526,0x019943A9,
+	0x04E61ECA,0x069DBD1A,0x00000000,0x00000000,0x00000000,0x0631FB9A,0x00000000,0x00000000,
+	0x04CA7A97,0x007C2BEF,0x0A12D8C3,0x00000000,0x00000000,0x00000000,0x012198EC,0x0162A081,
+	0x00000000,0x00000000,0x019D91F2,0x00000000,0x0479987E,0x00000000,0x00000000,0x02315FD4,
+	0x00000000,0x01460392,0x01FD51A5,0x06E2B763,0x06B66B71,0x01767856,0x012D79FF,0x00000000,
+	0x009C5775,0x00000000,0x04D5A474,0x04D5D67F,0x00000000,0x00000000,0x04864675,0x070EA042,
+	0x00DCF2D7,0x030CEA8B,0x00A669EE,0x0A22C3A1,0x00000000,0x03910091,0x00000000,0x0462C554,
+	0x0165EBF2,0x002D12DA,0x03E1C593,0x018A5E4D,0x05920B79,0x032AA062,0x0469F6F8,0x0091C2ED,
+	0x0355F8D7,0x0329DCA4,0x067E69A6,0x013A4964,0x00000000,0x025DCEFE,0x01BE50AB,0x00000000,
+	0x0279AF8D,0x0425FA8C,0x0732C330,0x00000000,0x00000000,0x03422350,0x07D2BBA9,0x04B2A0EF,
+	0x016206F6,0x0692C774,0x05CABBE3,0x00000000,0x04ADC1AC,0x0032C1C3,0x00000000,0x00C92CFC,
+	0x0225CEBD,0x00BED155,0x00000000,0x01A8BF02,0x00000000,0x00000000,0x03F64031,0x00000000,
+	0x09C6A7B8,0x000A5D85,0x00000000,0x00000000,0x00000000,0x0751FC23,0x03516769,0x04818402,
+	0x006853E2,0x02B8F877,0x00000000,0x02BA7277,0x0639C2B9,0x00000000,0x044A3680,0x010E6726,
+	0x00000000,0x027D66AC,0x0A3AC33D,0x00FAA573,0x00000000,0x017877EB,0x01DD886D,0x07BE651D,
+	0x05A9DD42,0x00846C45,0x06FDDFD9,0x01761A61,0x00886A6C,0x065A186A,0x055163C5,0x078A644F,
+	0x06726BE0,0x00FD37F4,0x00000000,0x0515E0E0,0x00000000,0x00000000,0x00000000,0x05966A7B,
+	0x08DA78B2,0x09827F45,0x00000000,0x01893188,0x030515B6,0x098A9247,0x00000000,0x02D8D5A4,
+	0x00192AD8,0x0064D623,0x00000000,0x0240B1CC,0x05D63C5A,0x0926CEC6,0x0214CF78,0x05D9DC75,
+	0x00000000,0x00000000,0x08569412,0x03B14EC2,0x00000000,0x04C13FF2,0x00000000,0x04D18B32,
+	0x00000000,0x00000000,0x0486B941,0x00000000,0x05260DFE,0x000DFE37,0x00000000,0x06D1BF87,
+	0x07C68480,0x069273EF,0x028DB47E,0x0326630A,0x02325FFE,0x045DA750,0x02ED74EC,0x00000000,
+	0x0485DAAF,0x02AA98AD,0x0736A2C2,0x0238E7C7,0x012962E7,0x07EEB495,0x00E911E9,0x00000000,
+	0x0772B343,0x06424675,0x0148BC6C,0x00000000,0x06C1FC6E,0x09B2CDCA,0x0785F55E,0x00B257CB,
+	0x00000000,0x00000000,0x01365CEB,0x03AE6BA4,0x05BA9F3F,0x04F9E4EB,0x04090D07,0x04966420,
+	0x00000000,0x0509FAB5,0x00000000,0x09365312,0x04AE08B8,0x07C23C05,0x0665F2DE,0x02ADDC68,
+	0x00000000,0x00000000,0x0732651D,0x07763536,0x00188D74,0x04D22AB0,0x00000000,0x049DA356,
+	0x06C5F7B5,0x06DE624F,0x01A6AB3D,0x04250F6A,0x00000000,0x08066768,0x049DB235,0x02B1162F,
+	0x0260E160,0x00000000,0x032D3E51,0x00000000,0x00000000,0x03A618A1,0x019CC145,0x04F96DB2,
+	0x0334D845,0x00000000,0x050AA029,0x00000000,0x02E91D0A,0x089AA8BE,0x01DD544D,0x009E4AC2,
+	0x0284BC3B,0x07F6199E,0x009DA794,0x0472A2EA,0x042183B8,0x01D15361,0x00000000,0x0589761C,
+	0x034E692B,0x02C120FC,0x0331FC23,0x00000000,0x062211AB,0x00000000,0x00000000,0x00000000,
+	0x05725C93,0x0262BFFB,0x04468025,0x00000000,0x00000000,0x004834CB,0x00000000,0x00000000,
+	0x05563ABC,0x062DC084,0x041D3991,0x00795B87,0x00000000,0x0725F0A2,0x00000000,0x0175A4ED,
+	0x00002E18,0x00EE0FBC,0x00000000,0x0140C59D,0x089231BA,0x012CDA61,0x0701EE20,0x01F1047C,
+	0x002E142F,0x00000000,0x02C22750,0x00000000,0x071DFC9D,0x026639F7,0x06DAB36C,0x022E5B57,
+	0x0062BB26,0x01688BEE,0x00000000,0x024DC858,0x07F67952,0x0135FB5D,0x04B14A98,0x042A1BB4,
+	0x004D69C0,0x0635DB19,0x00000000,0x03DAB34B,0x01DA912B,0x0146D693,0x00B134A2,0x040EAA17,
+	0x00000000,0x054EB461,0x04A624E9,0x04524A53,0x00000000,0x00000000,0x0425DD6A,0x05EDE207,
+	0x001ABE27,0x0314D26F,0x00362CE6,0x02025D28,0x0502D73D,0x05859B12,0x04DA0F24,0x052AABF5,
+	0x02DA05AE,0x01714114,0x05FAB6DE,0x02EA1E7E,0x03168E99,0x0081440E,0x00AC9D8A,0x08529A83,
+	0x03352CA9,0x00D5169E,0x00000000,0x0062962F,0x0699ADCE,0x004DACAB,0x0532B5BB,0x04FE399A,
+	0x059A065E,0x06D9D63A,0x00000000,0x05395EB8,0x00000000,0x010DEC47,0x012532D8,0x0268C7E7,
+	0x07CE7A2C,0x026194D4,0x00A86C4A,0x00000000,0x00000000,0x02913911,0x00000000,0x00DDAE38,
+	0x0639D4C0,0x00000000,0x00000000,0x05F99A14,0x00000000,0x0330E269,0x015A3828,0x03A25B03,
+	0x03552D5A,0x07AE7983,0x06FA99CC,0x01312DFB,0x00000000,0x0164D448,0x05566E98,0x00000000,
+	0x0516BA14,0x022999DE,0x00000000,0x00000000,0x010488AA,0x008A1BBE,0x00000000,0x04F222CD,
+	0x00000000,0x05EAC89B,0x06FE6F8F,0x043DCF69,0x00000000,0x00000000,0x00000000,0x041D92D1,
+	0x0016ACD0,0x0282D26D,0x00B25CBF,0x03FA22B1,0x050D7700,0x01DCD7D8,0x011478BB,0x0044DF32,
+	0x00863181,0x02123D7E,0x01EA3ABE,0x0181B45F,0x07CA3555,0x00000000,0x00000000,0x00000000,
+	0x00000000,0x085ED55E,0x02217A96,0x06162653,0x00000000,0x048A2E48,0x043DC032,0x067DC423,
+	0x00000000,0x04920AD3,0x04226223,0x0112B7DA,0x07CA1A15,0x079635DD,0x054D78D9,0x00000000,
+	0x047E05F9,0x00000000,0x010D11B5,0x02D956AE,0x01786E3F,0x00000000,0x065EBF64,0x00000000,
+	0x00000000,0x00000000,0x028EAD53,0x0204F184,0x0591AD88,0x0872CE2D,0x015962FB,0x0601CFE5,
+	0x00054CF4,0x03766670,0x00049271,0x00D49956,0x00000000,0x0261D691,0x01ED05CD,0x03D932DA,
+	0x0446CAF9,0x01A69412,0x0479529D,0x00000000,0x082E96D0,0x0551CE16,0x02795593,0x0218B373,
+	0x00F9ED6C,0x06A60353,0x00000000,0x00000000,0x00000000,0x007900A9,0x00000000,0x05CDE3BA,
+	0x04B19F44,0x02797F93,0x0A26A9B6,0x06D63892,0x05EA2ADB,0x03E1F3E7,0x0395FA11,0x08AE66AD,
+	0x05459FAF,0x00000000,0x00000000,0x00000000,0x00000000,0x0289F8E6,0x0219B9CE,0x00000000,
+	0x030E9486,0x00000000,0x05D5A5E4,0x00000000,0x00000000,0x00F97FDF,0x089E3586,0x02662373,
+	0x029AD8BB,0x00000000,0x091AADCA,0x07AE3499,0x05AA89D0,0x020CF6AF,0x02F5996C,0x004D74BC,
+	0x00000000,0x05DEB0FC,0x08BABA37,0x021D9537,0x06FA50E9,0x0722A940,0x00000000,0x02F20BCE,
+	0x0170C27D,0x00000000,0x04FA4E04,0x01A292B9,0x00000000,0x00000000,0x00000000,0x06026236,
+	0x017DA646,0x0421493C,0x0048AACA,0x00000000,0x0409232C,0x00C49FFD,0x00000000,0x0552ABC5,
+	0x059E6F92,0x06FEC515,0x02209210,0x02552069,0x00000000,0x0094969A,0x0765EE8D,0x028AA971,
+	0x00000000,0x031590A8,0x00000000,0x01B9A526,0x03815C3C,0x00000000,0x0441ED58,0x000026A7,
+	0x04523FF5,0x045DDC36,0x00000000,0x01AD2D50,0x017C8747,0x00FA71D2,0x04A67AE9,0x0A429B43,
+	0x0198BDA9,0x065DBF51,0x0211980A,0x0ACAD24F,0x01E11B79,0x043ED033,0x0322CC73,0x01FCA90B,
+	0x0266A0F7,0x00000000,0x047983DF,0x078E55F6,0x0166BE27,0x00000000,0x01668FDE,0x077A1880,
+	0x00E8A3C4,0x027CF5ED,0x005D4CFA,0x00000000,0x05A2A8C3,0x03421CF7,0x06A5F49C,0x035230E4,
+	0x00000000,0x00000000,0x067ED5C7,0x009CC55B,0x02B65208,0x00000000,0x00A44318,0x00000000,
+	0x00000000,0x0A06D81C,0x00000000,0x00000000,0x0866C552,0x00000000,0x070E5937,0x092E6476,
+	0x000A4CD3,0x01E09219,0x0621D82A,0x0074DAC6,0x00CDA825,0x00405A83,0x02D1E689,0x00000000,
+	0x0682154F,0x0228AE4E,0x013E33A7,0x04EEC632,0x03093246,0x01E191DB,0x0098F7E1,0x058ECA97,
+	0x094ABE8A,0x00000000,0x00000000,0x041A71D2,0x035E6363,0x035104AD,0x00000000,0x043D6DB2,
+	0x044E2BBA,0x00015E65,0x00646323,0x00000000,0x00000000,0x0536B5E5,0x00000000,0x00000000,
+	0x02829C50,0x00000000,0x018641FA,0x0014B2DF,0x0000B5FD,0x04B1E3DB,0x082A30A9,0x021989EF,
+	0x0020AB7B,0x010203C4,0x0416C330,0x047A1D7D,0x00000000,0x00000000,0x028981B7,0x091E4FF2,
+	0x00000000,0x00000000,0x08A6CDBD,0x00000000,0x050214BD,0x00000000,0x02E5DE62,0x00000000,
+	0x078629E2,0x00000000,0x03A9B385,0x04C69831,0x05C6BD3C,0x03A516E8,0x00000000,0x00000000,
+	0x020E13AF,0x00000000,0x044DEAFF,0x02492E74,0x00ACFE88,0x00BAA906,0x00000000,0x00000000,
+	0x046E48B8,0x01BCCFCC,0x05A17EFE,0x001451AE,0x00000000,0x00845E58,0x0045B558,0x016E5322,
+	0x006D08D7,0x00EE752B,0x0124EC5C,0x02B9FEFD,0x02360A6F,0x00000000,0x00B509EE,0x023CE463,
+}
+
+var grid9DecodeDisp = [grid9Buckets]uint16{
+	0,4,3,1,6,1,3,0,1,2,6,3,4,2,0,0,
+	1,1,0,6,14,5,0,7,1,2,0,0,1,8,2,13,
+	8,0,0,13,3,1,7,0,5,2,0,0,9,6,0,1,
+	0,2,0,0,2,0,1,7,0,12,1,0,3,2,2,2,
+	5,5,2,0,15,6,0,4,11,2,0,0,0,2,3,1,
+	0,0,0,5,0,1,9,12,5,6,1,1,3,4,1,2,
+	0,0,6,0,1,4,10,1,1,0,11,2,0,8,2,0,
+	2,6,1,7,4,3,0,0,1,3,10,5,2,3,5,6,
+	2,2,12,9,4,2,0,1,3,0,0,0,0,5,2,1,
+	0,7,21,2,1,7,0,4,0,2,5,4,6,0,0,0,
+	1,2,7,3,4,1,10,4,0,1,2,0,0,0,2,6,
+	14,0,0,0,2,1,13,6,1,0,7,2,10,2,0,0,
+	0,24,0,2,11,1,0,2,1,5,1,0,3,0,3,24,
+	11,9,5,3,4,0,0,0,0,0,2,3,23,4,0,0,
+	1,3,0,7,0,0,1,8,0,8,1,2,6,0,0,3,
+	9,0,0,0,3,5,1,0,1,2,0,1,8,5,9,0,
+	2,0,1,1,0,15,7,0,1,5,4,1,0,5,5,1,
+	0,28,0,3,3,1,1,3,6,2,14,0,2,0,2,5,
+	7,12,0,2,0,6,3,2,2,0,1,3,7,1,0,0,
+	4,0,0,0,0,0,0,3,0,9,5,0,4,0,25,26,
+	0,4,2,6,5,3,1,0,0,10,5,9,3,0,0,4,
+	9,0,8,2,0,13,1,12,16,1,8,0,6,3,0,0,
+	8,2,0,0,5,3,3,0,8,3,0,7,1,1,1,3,
+	10,7,0,3,0,0,1,5,0,0,0,5,0,22,0,0,
+	2,0,11,2,3,7,2,1,1,2,2,1,0,0,0,0,
+	0,1,5,0,3,4,3,1,3,0,0,0,1,0,6,2,
+	0,13,3,10,2,19,5,3,11,0,2,6,3,0,1,3,
+	3,0,0,3,1,8,6,10,1,9,1,0,7,0,0,2,
+	6,2,2,0,0,1,0,12,2,8,2,8,0,0,0,3,
+	0,0,2,0,9,3,10,4,2,0,5,1,1,3,0,3,
+	0,0,1,0,3,6,6,3,15,0,0,1,5,4,7,7,
+	5,0,8,6,1,5,1,2,1,0,7,0,4,5,0,9,
+	0,3,6,0,2,0,3,0,0,9,2,0,1,10,0,3,
+	1,9,12,1,1,1,1,0,4,0,2,4,0,1,3,0,
+	0,1,3,8,1,8,1,0,8,0,21,0,6,0,0,2,
+	0,1,2,1,4,2,1,0,4,1,15,2,2,0,3,7,
+	2,6,0,7,8,0,10,1,0,2,4,3,5,2,3,0,
+	5,6,1,0,0,0,4,1,3,8,6,2,5,4,12,0,
+	0,31,9,1,5,4,0,24,0,6,0,1,0,6,6,3,
+	0,4,0,4,4,0,2,0,3,8,4,7,2,3,0,0,
+	10,1,3,1,0,0,0,6,5,2,11,3,1,3,1,0,
+	6,5,0,0,0,1,0,6,0,2,4,0,1,3,6,1,
+	0,0,11,0,1,8,3,0,1,28,0,0,1,1,5,7,
+	3,3,1,0,4,9,2,3,0,0,3,3,0,4,1,6,
+	7,5,0,8,2,4,0,0,5,0,5,3,2,0,0,0,
+	2,12,4,0,6,8,2,1,8,2,3,0,0,3,3,0,
+	14,4,0,4,0,0,1,1,1,2,0,0,1,1,12,12,
+	8,1,9,0,1,10,14,14,1,4,5,3,10,0,1,16,
+	6,0,2,12,0,5,12,0,10,23,3,4,0,4,1,20,
+	0,15,4,0,1,3,3,3,16,4,10,11,2,8,0,0,
+	3,4,1,6,3,8,2,0,0,3,4,0,5,30,8,11,
+	2,0,1,3,11,0,5,1,0,0,9,0,0,3,9,1,
+	0,9,14,17,0,21,1,18,1,2,1,0,1,0,1,4,
+	0,0,22,2,3,2,5,1,5,3,1,1,4,27,0,0,
+	0,8,3,8,2,3,1,0,1,4,18,21,3,10,2,2,
+	0,7,0,0,3,4,9,4,3,12,0,2,2,1,8,6,
+	0,3,5,13,0,1,4,2,0,0,1,4,0,11,2,24,
+	2,5,9,0,0,5,4,0,3,8,2,0,10,2,3,2,
+	0,2,15,1,0,0,1,16,0,8,0,7,1,1,0,1,
+	0,2,3,0,2,7,3,1,0,14,0,0,1,7,0,4,
+	1,2,2,0,11,2,2,2,1,0,6,48,0,2,11,0,
+	16,4,0,4,1,3,1,1,1,1,8,6,7,19,21,4,
+	0,5,0,0,4,0,0,30,0,4,13,3,3,0,3,0,
+	1,6,0,6,1,0,4,14,0,2,0,3,14,0,10,2,
+	1,0,11,0,1,1,9,6,4,1,2,0,2,0,0,16,
+	2,0,2,2,10,9,7,3,2,4,3,1,0,9,2,0,
+	0,7,0,0,20,4,2,0,1,2,4,12,0,2,11,5,
+	1,0,14,6,2,0,1,0,6,29,16,2,1,0,4,0,
+	1,0,0,0,0,22,0,3,10,2,21,4,16,15,9,1,
+	2,0,3,1,7,11,3,35,9,1,0,10,7,7,9,6,
+	1,37,0,8,1,25,4,12,3,2,0,0,0,0,6,17,
+	5,2,0,7,9,2,14,3,7,7,4,1,6,13,10,7,
+	5,1,0,11,12,4,0,1,5,7,4,2,8,1,1,1,
+	3,3,6,0,3,1,0,3,0,2,2,5,14,1,0,10,
+	4,3,5,9,0,0,0,5,0,0,1,5,0,0,12,4,
+	2,1,0,1,3,1,19,14,6,2,0,26,17,3,33,5,
+	13,1,3,1,7,14,5,0,11,17,2,6,15,9,36,10,
+	2,1,8,0,4,0,5,2,0,5,6,1,2,4,0,2,
+	3,0,15,0,3,13,22,3,1,7,9,3,8,2,1,0,
+	1,2,1,1,14,0,0,21,8,1,3,0,0,4,5,0,
+	2,2,0,9,0,0,2,6,13,10,19,3,0,5,5,11,
+	2,6,2,9,0,48,1,20,0,0,0,4,5,1,2,0,
+	15,3,0,6,7,8,0,8,10,1,6,18,19,7,1,13,
+	2,5,12,18,0,1,2,1,1,2,12,0,13,13,11,2,
+	8,3,15,21,1,1,9,5,0,4,0,0,1,0,3,11,
+	12,2,20,12,16,1,0,5,2,12,8,3,1,0,4,1,
+	2,4,1,21,5,5,0,7,13,5,12,4,2,8,1,7,
+	5,2,1,6,11,4,1,1,0,1,4,8,1,1,28,6,
+	3,19,2,3,2,1,4,1,0,4,3,0,0,0,0,13,
+	0,2,0,0,4,0,8,0,0,1,0,5,10,2,10,5,
+	3,10,11,1,4,6,0,1,24,3,0,2,5,4,0,4,
+	2,4,12,1,3,6,2,1,1,4,1,1,0,3,0,22,
+	1,7,12,3,0,0,3,8,0,0,9,1,4,11,0,6,
+	37,0,21,3,0,0,2,2,0,8,4,19,3,2,7,3,
+	4,3,6,1,1,0,4,0,1,7,11,1,1,6,6,12,
+	2,40,1,6,0,4,0,2,6,0,21,6,6,0,3,0,
+	8,5,12,0,1,6,10,0,2,2,29,5,3,3,3,17,
+	17,12,13,4,7,11,0,1,0,8,1,15,8,6,4,28,
+	2,6,3,1,2,20,0,6,13,2,55,9,0,1,1,3,
+	1,1,0,7,5,0,1,0,18,4,16,0,11,5,0,4,
+	2,10,1,0,6,7,20,1,18,3,6,21,12,3,22,0,
+	1,16,5,9,1,19,20,11,0,24,0,2,0,1,0,1,
+	0,2,3,25,2,7,19,27,12,0,5,8,29,0,1,0,
+	6,3,1,10,9,0,10,2,2,7,2,0,13,0,7,16,
+	19,3,0,21,21,0,4,1,0,14,11,13,2,13,5,0,
+	2,1,2,0,16,1,1,13,8,2,0,0,1,3,2,0,
+	6,7,1,8,10,20,15,45,3,31,0,0,7,7,4,10,
+	8,0,2,7,1,3,0,4,2,21,2,14,1,3,0,4,
+	6,0,2,0,11,0,5,2,6,5,12,5,0,0,0,4,
+	1,6,48,1,8,0,0,1,2,17,25,0,8,18,3,5,
+	37,0,0,6,46,25,7,0,1,3,24,8,31,0,6,1,
+	36,1,3,3,8,0,0,33,8,2,4,1,8,30,2,16,
+	50,15,0,6,0,19,26,3,14,0,7,27,1,0,13,7,
+	7,4,17,0,0,0,13,6,10,0,16,3,0,7,35,13,
+	0,7,7,4,0,2,0,0,11,1,0,16,31,4,7,13,
+	7,51,0,28,8,3,10,0,12,6,6,14,1,0,5,1,
+	0,0,12,8,8,12,0,40,30,5,5,6,1,24,1,3,
+	0,10,0,1,9,3,1,6,0,0,6,2,1,5,0,2,
+	3,0,0,21,0,1,1,10,4,17,12,3,25,0,6,5,
+	17,1,1,7,3,1,4,3,5,1,2,0,29,1,2,3,
+	32,23,3,10,7,2,0,6,24,0,9,7,0,15,2,39,
+	0,1,2,1,1,0,8,0,3,36,3,2,0,18,0,0,
+	0,3,3,4,2,21,5,39,8,6,2,0,0,4,1,21,
+	1,19,0,7,7,6,19,3,5,0,0,25,13,3,8,27,
+	5,3,9,0,4,0,2,5,6,11,21,9,0,2,5,0,
+	1,0,13,0,0,0,10,0,5,12,0,8,11,12,4,2,
+	0,22,3,9,6,8,0,21,0,0,14,5,4,1,7,7,
+	11,3,3,0,3,0,4,6,1,4,1,5,2,13,1,5,
+}
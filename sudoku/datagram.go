@@ -0,0 +1,125 @@
+// 数据报模式 - 面向 UDP 等不保证顺序、可能丢包的传输
+// 默认的记录载荷携带完整 nonce ([nonce (12/24)][密文][tag])，解码状态 (未凑满的 hint 组、伪装模板游标) 跨调用延续，
+// 要求记录按序、完整到达；数据报模式下:
+//   载荷改为 [序号 (4, 大端序)][密文][tag]，序号为 nonce counter 的低 32 位，接收端按已接受的最大 counter 还原高位
+//   (取与之最接近的值，同 QUIC 包序号)，nonce 由本端 nonce salt 与还原的 counter 重新构造，双方 salt 须一致
+//   乱序窗口可配置 (1-64 条记录): 比已接受的最大 counter 落后 window 条及以上的记录以 errReplay 拒绝，窗口内的乱序记录正常解密
+//   每次 unmask 类调用的输入独立解码、每条记录的 mask 输出从模板起点开始，一个报文丢失不影响后续报文
+// 接收方每次 unmaskFrame 只应传入一个报文；开启流量整形时块与记录不对齐，数据报模式应配合 setMaxFrameSize 而非整形
+// 双方须在收发数据前以相同的设置开启
+
+package sudoku
+
+import "encoding/binary"
+
+const datagramSeqSize = 4
+
+var sessionDatagramWindow [maxSessions]uint8 // 乱序窗口 (记录数)，0 表示未开启
+
+// setDatagramMode - 开启数据报模式，window 为乱序窗口 (1-64 条记录)，0 关闭
+// 返回值: 0 成功, -1 session 无效, -2 window 超过 64, -3 CipherNone 没有序号可用
+//
+//export setDatagramMode
+func setDatagramMode(id int32, window uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	session := getSession(id)
+	if window == 0 {
+		session.flags &^= sessionFlagDatagram
+		sessionDatagramWindow[id] = 0
+		return 0
+	}
+	if window > replayWindowSize {
+		return -2
+	}
+	if session.cipherType == CipherNone {
+		return -3
+	}
+	session.flags |= sessionFlagDatagram
+	sessionDatagramWindow[id] = uint8(window)
+	return 0
+}
+
+// datagramCounter - 由截断的序号还原完整 counter: 取与 rxCounter+1 最接近、低 32 位为 seq 的值
+func datagramCounter(rxCounter uint64, seq uint32) uint64 {
+	const span = uint64(1) << 32
+	expected := rxCounter + 1
+	ctr := expected&^(span-1) | uint64(seq)
+	if ctr+span/2 <= expected && ctr < ^uint64(0)-span {
+		return ctr + span
+	}
+	if ctr > expected+span/2 && ctr >= span {
+		return ctr - span
+	}
+	return ctr
+}
+
+// datagramNonce - 以 session 的 nonce salt 与 counter 构造 nonce (布局同 incNonce)
+func datagramNonce(session *SudokuInstance, ctr uint64, nonce []byte) {
+	copy(nonce[0:4], session.sudokuState[stateNonceSalt:stateNonceSalt+4])
+	c := len(nonce) - 8
+	for i := 4; i < c; i++ {
+		nonce[i] = 0
+	}
+	binary.BigEndian.PutUint64(nonce[c:], ctr)
+}
+
+// recordSeal - 记录层的 AEAD 加密: 数据报模式下载荷以 4 字节序号代替完整 nonce，否则同 aeadSeal
+// (nonce 由对端以序号还原，XChaCha20 不填充随机部分)
+// 返回值: 载荷长度，0 表示失败
+func recordSeal(session *SudokuInstance, plainPtr uint32, plainLen uint32, outPtr uint32) uint32 {
+	if session.flags&sessionFlagDatagram == 0 {
+		return aeadSeal(session, plainPtr, plainLen, outPtr, nil)
+	}
+	spec := cipherLookup(session.cipherType)
+	nonceLen := uint32(spec.nonceLen)
+	var nonce [cipherMaxNonce]byte
+	incNonce(session, nonce[:nonceLen])
+	copy(arena[outPtr:outPtr+datagramSeqSize], nonce[nonceLen-datagramSeqSize:nonceLen])
+	plaintext := arena[plainPtr : plainPtr+plainLen]
+	out := arena[outPtr+datagramSeqSize : outPtr+datagramSeqSize+plainLen+uint32(spec.tagLen)]
+	n := spec.seal(&session.key, nonce[:nonceLen], plaintext, int(plainLen), nil, out)
+	if n <= 0 {
+		return 0
+	}
+	sealedLen := uint32(n) + datagramSeqSize
+	tagLen := uint32(spec.tagLen)
+	return sealedLen + commitAppend(session, nonce[:nonceLen], outPtr+sealedLen-tagLen, tagLen, nil)
+}
+
+// recordOpen - 记录层的 AEAD 解密，与 recordSeal 对应；数据报模式下 ctr 为 replayCounter 还原的 counter
+// 返回值: 明文长度，0 表示认证失败
+func recordOpen(session *SudokuInstance, framePtr uint32, frameLen uint32, outPtr uint32, ctr uint64) uint32 {
+	if session.flags&sessionFlagDatagram == 0 {
+		return aeadOpen(session, framePtr, frameLen, outPtr, nil)
+	}
+	tagLen := uint32(session.tagSize)
+	commitLen := commitOverhead(session)
+	if frameLen < datagramSeqSize+tagLen+commitLen {
+		return 0
+	}
+	frameLen -= commitLen
+	ctLen := frameLen - datagramSeqSize
+	ciphertextAndTag := arena[framePtr+datagramSeqSize : framePtr+frameLen]
+	out := arena[outPtr : outPtr+ctLen]
+	spec := cipherLookup(session.cipherType)
+	var nonce [cipherMaxNonce]byte
+	datagramNonce(session, ctr, nonce[:spec.nonceLen])
+	if !commitCheck(session, nonce[:spec.nonceLen], framePtr+frameLen-tagLen, tagLen, nil) {
+		return 0
+	}
+	n := spec.open(sessionRxKeyOf(session), nonce[:spec.nonceLen], ciphertextAndTag, int(ctLen), nil, out)
+	if n < 0 {
+		return 0
+	}
+	return uint32(n)
+}
+
+// datagramTooOld - 数据报模式下 counter 已落在乱序窗口之外 (比已接受的最大 counter 落后 window 条及以上)
+func datagramTooOld(id int32, session *SudokuInstance, ctr uint64) bool {
+	if session.flags&sessionFlagDatagram == 0 {
+		return false
+	}
+	return ctr < session.rxCounter && session.rxCounter-ctr >= uint64(sessionDatagramWindow[id])
+}
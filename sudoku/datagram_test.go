@@ -0,0 +1,108 @@
+package sudoku
+
+import "testing"
+
+// 截断序号还原: 取与 rxCounter+1 最接近的 counter，跨越 2^32 边界时正确进位/借位
+func TestDatagramCounter(t *testing.T) {
+	tests := []struct {
+		rxCounter uint64
+		seq       uint32
+		want      uint64
+	}{
+		{0, 1, 1},
+		{5, 3, 3},
+		{5, 10, 10},
+		{0, 0xFFFFFFFF, 0xFFFFFFFF},
+		{0xFFFFFFFF, 0, 0x100000000},
+		{0xFFFFFFF0, 5, 0x100000005},
+		{0x100000002, 0xFFFFFFFE, 0xFFFFFFFE},
+		{0x500000000, 7, 0x500000007},
+	}
+	for _, tt := range tests {
+		if got := datagramCounter(tt.rxCounter, tt.seq); got != tt.want {
+			t.Fatalf("datagramCounter(%#x, %#x) = %#x, want %#x", tt.rxCounter, tt.seq, got, tt.want)
+		}
+	}
+}
+
+// 数据报模式: 载荷以 4 字节序号代替 nonce，乱序窗口内的报文照常解密，落后 window 条及以上或重复的报文被拒绝
+func TestDatagramReorder(t *testing.T) {
+	tests := []struct {
+		name   string
+		window uint32
+		order  []int // 依次送入的报文 counter
+		want   []int32
+	}{
+		{"reversed", 8, []int{5, 4, 3, 2, 1}, []int32{errOK, errOK, errOK, errOK, errOK}},
+		{"loss", 4, []int{1, 3, 6}, []int32{errOK, errOK, errOK}},
+		{"inside window", 4, []int{5, 2}, []int32{errOK, errOK}},
+		{"window edge", 4, []int{5, 1}, []int32{errOK, errReplay}},
+		{"duplicate", 4, []int{3, 3}, []int32{errOK, errReplay}},
+		{"window 1", 1, []int{2, 1, 3}, []int32{errOK, errReplay, errOK}},
+		{"max window", replayWindowSize, []int{65, 2, 1}, []int32{errOK, errOK, errReplay}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, rx := testPair(t, CipherChaCha20Poly)
+			for _, id := range []int32{tx, rx} {
+				if r := setDatagramMode(id, tt.window); r != 0 {
+					t.Fatalf("setDatagramMode: %d", r)
+				}
+			}
+			var packets [][]byte
+			for i := 1; i <= 65; i++ {
+				p, st := testCall(tx, []byte{byte(i)}, maskFrame)
+				if st != errOK {
+					t.Fatalf("maskFrame: %d", st)
+				}
+				packets = append(packets, p)
+			}
+			for i, c := range tt.order {
+				got, st := testCall(rx, packets[c-1], unmaskFrame)
+				if st == errOK {
+					st = getOpenStatus(rx)
+				}
+				if st != tt.want[i] {
+					t.Fatalf("packet %d (step %d): status %d, want %d", c, i, st, tt.want[i])
+				}
+				if st == errOK && (len(got) != 1 || got[0] != byte(c)) {
+					t.Fatalf("packet %d: plaintext %x", c, got)
+				}
+			}
+		})
+	}
+}
+
+// 数据报模式的载荷长度与 setDatagramMode 的参数检查
+func TestDatagramMode(t *testing.T) {
+	for _, cipherType := range []uint8{CipherChaCha20Poly, CipherAES128GCM, CipherXChaCha20Poly} {
+		id := testSession(t, cipherType, RoleClient)
+		setDatagramMode(id, 16)
+		plain := []byte("payload")
+		if rec := testRecord(t, id, plain); len(rec) != frameHeaderSize+datagramSeqSize+len(plain)+16 {
+			t.Fatalf("cipher %d: datagram record is %d bytes", cipherType, len(rec))
+		}
+	}
+
+	id := testSession(t, CipherChaCha20Poly, RoleClient)
+	none := testSession(t, CipherNone, RoleShared)
+	tests := []struct {
+		name   string
+		id     int32
+		window uint32
+		want   int32
+	}{
+		{"bad session", -1, 4, -1},
+		{"window too large", id, replayWindowSize + 1, -2},
+		{"cipher none", none, 4, -3},
+		{"disable", id, 0, 0},
+	}
+	for _, tt := range tests {
+		if r := setDatagramMode(tt.id, tt.window); r != tt.want {
+			t.Fatalf("setDatagramMode(%s) = %d, want %d", tt.name, r, tt.want)
+		}
+	}
+	if getSession(id).flags&sessionFlagDatagram != 0 || sessionDatagramWindow[id] != 0 {
+		t.Fatal("datagram mode still enabled after window 0")
+	}
+}
@@ -0,0 +1,142 @@
+// 加密 DRBG - 以 ChaCha8 keystream 代替 mask 的 32 位 LCG (padding 插入、hint 组与排列的选择)
+// LCG 的输出即其完整状态，观察到少量 padding 决策后即可预测之后的全部选择；
+// 开启 SessionOptDRBG 后每个随机字取自 ChaCha8(K, 块号)，K = HKDF-SHA256(发送方向密钥, "sudoku drbg")，
+// 已消费的字数存放于 sudokuState，随快照与 session 导出延续；密钥轮换后自动按新密钥重新派生
+// 随机源只影响发送方向的 mask 输出，接收端解码与之无关，因此两端无需一致；未开启时输出与 LCG 逐字节相同
+// 流量整形的块长/延迟 (shaping.go) 与 codec 表的种子展开仍使用 LCG
+
+package sudoku
+
+import "encoding/binary"
+
+const stateDRBGWords = 50 // [50:58] DRBG 已消费的随机字数 (大端序)
+
+var drbgLabel = []byte("sudoku drbg")
+
+// drbgCache - 当前 session 的 ChaCha8 密钥与最近生成的块 (同一时刻只有一个 session 在 mask，全模块共用一份)
+type drbgCache struct {
+	owner  int32    // session id + 1，0 表示空
+	srcKey [32]byte // 派生 key 时的 session.key，不一致说明已轮换密钥
+	key    [8]uint32
+	block  uint64 // words 对应的块号
+	filled bool
+	words  [16]uint32
+}
+
+var drbg drbgCache
+
+// maskRng - mask 的随机源: x 为当前随机字，drbg 为 nil 时按 LCG 推进
+// draws/log 为本次载入以来的消耗计数与消耗记录的写入位置 (见 rngdraws.go)
+type maskRng struct {
+	x     uint32
+	words uint64
+	drbg  *drbgCache
+	id    int32
+	draws uint32
+	log   uint32 // 下一条消耗记录的 arena 地址，0 表示不记录
+	end   uint32
+}
+
+// maskRngLoad - 从 sudokuState 读取随机源状态
+func maskRngLoad(id int32) maskRng {
+	session := getSession(id)
+	state := &session.sudokuState
+	r := maskRng{x: binary.BigEndian.Uint32(state[stateRngState : stateRngState+4]), id: id}
+	rngLogStart(&r)
+	if session.flags&sessionFlagDRBG != 0 {
+		r.words = binary.BigEndian.Uint64(state[stateDRBGWords : stateDRBGWords+8])
+		r.drbg = drbgFor(id, session)
+	}
+	return r
+}
+
+// maskRngStore - 写回随机源状态，计入已提交的消耗数
+func maskRngStore(state *[64]byte, r *maskRng) {
+	rngDrawsCommit(r)
+	binary.BigEndian.PutUint32(state[stateRngState:stateRngState+4], r.x)
+	if r.drbg != nil {
+		binary.BigEndian.PutUint64(state[stateDRBGWords:stateDRBGWords+8], r.words)
+	}
+}
+
+// rngAdvance - 推进到下一个随机字
+func rngAdvance(r *maskRng) {
+	rngLogDraw(r)
+	if r.drbg == nil {
+		r.x = lcgNext(r.x)
+		return
+	}
+	d := r.drbg
+	if blk := r.words >> 4; !d.filled || d.block != blk {
+		drbgBlock(d, blk)
+	}
+	r.x = d.words[r.words&15]
+	r.words++
+}
+
+// drbgFor - 取得 session 的 DRBG 缓存，换 session 或密钥轮换后重新派生 key
+func drbgFor(id int32, session *SudokuInstance) *drbgCache {
+	if drbg.owner == id+1 && drbg.srcKey == session.key {
+		return &drbg
+	}
+	var prk [sha256Size]byte
+	var k [32]byte
+	hkdfExtract(&prk, drbgLabel, session.key[:])
+	hkdfExpand(&prk, nil, k[:])
+	for i := 0; i < 8; i++ {
+		drbg.key[i] = binary.LittleEndian.Uint32(k[i*4 : i*4+4])
+	}
+	for i := range prk {
+		prk[i] = 0
+	}
+	for i := range k {
+		k[i] = 0
+	}
+	drbg.owner = id + 1
+	drbg.srcKey = session.key
+	drbg.filled = false
+	return &drbg
+}
+
+// drbgStart - 开启 DRBG 时丢弃 LCG 留下的当前字，使首个 padding 决策也取自 DRBG
+func drbgStart(id int32) {
+	r := maskRngLoad(id)
+	rngAdvance(&r)
+	maskRngStore(&getSession(id).sudokuState, &r)
+}
+
+// drbgRelease - closeSession 时清除该 session 的 DRBG 密钥与缓存块
+func drbgRelease(id int32) {
+	if drbg.owner == id+1 {
+		drbg = drbgCache{}
+	}
+}
+
+// drbgBlock - 生成第 blk 块 ChaCha8 keystream (块号占 counter 与首个 nonce 字，其余 nonce 为 0)
+func drbgBlock(d *drbgCache, blk uint64) {
+	s0, s1, s2, s3 := chachaConstants[0], chachaConstants[1], chachaConstants[2], chachaConstants[3]
+	s4, s5, s6, s7 := d.key[0], d.key[1], d.key[2], d.key[3]
+	s8, s9, s10, s11 := d.key[4], d.key[5], d.key[6], d.key[7]
+	s12, s13, s14, s15 := uint32(blk), uint32(blk>>32), uint32(0), uint32(0)
+
+	// 8 轮 (4 个双轮)
+	for i := 0; i < 4; i++ {
+		s0, s4, s8, s12 = chachaQuarterRound(s0, s4, s8, s12)
+		s1, s5, s9, s13 = chachaQuarterRound(s1, s5, s9, s13)
+		s2, s6, s10, s14 = chachaQuarterRound(s2, s6, s10, s14)
+		s3, s7, s11, s15 = chachaQuarterRound(s3, s7, s11, s15)
+		s0, s5, s10, s15 = chachaQuarterRound(s0, s5, s10, s15)
+		s1, s6, s11, s12 = chachaQuarterRound(s1, s6, s11, s12)
+		s2, s7, s8, s13 = chachaQuarterRound(s2, s7, s8, s13)
+		s3, s4, s9, s14 = chachaQuarterRound(s3, s4, s9, s14)
+	}
+
+	d.words = [16]uint32{
+		s0 + chachaConstants[0], s1 + chachaConstants[1], s2 + chachaConstants[2], s3 + chachaConstants[3],
+		s4 + d.key[0], s5 + d.key[1], s6 + d.key[2], s7 + d.key[3],
+		s8 + d.key[4], s9 + d.key[5], s10 + d.key[6], s11 + d.key[7],
+		s12 + uint32(blk), s13 + uint32(blk>>32), s14, s15,
+	}
+	d.block = blk
+	d.filled = true
+}
@@ -0,0 +1,167 @@
+// 0-RTT 早期数据 - client 在首轮握手消息之后、收到 server 消息之前即可发送应用数据
+// 早期密钥 = HKDF-Expand(HKDF-Extract(SHA-256(client 握手消息), client_write 预共享密钥), "sudoku early data", keyLen)
+// 只依赖预共享密钥与 client 消息，不具备前向安全，且可被整体重放: 模块不维护重放缓存，
+// 由 server 宿主以 client 握手消息 (含混淆时间戳与临时公钥) 查询自己的缓存，确认是首次出现后
+// 以 SessionOptEarlyData = 1 通知模块，随后的 handshakeConsume 才会接受早期数据；未设置时早期数据一律拒绝，
+// client 收到宿主层的拒绝通知后应在握手完成后以传输密钥重发
+// 早期记录格式同 aeadEncrypt: [nonce (nonceSize，counter 大端序位于末 8 字节，其余为 0)][密文][tag]，
+// counter 从 1 开始，server 只接受递增的 counter；每次握手的早期数据合计不超过 earlyDataMax 字节明文
+
+package sudoku
+
+import "encoding/binary"
+
+const earlyDataMax = 16384
+
+var earlyDataLabel = []byte("sudoku early data")
+
+// 早期数据阶段
+const (
+	earlyNone   = 0
+	earlyClient = 1 // client: 已派生早期密钥，握手完成前可继续发送
+	earlyServer = 2 // server: 本次握手接受早期数据
+)
+
+type earlyDataState struct {
+	key     [32]byte
+	counter uint64 // client: 上一个发送的 counter；server: 已接受的最大 counter
+	bytes   uint32 // 已发送/接受的明文字节数
+	phase   uint8
+}
+
+var sessionEarly [maxSessions]earlyDataState
+
+// deriveEarlyKey - 由 client 握手消息与 client_write 预共享密钥派生早期密钥
+func deriveEarlyKey(clientMsg []byte, clientKey *[32]byte, keyLen uint32, out *[32]byte) {
+	var ctx sha256Context
+	sha256Init(&ctx)
+	sha256Update(&ctx, clientMsg, len(clientMsg))
+	var digest [sha256Size]byte
+	sha256Finalize(&ctx, &digest)
+
+	var prk [sha256Size]byte
+	hkdfExtract(&prk, digest[:], clientKey[:keyLen])
+	*out = [32]byte{}
+	hkdfExpand(&prk, earlyDataLabel, out[:keyLen])
+	prk = [sha256Size]byte{}
+}
+
+// earlyOnConsume - handshakeConsume 切换传输密钥之前调用: client 结束早期数据，
+// server 按 SessionOptEarlyData 决定是否接受 (该选项只对本次握手有效，随即清除)
+func earlyOnConsume(id int32, session *SudokuInstance, peerMsg []byte) {
+	ed := &sessionEarly[id]
+	*ed = earlyDataState{}
+	if session.sudokuState[stateRole] != RoleServer || session.flags&sessionFlagEarlyData == 0 {
+		return
+	}
+	session.flags &^= sessionFlagEarlyData
+	if session.cipherType == CipherNone {
+		return
+	}
+	deriveEarlyKey(peerMsg, sessionRxKeyOf(session), cipherKeyLen(session.cipherType), &ed.key)
+	ed.phase = earlyServer
+}
+
+// earlyNonce - 构造早期记录的 nonce: 末 8 字节为 counter，其余为 0
+func earlyNonce(counter uint64, nonce []byte) {
+	c := len(nonce) - 8
+	for i := 0; i < c; i++ {
+		nonce[i] = 0
+	}
+	binary.BigEndian.PutUint64(nonce[c:], counter)
+}
+
+// sealEarlyData - client 在 handshakeStart 之后、handshakeConsume 之前以早期密钥加密 arena[inPtr:inPtr+inLen]，
+// 输出写入 arena[outPtr:]，长度为 nonceSize + inLen + tagSize
+// 返回值: 输出长度
+//
+//	-1 session 无效
+//	-2 输入为空或越界
+//	-3 不是 RoleClient，或握手不处于已发送本端消息、等待对端的阶段
+//	-4 CipherNone 没有早期密钥
+//	-5 超出本次握手的早期数据上限 (earlyDataMax)
+//
+//export sealEarlyData
+func sealEarlyData(id int32, inPtr uint32, inLen uint32, outPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	session := getSession(id)
+	spec := cipherLookup(session.cipherType)
+	nonceLen := uint32(spec.nonceLen)
+	if inLen == 0 || !inArenaRange(inPtr, inLen) || !inArenaRange(outPtr, nonceLen+inLen+uint32(spec.tagLen)) {
+		return -2
+	}
+	hs := &sessionHandshake[id]
+	if session.sudokuState[stateRole] != RoleClient || hs.phase != handshakeStarted {
+		return -3
+	}
+	if session.cipherType == CipherNone {
+		return -4
+	}
+	ed := &sessionEarly[id]
+	if inLen > earlyDataMax-ed.bytes {
+		return -5
+	}
+	if ed.phase != earlyClient {
+		deriveEarlyKey(hs.msg[:], &session.key, cipherKeyLen(session.cipherType), &ed.key)
+		ed.phase = earlyClient
+	}
+
+	ed.counter++
+	var nonce [cipherMaxNonce]byte
+	earlyNonce(ed.counter, nonce[:nonceLen])
+	out := arena[outPtr+nonceLen : outPtr+nonceLen+inLen+uint32(spec.tagLen)]
+	n := spec.seal(&ed.key, nonce[:nonceLen], arena[inPtr:inPtr+inLen], int(inLen), nil, out)
+	if n <= 0 {
+		return -4
+	}
+	copy(arena[outPtr:outPtr+nonceLen], nonce[:nonceLen])
+	ed.bytes += inLen
+	return int32(uint32(n) + nonceLen)
+}
+
+// openEarlyData - server 在 handshakeConsume 之后解密 client 的早期记录，明文写入 arena[outPtr:]
+// 返回值: 明文长度
+//
+//	-1 session 无效
+//	-2 输入越界或长度不足
+//	-3 本次握手未接受早期数据 (未设置 SessionOptEarlyData、不是 RoleServer 或握手未完成)
+//	-5 超出本次握手的早期数据上限 (earlyDataMax)
+//	-6 认证失败，或 counter 未递增 (重复或乱序的早期记录)
+//
+//export openEarlyData
+func openEarlyData(id int32, inPtr uint32, inLen uint32, outPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	session := getSession(id)
+	ed := &sessionEarly[id]
+	if ed.phase != earlyServer || sessionHandshake[id].phase != handshakeDone {
+		return -3
+	}
+	spec := cipherLookup(session.cipherType)
+	nonceLen := uint32(spec.nonceLen)
+	if inLen <= nonceLen+uint32(spec.tagLen) || !inArenaRange(inPtr, inLen) {
+		return -2
+	}
+	plainLen := inLen - nonceLen - uint32(spec.tagLen)
+	if !inArenaRange(outPtr, plainLen) {
+		return -2
+	}
+	if plainLen > earlyDataMax-ed.bytes {
+		return -5
+	}
+	nonce := arena[inPtr : inPtr+nonceLen]
+	counter := binary.BigEndian.Uint64(nonce[nonceLen-8:])
+	if counter <= ed.counter {
+		return -6
+	}
+	n := spec.open(&ed.key, nonce, arena[inPtr+nonceLen:inPtr+inLen], int(inLen-nonceLen), nil, arena[outPtr:outPtr+plainLen])
+	if n < 0 {
+		return -6
+	}
+	ed.counter = counter
+	ed.bytes += plainLen
+	return int32(n)
+}
@@ -0,0 +1,68 @@
+// 宿主熵源 - 模块本身不含随机源，密钥、nonce salt 等默认都由宿主传入或从密钥派生；
+// 以下导出改为经宿主导入 env.getRandom (Workers / 浏览器中即 crypto.getRandomValues) 取得随机数，
+// 宿主不必先生成随机数再写入 arena，临时私钥也不会出现在宿主可见的内存中
+
+package sudoku
+
+import "encoding/binary"
+
+// handshakeStartRandom - 同 handshakeStart，但临时私钥由 getRandom 生成，不经过 arena
+// 返回值: 同 handshakeStart (-2 仅表示输出越界)
+//
+//export handshakeStartRandom
+func handshakeStartRandom(id int32, outPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	var priv [x25519Size]byte
+	fillRandom(priv[:])
+	r := handshakeBegin(id, &priv, outPtr)
+	priv = [x25519Size]byte{}
+	return r
+}
+
+// randomizeNonceSalt - 以随机数设置 session 的 nonce salt，并把新 salt 写入 arena[outPtr:outPtr+4]
+// 完整 nonce 随记录发送时对端无需知道 salt；数据报模式下需把输出的 salt 告知对端 (setNonceSalt)
+// 调用时机同 setNonceSalt: 应在第一次加密前
+// 返回值: 0 成功, -1 session 无效, -2 输出越界
+//
+//export randomizeNonceSalt
+func randomizeNonceSalt(id int32, outPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(outPtr, nonceSaltLen) {
+		return -2
+	}
+	state := &getSession(id).sudokuState
+	fillRandom(state[stateNonceSalt : stateNonceSalt+nonceSaltLen])
+	copy(arena[outPtr:outPtr+nonceSaltLen], state[stateNonceSalt:stateNonceSalt+nonceSaltLen])
+	return 0
+}
+
+// shufflePaddingPool - 随机打乱 session 的 padding 池顺序 (Fisher-Yates)
+// 池中字节的集合不变，只是 RNG 取值到 padding 字节的映射对观察者不再已知；不影响对端解码
+// 返回值: 0 成功, -1 session 无效
+//
+//export shufflePaddingPool
+func shufflePaddingPool(id int32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	size := uint32(getSession(id).sudokuState[statePadPoolSize])
+	if size < 2 {
+		return 0
+	}
+	pool := &sessionPadPool[id]
+	var rnd [maxPadPoolSize * 4]byte
+	fillRandom(rnd[:size*4])
+	for i := size - 1; i > 0; i-- {
+		// 32 位随机数对 i+1 (<= 32) 取模，偏差不超过 2^-27
+		j := binary.LittleEndian.Uint32(rnd[i*4:i*4+4]) % (i + 1)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	for i := range rnd {
+		rnd[i] = 0
+	}
+	return 0
+}
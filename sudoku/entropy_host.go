@@ -0,0 +1,10 @@
+//go:build !tinygo || wasip1
+
+package sudoku
+
+import "crypto/rand"
+
+// 非 TinyGo 构建 (go vet / 原生测试) 没有宿主导入，直接读取系统随机源；wasip1 构建经 WASI random_get 读取
+func fillRandom(dst []byte) {
+	rand.Read(dst)
+}
@@ -0,0 +1,18 @@
+//go:build tinygo && !wasip1
+
+package sudoku
+
+import "unsafe"
+
+// 宿主导入: 宿主需在 importObject.env 中提供 getRandom (以密码学安全随机数填满 [ptr, ptr+len))
+//
+//go:wasmimport env getRandom
+func hostGetRandom(ptr uint32, n uint32)
+
+// fillRandom - 以宿主随机数填满 dst
+func fillRandom(dst []byte) {
+	if len(dst) == 0 {
+		return
+	}
+	hostGetRandom(uint32(uintptr(unsafe.Pointer(&dst[0]))), uint32(len(dst)))
+}
@@ -0,0 +1,53 @@
+// 错误码 - 导出函数失败时的原因
+// 返回指针/长度的导出函数失败时仍返回 0，原因通过 getLastError 获取；
+// 返回 int32 状态的导出函数直接返回 session 无效等局部错误码 (见各函数注释)
+
+package sudoku
+
+const (
+	errOK              = 0
+	errBadSession      = -1  // session ID 无效或未初始化
+	errEmptyInput      = -2  // 输入长度为 0
+	errInputTooLarge   = -3  // 输入超过单次调用上限
+	errOutputTooSmall  = -4  // 输出区容量不足
+	errDecode          = -5  // 未解出任何数据
+	errAuth            = -6  // AEAD 认证失败
+	errReplay          = -7  // nonce counter 已接受过或落在抗重放窗口之外
+	errBadFrame        = -8  // 记录长度非法或记录不完整
+	errStreamState     = -9  // 流式调用顺序错误 (未 maskBegin)
+	errOutOfBounds     = -10 // 指针/长度越出 arena
+	errCipher          = -11 // cipherType 不支持该操作
+	errUndecodable     = -12 // 严格解码模式下遇到无法解码的 hint 组 (偏移见 getDecodeErrorOffset)
+	errDecompress      = -13 // 压缩载荷格式非法或解压后超出上限 (见 compress.go)
+	errRateLimited     = -14 // 超出 session 发送限速 (见 ratelimit.go)
+	errClosed          = -15 // session 已发送或收到关闭记录 (见 close.go)
+	errStreamBlocked   = -16 // stream 发送窗口为 0 (见 mux.go)
+	errNoStream        = -17 // stream 不存在、本端已关闭或 stream 表已满
+	errNoFEC           = -18 // session 未开启 FEC (见 fec.go)
+	errNoPadding       = -19 // padding 池为空，无法生成伪装帧 (见 coverframe.go)
+	errQuota           = -20 // identity 的 session 数已达配额 (见 quota.go)
+	errLayoutMismatch  = -21 // 对端布局头与本端 layout/gridProfile/codec 表不符 (见 layouthdr.go)
+	errUnrepresentable = -22 // 输入字节的全部编码都含禁用输出字节 (见 forbid.go)
+	errOutBufBusy      = -23 // 未开启双缓冲输出区或两个槽位都已被取走 (见 outslots.go)
+
+	// 以下错误码只由 Go 接口 (api.go) 使用: 对应导出函数的 int32 局部返回值
+	errNoSlot     = -24 // 无可用 session、keyed codec 表或 trace 槽位
+	errBadKey     = -25 // 密钥长度不符合 cipherType
+	errBadConfig  = -26 // 未知 cipherType/gridProfile/role/选项，或取值、组合不支持
+	errBadPadding = -27 // padding 比例超过 100、padding 池过长或含不允许的字节
+)
+
+var lastError int32
+
+// fail - 记录错误码并返回 0 (指针型导出函数的失败返回值)
+func fail(code int32) uint32 {
+	lastError = code
+	return 0
+}
+
+// getLastError - 最近一次导出调用的错误码，成功时为 0
+//
+//export getLastError
+func getLastError() int32 {
+	return lastError
+}
@@ -0,0 +1,89 @@
+// 转义编码 - keyed codec 表可能存在没有任何编码变体的字节值 (encodeTableCount 为 0)，
+// 此类字节以两个 hint 组输出，而不是原样写入流中 (明文泄露，且接收端会把它当作 padding 跳过):
+//   前缀组: 4 个 hint 位于同一位置，取值为 1..4 的随机排列；真实网格的 hint 组位置互不相同，不会与码表冲突
+//   字面组: 第 j 个 hint 位于第 j 个四分区 (位置 4j..4j+3，区内随机)，取值 = 字节第 (3-j) 个 2 位 + 1，发送顺序随机
+// 两组之间不插入 padding，连同组前 padding 至多 maskMaxPerByte 字节；只用于 4x4 profile (9x9 码表覆盖全部字节值)
+
+package sudoku
+
+const stateEscapeRx = 62 // 已收到转义前缀，下一个 hint 组为字面组
+
+// maskEscape4 - 输出字节 b 的转义前缀组与字面组，返回数据字节数；
+// 前缀位置与字面组区内位置顺延避开禁用字节 (forbid)，无可用组合时返回 0
+func maskEscape4(b uint8, alpha *[64]uint8, forbid *[32]byte, rng *maskRng, grp *[maskMaxPerByte]byte, n *uint32) uint32 {
+	var prefix, literal [4]uint8
+
+	pos := uint8(rng.x & 0x0F)
+	rngAdvance(rng)
+	k := 0
+	for ; k < 16; k++ {
+		for j := uint8(0); j < 4; j++ {
+			prefix[j] = hintByte(pos, j+1)
+		}
+		if forbid == nil || hints4Allowed(&prefix, alpha, forbid) {
+			break
+		}
+		pos = (pos + 1) & 0x0F
+	}
+	if k == 16 {
+		return 0
+	}
+
+	for j := uint8(0); j < 4; j++ {
+		val := (b>>(6-2*j))&3 + 1
+		off := uint8(rng.x & 3)
+		rngAdvance(rng)
+		k := 0
+		for ; k < 4; k++ {
+			literal[j] = hintByte(4*j+off, val)
+			if !byteForbidden(forbid, alpha[literal[j]&0x3F]) {
+				break
+			}
+			off = (off + 1) & 3
+		}
+		if k == 4 {
+			return 0
+		}
+	}
+
+	perm := perm4[rng.x%24]
+	rngAdvance(rng)
+	for j := 0; j < 4; j++ {
+		grp[*n] = alpha[prefix[perm[j]]&0x3F]
+		*n++
+	}
+	perm = perm4[rng.x%24]
+	rngAdvance(rng)
+	for j := 0; j < 4; j++ {
+		grp[*n] = alpha[literal[perm[j]]&0x3F]
+		*n++
+	}
+	return 8
+}
+
+// escapeAllowed - 在禁用字节下是否存在字节 b 的转义输出
+func escapeAllowed(b uint8, alpha *[64]uint8, forbid *[32]byte) bool {
+	var grp [maskMaxPerByte]byte
+	n := uint32(0)
+	rng := maskRng{}
+	return maskEscape4(b, alpha, forbid, &rng, &grp, &n) != 0
+}
+
+// escapePrefix - 4 个 hint 是否构成转义前缀 (位置相同)
+func escapePrefix(hints *[4]uint8) bool {
+	pos := hints[0] & 0x0F
+	return hints[1]&0x0F == pos && hints[2]&0x0F == pos && hints[3]&0x0F == pos
+}
+
+// escapeLiteral - 还原字面组，四分区重复或缺失时返回 false
+func escapeLiteral(hints *[4]uint8) (uint8, bool) {
+	var val uint8
+	seen := uint8(0)
+	for j := 0; j < 4; j++ {
+		h := hints[j]
+		q := (h & 0x0F) >> 2
+		seen |= 1 << q
+		val |= ((h >> 4) & 3) << (6 - 2*q)
+	}
+	return val, seen == 0x0F
+}
@@ -0,0 +1,128 @@
+package sudoku
+
+import (
+	"bytes"
+	"testing"
+)
+
+// 转义组编解码: 每个字节值的前缀组被识别为前缀、字面组还原出原字节，且字面组不会被当作前缀
+func TestEscapeGroups(t *testing.T) {
+	var alpha [64]uint8 // 恒等字母表: 输出即 hint 字节
+	for i := range alpha {
+		alpha[i] = 0x40 | uint8(i)
+	}
+	for _, seed := range []uint32{0, 1, 0x9E3779B9} {
+		rng := maskRng{x: seed}
+		for b := 0; b < 256; b++ {
+			var grp [maskMaxPerByte]byte
+			n := uint32(0)
+			if r := maskEscape4(uint8(b), &alpha, nil, &rng, &grp, &n); r != 8 || n != 8 {
+				t.Fatalf("maskEscape4(%#x) = %d (%d bytes)", b, r, n)
+			}
+			var prefix, literal [4]uint8
+			copy(prefix[:], grp[0:4])
+			copy(literal[:], grp[4:8])
+			if !escapePrefix(&prefix) {
+				t.Fatalf("byte %#x: prefix % x not recognized", b, prefix)
+			}
+			if escapePrefix(&literal) {
+				t.Fatalf("byte %#x: literal % x taken for a prefix", b, literal)
+			}
+			if v, ok := escapeLiteral(&literal); !ok || v != uint8(b) {
+				t.Fatalf("byte %#x: literal % x decodes to %#x (%v)", b, literal, v, ok)
+			}
+		}
+	}
+
+	bad := []struct {
+		name  string
+		hints [4]uint8
+	}{
+		{"repeated quadrant", [4]uint8{hintByte(0, 1), hintByte(1, 2), hintByte(8, 3), hintByte(12, 4)}},
+		{"single quadrant", [4]uint8{hintByte(4, 1), hintByte(5, 1), hintByte(6, 1), hintByte(7, 1)}},
+	}
+	for _, tt := range bad {
+		if _, ok := escapeLiteral(&tt.hints); ok {
+			t.Fatalf("escapeLiteral(%s) accepted", tt.name)
+		}
+	}
+}
+
+// escapeTestTable - 使默认 codec 表中的 bytes 没有编码变体，测试结束时恢复
+func escapeTestTable(t *testing.T, escaped []byte) {
+	t.Helper()
+	initWasm()
+	tbl := &codecTables[defaultCodecTable]
+	ensureCodecRows(tbl, 256)
+	saved := *tbl.count
+	t.Cleanup(func() { *tbl.count = saved })
+	for _, b := range escaped {
+		tbl.count[b] = 0
+	}
+}
+
+// 码表中没有编码变体的字节经转义组往返，不以原样出现在 mask 输出中；转义组跨 unmask 调用拆分时仍可解码
+func TestEscapeRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		escaped []byte
+		in      []byte
+		padding uint32
+		strict  bool
+	}{
+		{"single", []byte{0x00}, []byte{0x00}, 0, false},
+		{"mixed", []byte{0x00, 0xFF, 'e'}, []byte("h\x00e\xffllo e"), 0, false},
+		{"padding", []byte{0x00, 0xFF}, []byte{0xFF, 1, 0x00, 0x00, 2, 0xFF}, 50, false},
+		{"strict decode", []byte{'a'}, []byte("banana"), 30, true},
+		{"all escaped", []byte{0x10, 0x11, 0x12}, []byte{0x10, 0x11, 0x12}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			escapeTestTable(t, tt.escaped)
+			tx := testSession(t, CipherNone, RoleShared)
+			rx := testSession(t, CipherNone, RoleShared)
+			setPaddingPolicy(tx, tt.padding, 0, 0)
+			if tt.strict {
+				setSessionOption(rx, SessionOptStrictDecode, 1)
+			}
+			masked, st := testCall(tx, tt.in, mask)
+			if st != errOK {
+				t.Fatalf("mask: %d", st)
+			}
+			if tt.padding == 0 {
+				// 普通字节 4 个 hint，转义字节 8 个 (前缀组 + 字面组)
+				want := 0
+				for _, b := range tt.in {
+					want += 4
+					if bytes.IndexByte(tt.escaped, b) >= 0 {
+						want += 4
+					}
+				}
+				if len(masked) != want {
+					t.Fatalf("mask output %d bytes, want %d", len(masked), want)
+				}
+			}
+			for _, b := range masked {
+				if b < 0x40 || b > 0x7F {
+					if bytes.IndexByte(tt.escaped, b) >= 0 {
+						t.Fatalf("escaped byte %#x leaked into the output", b)
+					}
+				}
+			}
+			if got, st := testCall(rx, masked, unmask); st != errOK || !bytes.Equal(got, tt.in) {
+				t.Fatalf("unmask = % x (%d), want % x", got, st, tt.in)
+			}
+
+			// 在每个位置拆成两次 unmask 调用
+			for cut := 1; cut < len(masked); cut++ {
+				rx := testSession(t, CipherNone, RoleShared)
+				a, _ := testCall(rx, masked[:cut], unmask)
+				b, st := testCall(rx, masked[cut:], unmask)
+				if got := append(a, b...); !bytes.Equal(got, tt.in) {
+					t.Fatalf("split at %d: % x (%d), want % x", cut, got, st, tt.in)
+				}
+				closeSession(rx)
+			}
+		})
+	}
+}
@@ -0,0 +1,449 @@
+// 前向纠错 (FEC) - 面向 UDP / ICMP 等会丢包的隐蔽传输
+// 开启后 maskFrame 的每个块恰好是一条记录 (输出布局同 mtu.go，每块单独作为一个报文发送)，
+// 每 K 条数据记录组成一组，组满后追加 R 条校验记录: 第 j 条校验记录是组内序号 i%R == j 的数据正文的异或
+// 接收端 unmaskFrame 在校验记录到达时，若其覆盖的数据记录恰好缺一条，即由异或恢复并输出；
+// 缺失分散在不同的 j 上时每组最多恢复 R 条，同一个 j 上缺两条及以上则无法恢复
+//
+// FEC 记录为控制记录 (见 control.go)，正文:
+//   数据 (controlFECData):   [组号 (2, 大端序)][组内序号 (1)][R (1)][数据正文]
+//   校验 (controlFECParity): [组号 (2)][j (1)][R (1)][本组数据记录数 (1)][正文长度异或 (2)][正文异或]
+// 数据正文为 maskFrame 输入的一段 (开启压缩时为压缩载荷)，不超过 fecMaxBody 字节
+// 开启 FEC 时 maskFrame 不做流量整形；stream 记录 (mux.go) 与其他控制记录不受保护
+//
+// 恢复缓冲区为模块级 (fecSlots 个 session 可同时开启)，接收端也须调用 setFECPolicy 才能恢复；
+// 未开启时仍正常输出数据记录，校验记录被忽略
+// 接收端只跟踪当前组与前一组: 已恢复的记录迟到时被丢弃，更早的组的迟到记录因无法判断是否重复也被丢弃
+// 恢复的数据在校验记录到达时才输出，位于同组后续记录之后；需要保序的宿主应让每次 maskFrame 的输入是一个独立报文
+
+package sudoku
+
+import "encoding/binary"
+
+const (
+	fecMaxK      = 16
+	fecMaxR      = 4
+	fecSlots     = 8
+	fecMaxBody   = 1024
+	fecDataHdr   = 4
+	fecParityHdr = 7
+
+	controlFECData   = 5
+	controlFECParity = 6
+)
+
+type fecState struct {
+	owner int32 // session ID + 1，0 表示空闲
+	k, r  uint8
+
+	// 发送: 当前组的组号、下一条数据记录的组内序号与各 j 的异或累加
+	txGroup  uint16
+	txIdx    uint8
+	txLen    [fecMaxR]uint16 // 累加区有效长度 (组内正文最大长度)
+	txLenXor [fecMaxR]uint16
+	txAcc    [fecMaxR][fecMaxBody]byte
+
+	// 接收: 当前组已收到 (或已恢复) 的记录位图与异或累加
+	rxValid    bool
+	rxGroup    uint16
+	rxHave     uint16
+	rxPrevHave uint16 // rxGroup-1 的位图，用于丢弃迟到的重复记录
+	rxLen      [fecMaxR]uint16
+	rxLenXor   [fecMaxR]uint16
+	rxAcc      [fecMaxR][fecMaxBody]byte
+
+	recovered     uint32
+	unrecoverable uint32
+	dropped       uint32
+}
+
+var (
+	fecStates      [fecSlots]fecState
+	sessionFECSlot [maxSessions]uint8 // 槽位 + 1，0 表示未开启
+)
+
+// fecFor - session id 的 FEC 状态，未开启时返回 nil
+func fecFor(id int32) *fecState {
+	if s := sessionFECSlot[id]; s != 0 {
+		return &fecStates[s-1]
+	}
+	return nil
+}
+
+// fecRelease - 释放 session id 的 FEC 槽位 (累加区含明文，一并清零)
+func fecRelease(id int32) {
+	if st := fecFor(id); st != nil {
+		*st = fecState{}
+	}
+	sessionFECSlot[id] = 0
+}
+
+// setFECPolicy - 开启 FEC: 每 k 条数据记录后追加 r 条校验记录 (1 <= r <= k <= 16, r <= 4)，k 为 0 关闭
+// 重新设置时丢弃当前组 (收发两个方向)，双方应在收发数据前设置
+// 返回值: 0 成功, -1 session 无效, -2 k/r 超出范围, -3 FEC 槽位已用完
+//
+//export setFECPolicy
+func setFECPolicy(id int32, k uint32, r uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if k == 0 {
+		fecRelease(id)
+		return 0
+	}
+	if k > fecMaxK || r == 0 || r > fecMaxR || r > k {
+		return -2
+	}
+	st := fecFor(id)
+	if st == nil {
+		for i := range fecStates {
+			if fecStates[i].owner == 0 {
+				st = &fecStates[i]
+				sessionFECSlot[id] = uint8(i + 1)
+				break
+			}
+		}
+		if st == nil {
+			return -3
+		}
+	}
+	*st = fecState{owner: id + 1, k: uint8(k), r: uint8(r)}
+	return 0
+}
+
+// fecFrame - 多条 FEC 记录的输出布局: [块描述符 | mask 输出 | 记录暂存 | 明文暂存]
+type fecFrame struct {
+	out, reserved  uint32
+	scratch, plain uint32
+	pos, n         uint32 // 已写入的 mask 字节数与块数
+}
+
+// fecEmit - 封装 f.plain 处 plainLen 字节的控制记录明文，mask 后追加一个块
+func fecEmit(id int32, f *fecFrame, plainLen uint32) int32 {
+	session := getSession(id)
+	payloadLen := plainLen + aeadOverhead(session)
+	recordLen := controlHeaderSize + payloadLen
+	binary.BigEndian.PutUint16(arena[f.scratch:f.scratch+frameHeaderSize], 0)
+	binary.BigEndian.PutUint16(arena[f.scratch+frameHeaderSize:f.scratch+controlHeaderSize], uint16(payloadLen))
+	if recordSeal(session, f.plain, plainLen, f.scratch+controlHeaderSize) != payloadLen {
+		return errCipher
+	}
+	sessionStats[id].framesSealed++
+	traceSealed(id, plainLen)
+	rekeyAfterSeal(id, plainLen)
+
+	dst := f.out + f.reserved + f.pos
+	masked, ok := maskSealed(id, f.scratch, recordLen, dst, recordLen*maskPerByte(&session.sudokuState)+1+layoutHeaderReserve(id))
+	if !ok {
+		return maskShortError()
+	}
+	d := f.out + 4 + f.n*shapingDescSize
+	binary.LittleEndian.PutUint32(arena[d:d+4], f.reserved+f.pos)
+	binary.LittleEndian.PutUint32(arena[d+4:d+8], masked)
+	binary.LittleEndian.PutUint32(arena[d+8:d+12], 0)
+	f.pos += masked
+	f.n++
+	return errOK
+}
+
+// fecEmitParity - 为当前组输出校验记录并开始下一组
+func fecEmitParity(id int32, st *fecState, f *fecFrame) int32 {
+	count := st.txIdx
+	for j := uint8(0); j < st.r && j < count; j++ {
+		n := uint32(st.txLen[j])
+		p := f.plain
+		arena[p] = controlFECParity
+		binary.BigEndian.PutUint16(arena[p+1:p+3], st.txGroup)
+		arena[p+3] = j
+		arena[p+4] = st.r
+		arena[p+5] = count
+		binary.BigEndian.PutUint16(arena[p+6:p+8], st.txLenXor[j])
+		copy(arena[p+1+fecParityHdr:p+1+fecParityHdr+n], st.txAcc[j][:n])
+		if status := fecEmit(id, f, 1+fecParityHdr+n); status != errOK {
+			return status
+		}
+		for i := uint32(0); i < n; i++ {
+			st.txAcc[j][i] = 0
+		}
+		st.txLen[j] = 0
+		st.txLenXor[j] = 0
+	}
+	st.txGroup++
+	st.txIdx = 0
+	return errOK
+}
+
+// fecLayout - 为 records 条 FEC 记录 (明文均不超过 plainMax) 划分输出区，失败时返回错误码
+func fecLayout(id int32, records uint32, plainMax uint32, f *fecFrame) int32 {
+	session := getSession(id)
+	recordMax := controlHeaderSize + plainMax + aeadOverhead(session)
+	streamMax := uint64(records)*uint64(recordMax*maskPerByte(&session.sudokuState)+1) + uint64(layoutHeaderReserve(id))
+	out, outCap := sessionOut(id)
+	*f = fecFrame{out: out, reserved: 4 + records*shapingDescSize, scratch: aeadScratchBase}
+	f.plain = f.scratch + recordMax
+	tooLarge := int32(errInputTooLarge)
+	if sessionOutPtr[id] != 0 {
+		if recordMax+plainMax >= outCap {
+			return errOutputTooSmall
+		}
+		outCap -= recordMax + plainMax
+		f.scratch = out + outCap
+		f.plain = f.scratch + recordMax
+		tooLarge = errOutputTooSmall
+	}
+	if uint64(f.reserved)+streamMax > uint64(outCap) {
+		return tooLarge
+	}
+	return errOK
+}
+
+// fecPlainMax - FEC 记录明文 (含类型与 FEC 头) 的长度上限，0 表示容纳不下 1 字节数据
+func fecPlainMax(id int32) uint32 {
+	room := controlBodyCap(id)
+	if room <= fecParityHdr+sealPlainMax(id, 0) {
+		return 0
+	}
+	body := room - fecParityHdr
+	if body > fecMaxBody {
+		body = fecMaxBody
+	}
+	return 1 + fecParityHdr + body
+}
+
+// maskFrameFEC - maskFrame 在开启 FEC 时的路径: 输入切成数据记录，组满时追加校验记录，每条记录一个块
+// 调用方已完成 session、指针、空输入与限速检查
+func maskFrameFEC(id int32, inPtr uint32, inLen uint32) uint32 {
+	st := fecFor(id)
+	plainMax := fecPlainMax(id)
+	if plainMax == 0 {
+		return fail(errOutputTooSmall)
+	}
+	chunk := plainMax - 1 - fecParityHdr - sealPlainMax(id, 0)
+	data := (inLen + chunk - 1) / chunk
+	parity := (uint32(st.txIdx) + data) / uint32(st.k) * uint32(st.r)
+	var f fecFrame
+	if status := fecLayout(id, data+parity, plainMax, &f); status != errOK {
+		return fail(status)
+	}
+
+	for off := uint32(0); off < inLen; off += chunk {
+		n := chunk
+		if n > inLen-off {
+			n = inLen - off
+		}
+		p := f.plain
+		body := p + 1 + fecDataHdr
+		bodyPtr, bodyLen := compressForSeal(id, inPtr+off, n, body)
+		if bodyPtr != body {
+			copy(arena[body:body+bodyLen], arena[bodyPtr:bodyPtr+bodyLen])
+		}
+		j := st.txIdx % st.r
+		for i := uint32(0); i < bodyLen; i++ {
+			st.txAcc[j][i] ^= arena[body+i]
+		}
+		if uint16(bodyLen) > st.txLen[j] {
+			st.txLen[j] = uint16(bodyLen)
+		}
+		st.txLenXor[j] ^= uint16(bodyLen)
+
+		arena[p] = controlFECData
+		binary.BigEndian.PutUint16(arena[p+1:p+3], st.txGroup)
+		arena[p+3] = st.txIdx
+		arena[p+4] = st.r
+		if status := fecEmit(id, &f, 1+fecDataHdr+bodyLen); status != errOK {
+			setOutLen(id, 0)
+			return fail(status)
+		}
+		st.txIdx++
+		if st.txIdx == st.k {
+			if status := fecEmitParity(id, st, &f); status != errOK {
+				setOutLen(id, 0)
+				return fail(status)
+			}
+		}
+	}
+	rateCharge(id, inLen)
+
+	binary.LittleEndian.PutUint32(arena[f.out:f.out+4], f.n)
+	setOutLen(id, f.reserved+f.pos)
+	lastError = errOK
+	return f.out
+}
+
+// flushFEC - 立即为未满的当前组输出校验记录 (如一批数据发送完毕、短时间内不再有后续数据时)，之后开始新的一组
+// 输出格式同开启 FEC 时的 maskFrame
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取:
+// -2 当前组没有数据记录, -18 session 未开启 FEC)
+//
+//export flushFEC
+func flushFEC(id int32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	st := fecFor(id)
+	if st == nil {
+		return fail(errNoFEC)
+	}
+	if st.txIdx == 0 {
+		return fail(errEmptyInput)
+	}
+	parity := uint32(st.r)
+	if uint32(st.txIdx) < parity {
+		parity = uint32(st.txIdx)
+	}
+	var f fecFrame
+	if status := fecLayout(id, parity, fecPlainMax(id), &f); status != errOK {
+		return fail(status)
+	}
+	if status := fecEmitParity(id, st, &f); status != errOK {
+		setOutLen(id, 0)
+		return fail(status)
+	}
+	binary.LittleEndian.PutUint32(arena[f.out:f.out+4], f.n)
+	setOutLen(id, f.reserved+f.pos)
+	lastError = errOK
+	return f.out
+}
+
+// fecAdvance - 接收端切换到更新的组 g
+func fecAdvance(st *fecState, g uint16) {
+	if st.rxValid && g == st.rxGroup+1 {
+		st.rxPrevHave = st.rxHave
+	} else {
+		st.rxPrevHave = 0
+	}
+	for j := range st.rxAcc {
+		for i := uint16(0); i < st.rxLen[j]; i++ {
+			st.rxAcc[j][i] = 0
+		}
+	}
+	st.rxLen = [fecMaxR]uint16{}
+	st.rxLenXor = [fecMaxR]uint16{}
+	st.rxValid = true
+	st.rxGroup = g
+	st.rxHave = 0
+}
+
+// fecReceive - 处理 plain 处的 FEC 记录明文，数据 (收到的或恢复的) 前移到 plain
+// 返回值: (输出的数据长度, 错误码)
+func fecReceive(id int32, plain uint32, plainLen uint32) (uint32, int32) {
+	if arena[plain] == controlFECData {
+		return fecReceiveData(id, plain, plainLen)
+	}
+	return fecReceiveParity(id, plain, plainLen)
+}
+
+func fecReceiveData(id int32, plain uint32, plainLen uint32) (uint32, int32) {
+	if plainLen <= 1+fecDataHdr || plainLen-1-fecDataHdr > fecMaxBody {
+		sessionStats[id].decodeErrors++
+		return 0, errBadFrame
+	}
+	g := binary.BigEndian.Uint16(arena[plain+1 : plain+3])
+	idx, r := arena[plain+3], arena[plain+4]
+	if idx >= fecMaxK || r == 0 || r > fecMaxR {
+		sessionStats[id].decodeErrors++
+		return 0, errBadFrame
+	}
+	body := plain + 1 + fecDataHdr
+	n := plainLen - 1 - fecDataHdr
+
+	if st := fecFor(id); st != nil {
+		bit := uint16(1) << idx
+		switch {
+		case !st.rxValid || (g != st.rxGroup && g-st.rxGroup < 1<<15):
+			fecAdvance(st, g)
+			fallthrough
+		case g == st.rxGroup:
+			if st.rxHave&bit != 0 {
+				st.dropped++
+				return 0, errOK
+			}
+			st.rxHave |= bit
+			j := idx % r
+			for i := uint32(0); i < n; i++ {
+				st.rxAcc[j][i] ^= arena[body+i]
+			}
+			if uint16(n) > st.rxLen[j] {
+				st.rxLen[j] = uint16(n)
+			}
+			st.rxLenXor[j] ^= uint16(n)
+		case g == st.rxGroup-1 && st.rxPrevHave&bit == 0:
+			st.rxPrevHave |= bit
+		default:
+			st.dropped++
+			return 0, errOK
+		}
+	}
+	copy(arena[plain:plain+n], arena[body:body+n])
+	return n, errOK
+}
+
+func fecReceiveParity(id int32, plain uint32, plainLen uint32) (uint32, int32) {
+	if plainLen <= 1+fecParityHdr || plainLen-1-fecParityHdr > fecMaxBody {
+		sessionStats[id].decodeErrors++
+		return 0, errBadFrame
+	}
+	g := binary.BigEndian.Uint16(arena[plain+1 : plain+3])
+	j, r, count := arena[plain+3], arena[plain+4], arena[plain+5]
+	if r == 0 || r > fecMaxR || j >= r || count == 0 || count > fecMaxK {
+		sessionStats[id].decodeErrors++
+		return 0, errBadFrame
+	}
+	st := fecFor(id)
+	if st == nil {
+		return 0, errOK
+	}
+	if !st.rxValid || (g != st.rxGroup && g-st.rxGroup < 1<<15) {
+		fecAdvance(st, g)
+	} else if g != st.rxGroup {
+		return 0, errOK
+	}
+
+	missing, lost := 0, uint8(0)
+	for idx := j; idx < count; idx += r {
+		if st.rxHave&(uint16(1)<<idx) == 0 {
+			missing++
+			lost = idx
+		}
+	}
+	if missing == 0 {
+		return 0, errOK
+	}
+	xorLen := plainLen - 1 - fecParityHdr
+	n := uint32(binary.BigEndian.Uint16(arena[plain+6:plain+8]) ^ st.rxLenXor[j])
+	if missing > 1 || n == 0 || n > xorLen {
+		st.unrecoverable++
+		return 0, errOK
+	}
+	body := plain + 1 + fecParityHdr
+	for i := uint32(0); i < n; i++ {
+		arena[plain+i] = arena[body+i] ^ st.rxAcc[j][i]
+	}
+	st.rxHave |= uint16(1) << lost
+	st.recovered++
+	return n, errOK
+}
+
+// getFECStats - 将 session 的 FEC 接收统计写入 arena[outPtr:outPtr+12] (3 个 uint32，小端序):
+// [恢复的记录数, 缺失过多无法恢复的组数 (按校验记录计), 丢弃的迟到/重复记录数]
+// 返回值: 12, -1 session 无效, -2 输出越界, -3 未开启 FEC
+//
+//export getFECStats
+func getFECStats(id int32, outPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(outPtr, 12) {
+		return -2
+	}
+	st := fecFor(id)
+	if st == nil {
+		return -3
+	}
+	binary.LittleEndian.PutUint32(arena[outPtr:outPtr+4], st.recovered)
+	binary.LittleEndian.PutUint32(arena[outPtr+4:outPtr+8], st.unrecoverable)
+	binary.LittleEndian.PutUint32(arena[outPtr+8:outPtr+12], st.dropped)
+	return 12
+}
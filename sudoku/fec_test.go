@@ -0,0 +1,138 @@
+package sudoku
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+)
+
+// fecTestBlocks - 按块描述符把 maskFrame / flushFEC 的输出拆为各个报文
+func fecTestBlocks(out []byte) [][]byte {
+	n := binary.LittleEndian.Uint32(out[0:4])
+	var blocks [][]byte
+	for i := uint32(0); i < n; i++ {
+		d := out[4+i*shapingDescSize:]
+		off, l := binary.LittleEndian.Uint32(d[0:4]), binary.LittleEndian.Uint32(d[4:8])
+		blocks = append(blocks, out[off:off+l])
+	}
+	return blocks
+}
+
+// 每组 K 条数据记录后的 R 条校验记录可恢复每个 j 上缺失的一条记录，恢复的数据在校验记录到达时输出
+func TestFECRecovery(t *testing.T) {
+	tests := []struct {
+		name  string
+		k, r  uint32
+		msgs  int
+		flush bool
+		drop  []int    // 丢弃的报文序号 (按发送顺序，含校验记录)
+		want  []string // 接收端依次输出的明文
+		stats [3]uint32
+	}{
+		{"no loss", 4, 1, 4, false, nil, []string{"m0", "m1", "m2", "m3"}, [3]uint32{}},
+		{"one lost", 4, 1, 4, false, []int{1}, []string{"m0", "m2", "m3", "m1"}, [3]uint32{1, 0, 0}},
+		{"two lost one parity", 4, 1, 4, false, []int{1, 2}, []string{"m0", "m3"}, [3]uint32{0, 1, 0}},
+		{"two lost two parity", 4, 2, 4, false, []int{1, 2}, []string{"m0", "m3", "m2", "m1"}, [3]uint32{2, 0, 0}},
+		{"parity lost", 4, 1, 4, false, []int{4}, []string{"m0", "m1", "m2", "m3"}, [3]uint32{}},
+		{"flushed partial group", 4, 1, 2, true, []int{0}, []string{"m1", "m0"}, [3]uint32{1, 0, 0}},
+		{"two groups", 2, 1, 4, false, []int{0, 4}, []string{"m1", "m0", "m2", "m3"}, [3]uint32{2, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, rx := testPair(t, CipherChaCha20Poly)
+			for _, id := range []int32{tx, rx} {
+				if r := setFECPolicy(id, tt.k, tt.r); r != 0 {
+					t.Fatalf("setFECPolicy: %d", r)
+				}
+			}
+			var packets [][]byte
+			for i := 0; i < tt.msgs; i++ {
+				out, st := testCall(tx, []byte(fmt.Sprintf("m%d", i)), maskFrame)
+				if st != errOK {
+					t.Fatalf("maskFrame: %d", st)
+				}
+				packets = append(packets, fecTestBlocks(out)...)
+			}
+			if tt.flush {
+				p := flushFEC(tx)
+				if p == 0 {
+					t.Fatalf("flushFEC: %d", lastError)
+				}
+				packets = append(packets, fecTestBlocks(append([]byte(nil), arena[p:p+sessionOutLen[tx]]...))...)
+			}
+
+			dropped := make(map[int]bool)
+			for _, i := range tt.drop {
+				dropped[i] = true
+			}
+			var got []string
+			for i, pkt := range packets {
+				if dropped[i] {
+					continue
+				}
+				out, st := testCall(rx, pkt, unmaskFrame)
+				if st != errOK {
+					t.Fatalf("unmaskFrame(packet %d): %d", i, st)
+				}
+				if len(out) > 0 {
+					got = append(got, string(out))
+				}
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("output %v, want %v", got, tt.want)
+			}
+			statsPtr := uint32(workBufBase + 0x3000)
+			if r := getFECStats(rx, statsPtr); r != 12 {
+				t.Fatalf("getFECStats: %d", r)
+			}
+			for i, w := range tt.stats {
+				if v := binary.LittleEndian.Uint32(arena[statsPtr+uint32(i)*4:]); v != w {
+					t.Fatalf("FEC stats[%d] = %d, want %d", i, v, w)
+				}
+			}
+		})
+	}
+}
+
+// setFECPolicy / flushFEC 的参数与状态检查
+func TestFECPolicyErrors(t *testing.T) {
+	id := testSession(t, CipherChaCha20Poly, RoleClient)
+	policyTests := []struct {
+		k, r uint32
+		want int32
+	}{
+		{fecMaxK + 1, 1, -2},
+		{4, 0, -2},
+		{8, fecMaxR + 1, -2},
+		{2, 3, -2},
+		{fecMaxK, fecMaxR, 0},
+		{0, 0, 0},
+	}
+	for _, tt := range policyTests {
+		if r := setFECPolicy(id, tt.k, tt.r); r != tt.want {
+			t.Fatalf("setFECPolicy(%d, %d) = %d, want %d", tt.k, tt.r, r, tt.want)
+		}
+	}
+	if p := flushFEC(id); p != 0 || lastError != errNoFEC {
+		t.Fatalf("flushFEC(no FEC) = %d (%d), want %d", p, lastError, errNoFEC)
+	}
+	setFECPolicy(id, 4, 1)
+	if p := flushFEC(id); p != 0 || lastError != errEmptyInput {
+		t.Fatalf("flushFEC(empty group) = %d (%d), want %d", p, lastError, errEmptyInput)
+	}
+
+	// 槽位用完后返回 -3，释放后可再次开启
+	for i := 1; i < fecSlots; i++ {
+		if r := setFECPolicy(testSession(t, CipherChaCha20Poly, RoleClient), 2, 1); r != 0 {
+			t.Fatalf("setFECPolicy(slot %d): %d", i, r)
+		}
+	}
+	extra := testSession(t, CipherChaCha20Poly, RoleClient)
+	if r := setFECPolicy(extra, 2, 1); r != -3 {
+		t.Fatalf("setFECPolicy(slots full) = %d, want -3", r)
+	}
+	setFECPolicy(id, 0, 0)
+	if r := setFECPolicy(extra, 2, 1); r != 0 {
+		t.Fatalf("setFECPolicy after release: %d", r)
+	}
+}
@@ -0,0 +1,153 @@
+// 禁用输出字节 - 部分传输会破坏特定字节 (如短信或旧式串行通道中的 0x00、0x0D)
+// 宿主以 256 位位图登记后，mask 系列在编码变体与 padding 中避开这些字节:
+//   选中的 hint 组含禁用字节时顺延到下一个可用的编码变体，padding 只从池中未禁用的字节中选取 (全部禁用时不插入)；
+//   某个输入字节的全部编码变体都含禁用字节，或伪装模板的字面字节被禁用时，调用以 errUnrepresentable 失败，
+//   已消费部分的输出仍然有效 (同 errOutputTooSmall)
+// 只改变发送方向的随机选择，接收端无需设置；位图不随 exportSession 导出，导入后须重新设置
+// 编码变体来自双方共用的 codec 表，本端不能单方面重建: 禁用字节落在 hint 字母表内时，部分字节值的全部变体都含有该字节，
+// setForbiddenBytes 返回其个数，宿主应与对端改用不含这些字节的 layout 或 keyed codec 表 (返回 0 为止)
+
+package sudoku
+
+var (
+	sessionForbidden   [maxSessions][32]byte // bit (b&7) of [b>>3] 置位表示禁止输出字节 b
+	sessionForbidCount [maxSessions]uint16   // 禁用的字节数，0 表示未设置
+)
+
+// maskUnrepresentable - 最近一次 maskInput 因禁用字节而中止
+var maskUnrepresentable bool
+
+// sessionForbid - session 的禁用字节位图，未设置时返回 nil
+func sessionForbid(id int32) *[32]byte {
+	if sessionForbidCount[id] == 0 {
+		return nil
+	}
+	return &sessionForbidden[id]
+}
+
+// byteForbidden - forbid 为 nil 时恒为 false
+func byteForbidden(forbid *[32]byte, b uint8) bool {
+	return forbid != nil && forbid[b>>3]&(1<<(b&7)) != 0
+}
+
+// forbidFilterPool - 去掉 padding 池中的禁用字节，返回剩余字节数
+func forbidFilterPool(pool *[maxPadPoolSize]uint8, size uint8, forbid *[32]byte, out *[maxPadPoolSize]uint8) uint8 {
+	n := uint8(0)
+	for i := uint8(0); i < size; i++ {
+		if !byteForbidden(forbid, pool[i]) {
+			out[n] = pool[i]
+			n++
+		}
+	}
+	return n
+}
+
+// hints4Allowed - 4x4 hint 组映射到输出字母表后不含禁用字节
+func hints4Allowed(hints *[4]uint8, alpha *[64]uint8, forbid *[32]byte) bool {
+	for j := 0; j < 4; j++ {
+		if byteForbidden(forbid, alpha[hints[j]&0x3F]) {
+			return false
+		}
+	}
+	return true
+}
+
+// syms9Allowed - 9x9 符号对的 4 个输出字节不含禁用字节
+func syms9Allowed(syms *[2]uint16, forbid *[32]byte) bool {
+	for j := 0; j < 2; j++ {
+		if byteForbidden(forbid, grid9HintHi|uint8(syms[j]>>6)) || byteForbidden(forbid, grid9HintLo|uint8(syms[j]&0x3F)) {
+			return false
+		}
+	}
+	return true
+}
+
+// maskShortError - maskBody 未消费全部输入时的错误码
+func maskShortError() int32 {
+	if maskUnrepresentable {
+		maskUnrepresentable = false
+		return errUnrepresentable
+	}
+	return errOutputTooSmall
+}
+
+// forbidUnrepresentable - 在当前 layout/gridProfile/codec 表与伪装模板下无法输出的字节值个数
+func forbidUnrepresentable(id int32) uint32 {
+	forbid := sessionForbid(id)
+	state := &getSession(id).sudokuState
+	if forbid == nil {
+		return 0
+	}
+	if cover := sessionCover(state); cover != nil {
+		for i := uint8(0); i < cover.length; i++ {
+			if cover.template[i] != coverSlot && byteForbidden(forbid, cover.template[i]) {
+				return 256
+			}
+		}
+	}
+	profile9 := state[stateGridProfile] == GridProfile9x9
+	table := sessionCodecTable(state)
+	alpha := &alphabetEncode[sessionAlphabet(state)]
+	if !profile9 {
+		ensureCodecRows(table, 256)
+	}
+	missing := uint32(0)
+	for b := 0; b < 256; b++ {
+		ok := false
+		if profile9 {
+			for i := uint8(0); i < grid9EncodeCount[b] && !ok; i++ {
+				ok = syms9Allowed(&grid9EncodeTable[b][i], forbid)
+			}
+		} else if table.count[b] == 0 {
+			ok = escapeAllowed(uint8(b), alpha, forbid)
+		} else {
+			for i := uint8(0); i < table.count[b] && !ok; i++ {
+				ok = hints4Allowed(&table.encode[b][i], alpha, forbid)
+			}
+		}
+		if !ok {
+			missing++
+		}
+	}
+	return missing
+}
+
+// setForbiddenBytes - 以 arena[bitmapPtr:bitmapPtr+32] 设置禁用输出字节 (bit (b&7) of [b>>3] 对应字节 b)，全 0 清除
+// 之后更换 layout、codec 表或伪装 profile 时无需重新设置，但返回值只反映设置时的配置
+// 返回值: 无法输出的字节值个数 (0 表示任意输入都可 mask；含加密的调用密文随机，非 0 时可能随机失败)
+//
+//	-1 session 无效
+//	-2 输入越界
+//
+//export setForbiddenBytes
+func setForbiddenBytes(id int32, bitmapPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(bitmapPtr, 32) {
+		return -2
+	}
+	bitmap := &sessionForbidden[id]
+	copy(bitmap[:], arena[bitmapPtr:bitmapPtr+32])
+	count := uint16(0)
+	for b := 0; b < 256; b++ {
+		if bitmap[b>>3]&(1<<(b&7)) != 0 {
+			count++
+		}
+	}
+	sessionForbidCount[id] = count
+	return int32(forbidUnrepresentable(id))
+}
+
+// maskAllowed - 一个字节的完整输出 (含 padding 与伪装模板字面字节) 不含禁用字节；data 为 0 表示没有可用的编码变体
+func maskAllowed(out []byte, data uint32, forbid *[32]byte) bool {
+	if data == 0 {
+		return false
+	}
+	for _, b := range out {
+		if byteForbidden(forbid, b) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,164 @@
+// 原生 Go 模糊测试 - codec 与 AEAD 核心不依赖 TinyGo/wasm (日志在非 TinyGo 构建下由 log_host.go 提供)，
+// 可直接以 go test -fuzz 运行:
+//   go test -run '^$' -fuzz FuzzUnmask -fuzztime 60s .
+// 输入拷贝进 arena 的 workBuf 后调用与 wasm 导出相同的入口，检查不 panic、不越界写出，以及往返不变式
+
+package sudoku
+
+import (
+	"bytes"
+	"testing"
+)
+
+// fuzzMaxInput - 单次输入上限，保证解码输出落在共享 outBuf 内
+const fuzzMaxInput = workBufSize / 2
+
+// fuzzStage - 将 data 拷贝到 workBuf 起始处，返回 arena 指针与长度
+func fuzzStage(data []byte) (uint32, uint32) {
+	if len(data) > fuzzMaxInput {
+		data = data[:fuzzMaxInput]
+	}
+	copy(arena[workBufBase:], data)
+	return workBufBase, uint32(len(data))
+}
+
+// fuzzSession - 以固定密钥创建 session，失败时终止测试
+func fuzzSession(t *testing.T, cipherType uint8, layoutType uint8, gridProfile uint8) int32 {
+	t.Helper()
+	if !wasmInitialized {
+		initWasm()
+	}
+	key := katKey(0x40)
+	id := initSessionKey(key[:], cipherType, layoutType, gridProfile, RoleShared, nil)
+	if id < 0 {
+		t.Fatalf("initSessionKey: %d", id)
+	}
+	return id
+}
+
+// FuzzUnmask - 任意输入经 unmask 不得 panic，输出不超过输入长度；
+// 输入为合法 mask 输出时必须还原原文
+func FuzzUnmask(f *testing.F) {
+	f.Add([]byte{}, uint8(0), false)
+	f.Add([]byte("\x40\x51\x62\x73\x3f\x44\x55"), uint8(0), false)
+	f.Add([]byte("hello, sudoku"), uint8(1), true)
+	f.Add(bytes.Repeat([]byte{0x80, 0x01}, 64), uint8(2), false)
+	f.Add([]byte("AAAA-_.~zz09"), uint8(3), true)
+
+	f.Fuzz(func(t *testing.T, data []byte, mode uint8, strict bool) {
+		layouts := [...]uint8{LayoutASCII, LayoutEntropy, LayoutBase64URL, LayoutHeaderToken}
+		layout := layouts[mode%4]
+		profile := uint8(GridProfile4x4)
+		if mode&0x10 != 0 {
+			layout, profile = LayoutASCII, GridProfile9x9
+		}
+		id := fuzzSession(t, CipherNone, layout, profile)
+		defer closeSession(id)
+		if strict {
+			setStrictDecode(id, 1)
+		}
+
+		inPtr, inLen := fuzzStage(data)
+		out := unmask(id, inPtr, inLen)
+		if out != 0 && sessionOutLen[id] > inLen {
+			t.Fatalf("unmask: %d bytes from %d input bytes", sessionOutLen[id], inLen)
+		}
+		if checkArenaIntegrity() != 0 {
+			t.Fatal("arena canary corrupted")
+		}
+
+		// 往返: 同配置的新 session 把 data 当作明文 mask 后再 unmask
+		if len(data) == 0 || len(data) > maskMaxChunk {
+			return
+		}
+		tx := fuzzSession(t, CipherNone, layout, profile)
+		rx := fuzzSession(t, CipherNone, layout, profile)
+		defer closeSession(tx)
+		defer closeSession(rx)
+		inPtr, inLen = fuzzStage(data)
+		p := mask(tx, inPtr, inLen)
+		if p == 0 {
+			t.Fatalf("mask: %d", getLastError())
+		}
+		masked := append([]byte(nil), arena[p:p+sessionOutLen[tx]]...)
+		inPtr, inLen = fuzzStage(masked)
+		q := unmask(rx, inPtr, inLen)
+		if q == 0 || !bytes.Equal(arena[q:q+sessionOutLen[rx]], data) {
+			t.Fatalf("round trip mismatch (layout %d, profile %d)", layout, profile)
+		}
+	})
+}
+
+// FuzzChaCha20Poly1305Open - 任意密文不得 panic；Seal 的输出可被 Open 还原，任一字节被改动后必须拒绝
+func FuzzChaCha20Poly1305Open(f *testing.F) {
+	f.Add(make([]byte, 32), make([]byte, 12), []byte("plaintext"), []byte("ad"), uint16(0))
+	f.Add(bytes.Repeat([]byte{0xff}, 32), bytes.Repeat([]byte{1}, 12), []byte{}, []byte{}, uint16(7))
+
+	f.Fuzz(func(t *testing.T, keyBytes []byte, nonceBytes []byte, data []byte, ad []byte, flip uint16) {
+		var key [32]byte
+		var nonce [12]byte
+		copy(key[:], keyBytes)
+		copy(nonce[:], nonceBytes)
+		if len(data) > 4096 {
+			data = data[:4096]
+		}
+
+		// 原始输入当作密文: 不得 panic，认证通过的概率可忽略
+		var plain [4096]byte
+		if n := chacha20poly1305Open(&key, &nonce, data, len(data), ad, len(ad), plain[:]); n >= 0 && len(data) >= poly1305TagSize {
+			t.Fatalf("random ciphertext accepted (%d bytes)", n)
+		}
+
+		var sealed [4096 + poly1305TagSize]byte
+		n := chacha20poly1305Seal(&key, &nonce, data, len(data), ad, len(ad), sealed[:])
+		if n != len(data)+poly1305TagSize {
+			t.Fatalf("seal length %d", n)
+		}
+		if m := chacha20poly1305Open(&key, &nonce, sealed[:n], n, ad, len(ad), plain[:]); m != len(data) || !bytes.Equal(plain[:m], data) {
+			t.Fatal("open did not recover plaintext")
+		}
+		sealed[int(flip)%n] ^= 0x01
+		if chacha20poly1305Open(&key, &nonce, sealed[:n], n, ad, len(ad), plain[:]) >= 0 {
+			t.Fatal("tampered ciphertext accepted")
+		}
+	})
+}
+
+// FuzzDecodeTableLookup - 任意解码键查表不得 panic；命中时该字节的编码组中必有同一键
+func FuzzDecodeTableLookup(f *testing.F) {
+	f.Add(uint32(0))
+	f.Add(uint32(0x40506070))
+	f.Add(^uint32(0))
+
+	f.Fuzz(func(t *testing.T, key uint32) {
+		if !wasmInitialized {
+			initWasm()
+		}
+		tbl := &codecTables[defaultCodecTable]
+		if v, ok := decodeTableLookupIn(tbl, key); ok {
+			found := false
+			for g := uint8(0); g < tbl.count[v]; g++ {
+				if packHintsToKey(tbl.encode[v][g]) == key {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Fatalf("4x4 key %08x decodes to %d but is not one of its groups", key, v)
+			}
+		}
+		if v, ok := grid9DecodeLookup(key); ok {
+			found := false
+			for g := uint8(0); g < grid9EncodeCount[v]; g++ {
+				grp := grid9EncodeTable[v][g]
+				if grid9Key(grp[0], grp[1]) == key {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Fatalf("9x9 key %08x decodes to %d but is not one of its groups", key, v)
+			}
+		}
+	})
+}
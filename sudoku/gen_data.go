@@ -0,0 +1,575 @@
+//go:build ignore
+
+// gen_data.go - 预计算数据生成工具 (go generate 调用，见 main.go)
+// 运行: go run gen_data.go [-json codec_tables.json] [-seed 0x7375646F6B75]
+// 生成:
+//   data_generated.go  9x9 profile 编码表与完美散列解码表 (编译进模块，见 codec_chd.go)
+//   codec_tables.json  4x4 网格、hint 位置组合、默认 (或 -seed 指定) 种子的 4x4 编码表与 9x9 编码表，
+//                      供与官方客户端的表逐项比对
+// 4x4 表在模块内运行时构建 (codec_table.go)，此处的实现必须与其保持一致
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// ============================================================================
+// 4x4 profile (与 codec_table.go 的 setupGrids / buildCodecTable 一致)
+// hint 字节 = 0x40 | (value-1)<<4 | position
+// ============================================================================
+
+const (
+	numGrids         = 288
+	numHintPositions = 1820
+	maxHintsPerByte  = 50
+	decodeTableBits  = 14
+	decodeTableSize  = 1 << decodeTableBits
+	defaultCodecSeed = 0x7375646F6B75
+)
+
+var (
+	allGridsData      [numGrids][16]uint8
+	hintPositionsData [numHintPositions][4]uint8
+	encodeTable       [256][maxHintsPerByte][4]uint8
+	encodeTableCount  [256]uint8
+	decodeTableKeys   [decodeTableSize]uint32
+	decodeTableVals   [decodeTableSize]uint8
+)
+
+func initGrids() {
+	var g [16]uint8
+	n := 0
+	var backtrack func(int)
+	backtrack = func(idx int) {
+		if idx == 16 {
+			allGridsData[n] = g
+			n++
+			return
+		}
+		row, col := idx/4, idx%4
+		br, bc := (row/2)*2, (col/2)*2
+		for num := uint8(1); num <= 4; num++ {
+			valid := true
+			for i := 0; i < 4 && valid; i++ {
+				if g[row*4+i] == num || g[i*4+col] == num {
+					valid = false
+				}
+			}
+			for r := 0; r < 2 && valid; r++ {
+				for c := 0; c < 2; c++ {
+					if g[(br+r)*4+(bc+c)] == num {
+						valid = false
+					}
+				}
+			}
+			if valid {
+				g[idx] = num
+				backtrack(idx + 1)
+				g[idx] = 0
+			}
+		}
+	}
+	backtrack(0)
+	if n != numGrids {
+		panic(fmt.Sprintf("expected %d grids, got %d", numGrids, n))
+	}
+}
+
+func initHintPositions() {
+	idx := 0
+	for a := uint8(0); a < 13; a++ {
+		for b := a + 1; b < 14; b++ {
+			for c := b + 1; c < 15; c++ {
+				for d := c + 1; d < 16; d++ {
+					hintPositionsData[idx] = [4]uint8{a, b, c, d}
+					idx++
+				}
+			}
+		}
+	}
+}
+
+func hintByte(pos uint8, val uint8) uint8 {
+	return 0x40 | (val-1)<<4 | pos
+}
+
+// packHintsToKey - 4 个 hint 排序后打包 (与顺序无关)
+func packHintsToKey(hints [4]uint8) uint32 {
+	for i := 1; i < 4; i++ {
+		for j := i; j > 0 && hints[j-1] > hints[j]; j-- {
+			hints[j-1], hints[j] = hints[j], hints[j-1]
+		}
+	}
+	return uint32(hints[0])<<24 | uint32(hints[1])<<16 | uint32(hints[2])<<8 | uint32(hints[3])
+}
+
+func decodeTableHash(key uint32) uint32 {
+	return (key * 2654435761) >> (32 - decodeTableBits)
+}
+
+func decodeTableInsert(key uint32, val uint8) {
+	hash := decodeTableHash(key)
+	for i := 0; i < decodeTableSize; i++ {
+		if decodeTableKeys[hash] == 0 {
+			decodeTableKeys[hash] = key
+			decodeTableVals[hash] = val
+			return
+		}
+		hash = (hash + 1) & (decodeTableSize - 1)
+	}
+}
+
+func decodeTableLookup(key uint32) (uint8, bool) {
+	hash := decodeTableHash(key)
+	for i := 0; i < decodeTableSize; i++ {
+		if decodeTableKeys[hash] == key {
+			return decodeTableVals[hash], true
+		}
+		if decodeTableKeys[hash] == 0 {
+			return 0, false
+		}
+		hash = (hash + 1) & (decodeTableSize - 1)
+	}
+	return 0, false
+}
+
+// initCodecTables - 按种子构建 4x4 表: LCG 打乱网格顺序，再按 hint 位置组合顺序收集未被占用的 hint 组
+func initCodecTables(seed uint64) {
+	rngState := uint32(seed ^ (seed >> 32))
+	var gridOrder [numGrids]uint16
+	for i := 0; i < numGrids; i++ {
+		gridOrder[i] = uint16(i)
+	}
+	for i := numGrids - 1; i > 0; i-- {
+		rngState = rngState*1664525 + 1013904223
+		j := rngState % uint32(i+1)
+		gridOrder[i], gridOrder[j] = gridOrder[j], gridOrder[i]
+	}
+
+	for byteVal := 0; byteVal < 256; byteVal++ {
+		grid := &allGridsData[gridOrder[byteVal]]
+		count := uint8(0)
+		for hpIdx := 0; hpIdx < numHintPositions && count < maxHintsPerByte; hpIdx++ {
+			hp := hintPositionsData[hpIdx]
+			var hints [4]uint8
+			for i := 0; i < 4; i++ {
+				hints[i] = hintByte(hp[i], grid[hp[i]])
+			}
+			key := packHintsToKey(hints)
+			if _, found := decodeTableLookup(key); !found {
+				encodeTable[byteVal][count] = hints
+				decodeTableInsert(key, uint8(byteVal))
+				count++
+			}
+		}
+		encodeTableCount[byteVal] = count
+	}
+}
+
+// ============================================================================
+// 9x9 profile
+// 每个字节对应 2 个 hint 符号，符号 = cell*9 + (value-1) (0..728)
+// 网格由基础网格经数字重标记、行列置换生成，均为合法 9x9 数独
+// ============================================================================
+
+const (
+	grid9Count      = 256
+	grid9Cells      = 81
+	grid9MaxGroups  = 24
+	grid9DecodeBits = 13
+	grid9DecodeSize = 1 << grid9DecodeBits
+	grid9BucketBits = 11
+	grid9Buckets    = 1 << grid9BucketBits
+)
+
+var (
+	grid9Data        [grid9Count][grid9Cells]uint8
+	grid9EncodeTable [256][grid9MaxGroups][2]uint16
+	grid9EncodeCount [256]uint8
+	grid9DecodeKeys  [grid9DecodeSize]uint32 // 生成编码表时的已用键集合 (线性探测)
+	grid9DecodeVals  [grid9DecodeSize]uint8
+
+	// 完美散列解码表 (与 codec_chd.go / codec_grid9.go 一致)
+	grid9DecodeEntries [grid9DecodeSize]uint32
+	grid9DecodeDisp    [grid9Buckets]uint16
+	grid9DecodeSalt    uint32
+)
+
+func initGrids9() {
+	var seed uint64 = 0x7375646F6B75 ^ 0x3939
+	rngState := uint32(seed ^ (seed >> 32))
+	rngNext := func() uint32 {
+		rngState = rngState*1664525 + 1013904223
+		return rngState
+	}
+	shuffle3 := func() [3]int {
+		p := [3]int{0, 1, 2}
+		for i := 2; i > 0; i-- {
+			j := int(rngNext() % uint32(i+1))
+			p[i], p[j] = p[j], p[i]
+		}
+		return p
+	}
+
+	for g := 0; g < grid9Count; g++ {
+		var digits [9]uint8
+		for i := 0; i < 9; i++ {
+			digits[i] = uint8(i + 1)
+		}
+		for i := 8; i > 0; i-- {
+			j := rngNext() % uint32(i+1)
+			digits[i], digits[j] = digits[j], digits[i]
+		}
+
+		var rows, cols [9]int
+		bands, stacks := shuffle3(), shuffle3()
+		for b := 0; b < 3; b++ {
+			inner := shuffle3()
+			for i := 0; i < 3; i++ {
+				rows[b*3+i] = bands[b]*3 + inner[i]
+			}
+			inner = shuffle3()
+			for i := 0; i < 3; i++ {
+				cols[b*3+i] = stacks[b]*3 + inner[i]
+			}
+		}
+
+		for r := 0; r < 9; r++ {
+			for c := 0; c < 9; c++ {
+				base := (rows[r]*3 + rows[r]/3 + cols[c]) % 9
+				grid9Data[g][r*9+c] = digits[base]
+			}
+		}
+	}
+}
+
+func grid9Key(a, b uint16) uint32 {
+	if a > b {
+		a, b = b, a
+	}
+	return uint32(a)<<16 | uint32(b)
+}
+
+// grid9Hash - 乘法散列取高位 (键的低 16 位只有 729 种取值，直接取低位会严重聚集)
+func grid9Hash(key uint32) uint32 {
+	return (key * 2654435761) >> (32 - 13)
+}
+
+func grid9DecodeInsert(key uint32, val uint8) {
+	hash := grid9Hash(key)
+	for i := 0; i < grid9DecodeSize; i++ {
+		if grid9DecodeKeys[hash] == 0 {
+			grid9DecodeKeys[hash] = key
+			grid9DecodeVals[hash] = val
+			return
+		}
+		hash = (hash + 1) & (grid9DecodeSize - 1)
+	}
+}
+
+func grid9DecodeLookup(key uint32) (uint8, bool) {
+	hash := grid9Hash(key)
+	for i := 0; i < grid9DecodeSize; i++ {
+		if grid9DecodeKeys[hash] == key {
+			return grid9DecodeVals[hash], true
+		}
+		if grid9DecodeKeys[hash] == 0 {
+			return 0, false
+		}
+		hash = (hash + 1) & (grid9DecodeSize - 1)
+	}
+	return 0, false
+}
+
+// ============================================================================
+// 9x9 完美散列解码表 (CHD，散列与 codec_chd.go 一致)
+// 槽位项 = (a<<10 | b)<<8 | 字节值 (键 a<<16 | b，a < b < 729)
+// ============================================================================
+
+func chdMix(x uint32) uint32 {
+	x ^= x >> 16
+	x *= 0x85EBCA6B
+	x ^= x >> 13
+	x *= 0xC2B2AE35
+	x ^= x >> 16
+	return x
+}
+
+func chdSlot(h uint32, d uint16, bits uint32) uint32 {
+	return chdMix(h+uint32(d)*0x9E3779B9+0x7F4A7C15) >> (32 - bits)
+}
+
+func grid9Key20(key uint32) uint32 {
+	return (key>>16)<<10 | key&0x3FF
+}
+
+func initGrid9CHD() {
+	for attempt := uint32(0); attempt < 256; attempt++ {
+		if tryGrid9CHD(attempt * 0x632BE5AB) {
+			return
+		}
+	}
+	panic("9x9 perfect hash: no salt works")
+}
+
+func tryGrid9CHD(salt uint32) bool {
+	grid9DecodeEntries = [grid9DecodeSize]uint32{}
+	grid9DecodeDisp = [grid9Buckets]uint16{}
+	grid9DecodeSalt = salt
+
+	type item struct {
+		key uint32
+		h   uint32
+		val uint8
+	}
+	var buckets [grid9Buckets][]item
+	for b := 0; b < 256; b++ {
+		for g := 0; g < int(grid9EncodeCount[b]); g++ {
+			p := grid9EncodeTable[b][g]
+			key := grid9Key(p[0], p[1])
+			h := chdMix(key ^ salt)
+			bk := h >> (32 - grid9BucketBits)
+			buckets[bk] = append(buckets[bk], item{key, h, uint8(b)})
+		}
+	}
+	order := make([]int, grid9Buckets)
+	for i := range order {
+		order[i] = i
+	}
+	// 大桶先放置，大小相同时按桶号 (确定性输出)
+	sort.SliceStable(order, func(i, j int) bool { return len(buckets[order[i]]) > len(buckets[order[j]]) })
+
+	for _, bk := range order {
+		items := buckets[bk]
+		if len(items) == 0 {
+			break
+		}
+		placed := false
+		for d := 0; d <= 0xFFFF && !placed; d++ {
+			slots := make([]uint32, 0, len(items))
+			placed = true
+			for _, it := range items {
+				slot := chdSlot(it.h, uint16(d), grid9DecodeBits)
+				if grid9DecodeEntries[slot] != 0 {
+					placed = false
+					break
+				}
+				for _, s := range slots {
+					if s == slot {
+						placed = false
+					}
+				}
+				if !placed {
+					break
+				}
+				slots = append(slots, slot)
+			}
+			if placed {
+				grid9DecodeDisp[bk] = uint16(d)
+				for i, it := range items {
+					grid9DecodeEntries[slots[i]] = grid9Key20(it.key)<<8 | uint32(it.val)
+				}
+			}
+		}
+		if !placed {
+			return false
+		}
+	}
+	return true
+}
+
+func initCodecTables9() {
+	var seed uint64 = 0x7375646F6B75 ^ 0x3939
+	rngState := uint32(seed ^ (seed >> 32))
+	rngNext := func() uint32 {
+		rngState = rngState*1664525 + 1013904223
+		return rngState
+	}
+
+	// 所有 cell 对 (a < b)，LCG 打乱顺序
+	const numPairs = grid9Cells * (grid9Cells - 1) / 2
+	var pairs [numPairs][2]uint8
+	idx := 0
+	for a := 0; a < grid9Cells; a++ {
+		for b := a + 1; b < grid9Cells; b++ {
+			pairs[idx] = [2]uint8{uint8(a), uint8(b)}
+			idx++
+		}
+	}
+	for i := numPairs - 1; i > 0; i-- {
+		j := rngNext() % uint32(i+1)
+		pairs[i], pairs[j] = pairs[j], pairs[i]
+	}
+
+	for byteVal := 0; byteVal < 256; byteVal++ {
+		grid := &grid9Data[byteVal]
+		count := uint8(0)
+		start := (byteVal * 97) % numPairs
+		for k := 0; k < numPairs && count < grid9MaxGroups; k++ {
+			p := pairs[(start+k)%numPairs]
+			sa := uint16(p[0])*9 + uint16(grid[p[0]]-1)
+			sb := uint16(p[1])*9 + uint16(grid[p[1]]-1)
+			key := grid9Key(sa, sb)
+			if _, found := grid9DecodeLookup(key); !found {
+				grid9EncodeTable[byteVal][count] = [2]uint16{sa, sb}
+				grid9DecodeInsert(key, uint8(byteVal))
+				count++
+			}
+		}
+		grid9EncodeCount[byteVal] = count
+	}
+}
+
+func main() {
+	jsonPath := flag.String("json", "codec_tables.json", "JSON dump path (empty to skip)")
+	seedFlag := flag.Uint64("seed", defaultCodecSeed, "4x4 codec table seed (keyed 表见 codecSeedFromKey)")
+	flag.Parse()
+	seed := *seedFlag
+
+	fmt.Println("[GEN] Starting data generation...")
+
+	initGrids()
+	initHintPositions()
+	initCodecTables(seed)
+	fmt.Printf("[GEN] Generated 4x4 codec tables (seed 0x%X)\n", seed)
+
+	initGrids9()
+	initCodecTables9()
+	initGrid9CHD()
+	fmt.Println("[GEN] Generated 9x9 codec tables")
+
+	writeGoData()
+	fmt.Println("[GEN] Generated data_generated.go")
+
+	if *jsonPath != "" {
+		writeJSON(*jsonPath, seed)
+		fmt.Printf("[GEN] Generated %s\n", *jsonPath)
+	}
+}
+
+// writeGoData - 输出 9x9 表到 data_generated.go
+func writeGoData() {
+	f, err := os.Create("data_generated.go")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	fmt.Fprintln(f, "// Code generated by gen_data.go; DO NOT EDIT.")
+	fmt.Fprintln(f, "package sudoku")
+	fmt.Fprintln(f)
+
+	// grid9EncodeTable
+	fmt.Fprintln(f, "var grid9EncodeTable = [256][grid9MaxGroups][2]uint16{")
+	for i := 0; i < 256; i++ {
+		fmt.Fprint(f, "\t{")
+		for j := 0; j < grid9MaxGroups; j++ {
+			fmt.Fprintf(f, "{%d, %d},", grid9EncodeTable[i][j][0], grid9EncodeTable[i][j][1])
+		}
+		fmt.Fprintln(f, "},")
+	}
+	fmt.Fprintln(f, "}")
+	fmt.Fprintln(f)
+
+	// grid9EncodeCount
+	fmt.Fprintln(f, "var grid9EncodeCount = [256]uint8{")
+	for i := 0; i < 256; i++ {
+		if i%16 == 0 {
+			fmt.Fprint(f, "\t")
+		}
+		fmt.Fprintf(f, "%d,", grid9EncodeCount[i])
+		if i%16 == 15 {
+			fmt.Fprintln(f)
+		}
+	}
+	fmt.Fprintln(f, "}")
+	fmt.Fprintln(f)
+
+	// grid9DecodeEntries / grid9DecodeDisp (完美散列解码表，见 codec_chd.go)
+	fmt.Fprintf(f, "const grid9DecodeSalt = 0x%08X\n\n", grid9DecodeSalt)
+	fmt.Fprintln(f, "var grid9DecodeEntries = [grid9DecodeSize]uint32{")
+	for i := 0; i < grid9DecodeSize; i++ {
+		if i%8 == 0 {
+			fmt.Fprint(f, "\t")
+		}
+		fmt.Fprintf(f, "0x%08X,", grid9DecodeEntries[i])
+		if i%8 == 7 {
+			fmt.Fprintln(f)
+		}
+	}
+	fmt.Fprintln(f, "}")
+	fmt.Fprintln(f)
+
+	fmt.Fprintln(f, "var grid9DecodeDisp = [grid9Buckets]uint16{")
+	for i := 0; i < grid9Buckets; i++ {
+		if i%16 == 0 {
+			fmt.Fprint(f, "\t")
+		}
+		fmt.Fprintf(f, "%d,", grid9DecodeDisp[i])
+		if i%16 == 15 {
+			fmt.Fprintln(f)
+		}
+	}
+	fmt.Fprintln(f, "}")
+}
+
+// tableDump - JSON 结构
+// hint 组以 8 位十六进制串表示 (4x4: 4 个 hint 字节；9x9: 2 个 uint16 符号)，按编码表中的顺序排列；
+// 解码表即编码表的逆映射，不单独输出 (散列布局属实现细节)
+type tableDump struct {
+	Grid4 struct {
+		Seed          string     `json:"seed"`
+		Grids         [][]uint8  `json:"grids"`
+		HintPositions [][]uint8  `json:"hintPositions"`
+		Encode        [][]string `json:"encode"`
+	} `json:"grid4"`
+	Grid9 struct {
+		Grids  [][]uint8  `json:"grids"`
+		Encode [][]string `json:"encode"`
+	} `json:"grid9"`
+}
+
+func writeJSON(path string, seed uint64) {
+	var d tableDump
+	d.Grid4.Seed = fmt.Sprintf("0x%X", seed)
+	for i := range allGridsData {
+		d.Grid4.Grids = append(d.Grid4.Grids, append([]uint8(nil), allGridsData[i][:]...))
+	}
+	for i := range hintPositionsData {
+		d.Grid4.HintPositions = append(d.Grid4.HintPositions, append([]uint8(nil), hintPositionsData[i][:]...))
+	}
+	for b := 0; b < 256; b++ {
+		groups := make([]string, 0, encodeTableCount[b])
+		for j := 0; j < int(encodeTableCount[b]); j++ {
+			h := encodeTable[b][j]
+			groups = append(groups, fmt.Sprintf("%02x%02x%02x%02x", h[0], h[1], h[2], h[3]))
+		}
+		d.Grid4.Encode = append(d.Grid4.Encode, groups)
+	}
+	for i := range grid9Data {
+		d.Grid9.Grids = append(d.Grid9.Grids, append([]uint8(nil), grid9Data[i][:]...))
+	}
+	for b := 0; b < 256; b++ {
+		groups := make([]string, 0, grid9EncodeCount[b])
+		for j := 0; j < int(grid9EncodeCount[b]); j++ {
+			p := grid9EncodeTable[b][j]
+			groups = append(groups, fmt.Sprintf("%04x%04x", p[0], p[1]))
+		}
+		d.Grid9.Encode = append(d.Grid9.Encode, groups)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	if err := enc.Encode(&d); err != nil {
+		panic(err)
+	}
+}
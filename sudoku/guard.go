@@ -0,0 +1,64 @@
+// 守护金丝雀 - arena 各区域之间留 arenaGuardSize 字节的间隔，initWasm 写入固定图样
+// 宿主越界写入 (或模块内部缓冲区溢出) 跨过区域边界时会破坏对应金丝雀，checkArenaIntegrity 可检测
+// 边界编号:
+//   1 session 数组 | workBuf   [workBufBase-16, workBufBase)
+//   2 workBuf | outBuf         [workBufBase+workBufSize, +16)
+//   3 outBuf | 自由分配区       [outBufBase+outBufSize, +16)
+//   4 自由分配区 | codec 表区   [heapEnd, +16)
+
+package sudoku
+
+import "encoding/binary"
+
+const (
+	arenaGuardSize  = 16
+	arenaGuardCount = 4
+	arenaCanaryWord = uint32(0x5D0C0A7A)
+)
+
+// 各边界金丝雀的起始地址 (下标 0 对应边界 1)
+var arenaGuardBase = [arenaGuardCount]uint32{
+	workBufBase - arenaGuardSize,
+	workBufBase + workBufSize,
+	outBufBase + outBufSize,
+	heapEnd,
+}
+
+// 第 i 个边界的金丝雀字: 混入边界编号，防止整段数据平移后仍能通过检查
+func arenaCanaryFor(i int) uint32 {
+	return arenaCanaryWord ^ uint32(i+1)*0x01010101
+}
+
+// writeArenaCanaries - 在所有边界写入金丝雀 (小端 u32 重复填满 arenaGuardSize)
+func writeArenaCanaries() {
+	for i := 0; i < arenaGuardCount; i++ {
+		base := arenaGuardBase[i]
+		w := arenaCanaryFor(i)
+		for off := uint32(0); off < arenaGuardSize; off += 4 {
+			binary.LittleEndian.PutUint32(arena[base+off:base+off+4], w)
+		}
+	}
+}
+
+// checkArenaIntegrity - 检查各区域边界的金丝雀是否完好
+// 返回值: 0 完好
+//
+//	1..4 第一个被破坏的边界编号 (见文件头)
+//	-1 模块未初始化
+//
+//export checkArenaIntegrity
+func checkArenaIntegrity() int32 {
+	if !wasmInitialized {
+		return -1
+	}
+	for i := 0; i < arenaGuardCount; i++ {
+		base := arenaGuardBase[i]
+		w := arenaCanaryFor(i)
+		for off := uint32(0); off < arenaGuardSize; off += 4 {
+			if binary.LittleEndian.Uint32(arena[base+off:base+off+4]) != w {
+				return int32(i + 1)
+			}
+		}
+	}
+	return 0
+}
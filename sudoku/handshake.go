@@ -0,0 +1,177 @@
+// 握手 - 在模块内完成 X25519 临时密钥交换，直接得到传输密钥
+// 双方 (RoleClient / RoleServer，以预共享密钥创建 session) 各发送一条握手消息:
+//   [hello (24 字节，见 hello.go)][X25519 临时公钥 (32 字节)]
+// 收到对端消息后:
+//   transcript = SHA-256(client 消息 || server 消息)
+//   PRK        = HKDF-Extract(transcript, 共享密钥 || client_write 预共享密钥 || server_write 预共享密钥)
+//   传输密钥按角色由 PRK 派生 client_write/server_write (同 initSession)，两个方向的 counter 归零
+// 预共享密钥参与派生，未持有该密钥的中间人无法得到相同的传输密钥
+
+package sudoku
+
+const handshakeMsgSize = helloSize + x25519Size
+
+// 握手阶段
+const (
+	handshakeIdle    = 0
+	handshakeStarted = 1 // 已发送本端消息，等待对端
+	handshakeDone    = 2 // 传输密钥已就绪
+)
+
+// 每个 session 的握手状态 (临时私钥在握手完成后清零)
+// 本端消息原样保存用于 transcript (hello 含时间戳，不能在 consume 时重新生成)
+type handshakeState struct {
+	priv  [x25519Size]byte
+	msg   [handshakeMsgSize]byte
+	phase uint8
+}
+
+var sessionHandshake [maxSessions]handshakeState
+
+// handshakeStart - 以宿主提供的 32 字节随机数作为临时私钥，生成本端握手消息写入 arena[outPtr:outPtr+56]
+// 返回值: 消息长度 (56)
+//
+//	-1 session 无效
+//	-2 私钥或输出越界
+//	-3 session 为 RoleShared (握手需要区分 client/server)
+//	-4 握手已开始或已完成
+//
+//export handshakeStart
+func handshakeStart(id int32, privPtr uint32, outPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(privPtr, x25519Size) {
+		return -2
+	}
+	var priv [x25519Size]byte
+	copy(priv[:], arena[privPtr:privPtr+x25519Size])
+	r := handshakeBegin(id, &priv, outPtr)
+	priv = [x25519Size]byte{}
+	return r
+}
+
+// handshakeBegin - handshakeStart / handshakeStartRandom 的共同部分，返回值同 handshakeStart
+func handshakeBegin(id int32, priv *[x25519Size]byte, outPtr uint32) int32 {
+	if !inArenaRange(outPtr, handshakeMsgSize) {
+		return -2
+	}
+	session := getSession(id)
+	if session.sudokuState[stateRole] == RoleShared {
+		return -3
+	}
+	hs := &sessionHandshake[id]
+	if hs.phase != handshakeIdle {
+		return -4
+	}
+
+	hs.priv = *priv
+	var pub [x25519Size]byte
+	x25519ScalarMult(&pub, &hs.priv, &x25519Basepoint)
+	helloEncode(session, hs.msg[:helloSize])
+	copy(hs.msg[helloSize:], pub[:])
+	hs.phase = handshakeStarted
+
+	copy(arena[outPtr:outPtr+handshakeMsgSize], hs.msg[:])
+	return handshakeMsgSize
+}
+
+// handshakeConsume - 处理对端握手消息，成功后 session 切换到传输密钥
+// 返回值: 0 成功
+//
+//	-1 session 无效
+//	-2 输入越界或长度不足
+//	-3 未调用 handshakeStart 或握手已完成
+//	-4 对端 hello 被拒绝 (版本/参数不一致或时间戳超出窗口，见 parseHello)
+//	-5 对端公钥无效 (低阶点)
+//
+//export handshakeConsume
+func handshakeConsume(id int32, inPtr uint32, inLen uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if inLen < handshakeMsgSize || !inArenaRange(inPtr, inLen) {
+		return -2
+	}
+	hs := &sessionHandshake[id]
+	if hs.phase != handshakeStarted {
+		return -3
+	}
+	// 公钥校验通过后才锁定线路版本，失败的消息不影响重试；宿主已 parseHello 过同一 hello 时视为一致
+	session := getSession(id)
+	version := helloValidate(session, arena[inPtr:inPtr+helloSize])
+	if version < 0 || helloConflicts(session, version) {
+		return -4
+	}
+	role := session.sudokuState[stateRole]
+
+	var peerPub, shared [x25519Size]byte
+	copy(peerPub[:], arena[inPtr+helloSize:inPtr+handshakeMsgSize])
+	if !x25519(&shared, &hs.priv, &peerPub) {
+		return -5
+	}
+	session.sudokuState[stateWireVersion] = uint8(version)
+
+	// transcript: client 消息在前
+	localMsg := hs.msg[:]
+	peerMsg := arena[inPtr : inPtr+handshakeMsgSize]
+
+	var ctx sha256Context
+	sha256Init(&ctx)
+	if role == RoleClient {
+		sha256Update(&ctx, localMsg, handshakeMsgSize)
+		sha256Update(&ctx, peerMsg, handshakeMsgSize)
+	} else {
+		sha256Update(&ctx, peerMsg, handshakeMsgSize)
+		sha256Update(&ctx, localMsg, handshakeMsgSize)
+	}
+	var transcript [sha256Size]byte
+	sha256Finalize(&ctx, &transcript)
+
+	// 早期密钥由预共享密钥派生，须在其被传输密钥替换之前处理 (见 earlydata.go)
+	earlyOnConsume(id, session, peerMsg)
+
+	// 预共享的方向密钥按 client_write、server_write 顺序拼接，两端一致
+	rxKey := sessionRxKeyOf(session)
+	clientKey, serverKey := &session.key, rxKey
+	if role == RoleServer {
+		clientKey, serverKey = rxKey, &session.key
+	}
+	var ikm [x25519Size + 64]byte
+	copy(ikm[0:32], shared[:])
+	copy(ikm[32:64], clientKey[:])
+	copy(ikm[64:96], serverKey[:])
+
+	var prk [sha256Size]byte
+	hkdfExtract(&prk, transcript[:], ikm[:])
+	deriveDirectionalKeys(prk[:], role, cipherKeyLen(session.cipherType), &session.key, rxKey)
+
+	session.txCounter = 0
+	session.rxCounter = 0
+	session.replayBitmap = 0
+	rk := &sessionRekey[id]
+	rk.txFrames, rk.rxFrames, rk.txBytes, rk.rxBytes = 0, 0, 0, 0
+
+	for i := range ikm {
+		ikm[i] = 0
+	}
+	shared = [x25519Size]byte{}
+	prk = [sha256Size]byte{}
+	hs.priv = [x25519Size]byte{}
+	hs.phase = handshakeDone
+	return 0
+}
+
+// handshakeIsDone - 握手是否已完成
+// 返回值: 1 已完成, 0 未完成, -1 session 无效
+//
+//export handshakeIsDone
+func handshakeIsDone(id int32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if sessionHandshake[id].phase == handshakeDone {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,197 @@
+// 线路版本协商 - hello 帧
+// 双方各自 buildHello 发送自己的版本范围与会话参数，收到对端 hello 后 parseHello 校验并锁定配置
+// hello 帧布局 (24 字节):
+//   [0:8]   magic "SUDOKUV2" (与 sudokuState 中的 magic 一致)
+//   [8]     支持的最低线路版本
+//   [9]     支持的最高线路版本
+//   [10]    cipherType
+//   [11]    layoutType
+//   [12]    gridProfile
+//   [13]    role
+//   [14:16] 混淆的 identity hint (PSK session，见 psk.go)，否则为 0
+//   [16:24] 混淆时间戳: UNIX 秒 (大端序) XOR HMAC-SHA256(发送方向密钥, "sudoku hello time" || [0:16])[0:8]，计算时 [14:16] 视为 0
+// 时间戳抵御对旧 hello 的重放: 接收方以自身时钟 (setUnixTime) 校验，偏差超过窗口 (setHelloTimeWindow) 时拒绝；
+// 宿主从未 setUnixTime 时不校验，不设置时间的宿主仍按原有调用顺序工作
+
+package sudoku
+
+import "encoding/binary"
+
+// 本模块支持的线路版本范围
+const (
+	wireVersionMin = 2
+	wireVersionMax = 2
+)
+
+const helloSize = 24
+
+// 宿主提供的当前 UNIX 时间 (秒)，0 表示未设置
+var unixTime uint32
+
+// 时间戳允许的偏差 (秒)，0 表示不校验；unixTime 未设置时同样不校验
+var helloTimeWindow uint32 = 60
+
+var helloTimeLabel = []byte("sudoku hello time")
+
+// setUnixTime - 设置当前 UNIX 时间 (秒)，buildHello 写入、parseHello 校验时使用
+//
+//export setUnixTime
+func setUnixTime(now uint32) {
+	unixTime = now
+}
+
+// setHelloTimeWindow - 设置 hello 时间戳允许的偏差 (±seconds)，默认 60，0 关闭校验
+//
+//export setHelloTimeWindow
+func setHelloTimeWindow(seconds uint32) {
+	helloTimeWindow = seconds
+}
+
+// helloTimeMask - 时间戳混淆掩码 (由 hello 前 16 字节与该方向密钥决定)
+func helloTimeMask(key *[32]byte, header []byte, mask *[8]byte) {
+	var ctx hmacContext
+	var sum [sha256Size]byte
+	hmacInit(&ctx, key[:])
+	hmacUpdate(&ctx, helloTimeLabel, len(helloTimeLabel))
+	hmacUpdate(&ctx, header, 16)
+	hmacFinalize(&ctx, &sum)
+	copy(mask[:], sum[:8])
+}
+
+// buildHello - 将本端的版本范围、会话参数与混淆时间戳写入 arena[outPtr:outPtr+24]
+// 返回值: 帧长度 (24), -1 session 无效, -2 输出越界
+//
+//export buildHello
+func buildHello(id int32, outPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(outPtr, helloSize) {
+		return -2
+	}
+	helloEncode(getSession(id), arena[outPtr:outPtr+helloSize])
+	return helloSize
+}
+
+// helloEncode - 按 session 配置填充 hello 帧 (len(out) >= helloSize)
+func helloEncode(session *SudokuInstance, out []byte) {
+	state := &session.sudokuState
+	copy(out[0:8], state[stateMagic:stateMagic+8])
+	out[8] = wireVersionMin
+	out[9] = wireVersionMax
+	out[10] = session.cipherType
+	out[11] = state[stateLayoutType]
+	out[12] = state[stateGridProfile]
+	out[13] = state[stateRole]
+	out[14] = 0
+	out[15] = 0
+
+	var mask [8]byte
+	helloTimeMask(&session.key, out[0:16], &mask)
+	binary.BigEndian.PutUint64(out[16:24], uint64(unixTime)^binary.BigEndian.Uint64(mask[:]))
+	helloPutHint(session, out)
+}
+
+// parseHello - 校验对端 hello 帧并锁定会话配置
+// 版本取双方范围交集中的最高版本；cipherType、layoutType、gridProfile 必须一致，
+// role 必须同为 RoleShared，或一端 RoleClient 一端 RoleServer
+// 返回值: 协商出的线路版本 (>0)
+//
+//	-1 session 无效
+//	-2 输入越界或长度不足
+//	-3 magic 不匹配
+//	-4 版本范围无交集
+//	-5 cipherType 不一致
+//	-6 layoutType 或 gridProfile 不一致
+//	-7 role 冲突
+//	-8 已协商出不同的版本 (配置已锁定)；再次处理协商出同一版本的 hello 返回该版本
+//	-9 时间戳超出允许窗口 (疑似重放)
+//	-10 本端为 PSK session 且对端 identity hint 不一致
+//
+//export parseHello
+func parseHello(id int32, inPtr uint32, inLen uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if inLen < helloSize || !inArenaRange(inPtr, inLen) {
+		return -2
+	}
+	session := getSession(id)
+	version := helloValidate(session, arena[inPtr:inPtr+helloSize])
+	if version < 0 {
+		return version
+	}
+	if helloConflicts(session, version) {
+		return -8
+	}
+	session.sudokuState[stateWireVersion] = uint8(version)
+	return version
+}
+
+// helloConflicts - session 是否已锁定为与 version 不同的线路版本
+func helloConflicts(session *SudokuInstance, version int32) bool {
+	w := session.sudokuState[stateWireVersion]
+	return w != 0 && int32(w) != version
+}
+
+// helloValidate - 校验对端 hello (helloSize 字节) 但不锁定配置
+// 返回值: 协商出的线路版本 (>0)，或 parseHello 的 -3..-7、-9、-10
+func helloValidate(session *SudokuInstance, in []byte) int32 {
+	state := &session.sudokuState
+	for i := 0; i < 8; i++ {
+		if in[i] != state[stateMagic+i] {
+			return -3
+		}
+	}
+
+	peerMin, peerMax := in[8], in[9]
+	version := uint8(wireVersionMax)
+	if peerMax < version {
+		version = peerMax
+	}
+	if version < wireVersionMin || version < peerMin {
+		return -4
+	}
+
+	if in[10] != session.cipherType {
+		return -5
+	}
+	if in[11] != state[stateLayoutType] || in[12] != state[stateGridProfile] {
+		return -6
+	}
+	if !rolesCompatible(state[stateRole], in[13]) {
+		return -7
+	}
+	if session.flags&sessionFlagPSK != 0 && helloHint(in) != binary.BigEndian.Uint16(state[stateIdentityHint:stateIdentityHint+2]) {
+		return -10
+	}
+	if helloTimeWindow != 0 && unixTime != 0 {
+		var header [16]byte
+		copy(header[0:14], in[0:14])
+		var mask [8]byte
+		helloTimeMask(sessionRxKeyOf(session), header[:], &mask)
+		ts := binary.BigEndian.Uint64(in[16:24]) ^ binary.BigEndian.Uint64(mask[:])
+		now := uint64(unixTime)
+		diff := now - ts
+		if ts > now {
+			diff = ts - now
+		}
+		if diff > uint64(helloTimeWindow) {
+			return -9
+		}
+	}
+	return int32(version)
+}
+
+// rolesCompatible - 本端与对端 role 是否能组成一条连接
+func rolesCompatible(local, peer uint8) bool {
+	switch local {
+	case RoleShared:
+		return peer == RoleShared
+	case RoleClient:
+		return peer == RoleServer
+	case RoleServer:
+		return peer == RoleClient
+	}
+	return false
+}
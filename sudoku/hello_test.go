@@ -0,0 +1,103 @@
+package sudoku
+
+import (
+	"bytes"
+	"testing"
+)
+
+// helloTestSession - 以固定密钥创建 session
+func helloTestSession(t *testing.T, role uint8) int32 {
+	t.Helper()
+	id := initSessionKey(bytes.Repeat([]byte{0x42}, 32), CipherChaCha20Poly, LayoutASCII, GridProfile4x4, role, nil)
+	if id < 0 {
+		t.Fatalf("initSessionKey: %d", id)
+	}
+	t.Cleanup(func() { closeSession(id) })
+	return id
+}
+
+// 未调用 setUnixTime 的宿主 (hello 引入时的调用顺序): buildHello -> parseHello 与模块内握手都应成功
+func TestHelloWithoutUnixTime(t *testing.T) {
+	initWasm()
+	savedTime, savedWindow := unixTime, helloTimeWindow
+	t.Cleanup(func() { unixTime, helloTimeWindow = savedTime, savedWindow })
+	unixTime, helloTimeWindow = 0, 60
+
+	a := helloTestSession(t, RoleShared)
+	b := helloTestSession(t, RoleShared)
+	out := uint32(workBufBase)
+	if r := buildHello(a, out); r != helloSize {
+		t.Fatalf("buildHello = %d", r)
+	}
+	if r := parseHello(b, out, helloSize); r != wireVersionMax {
+		t.Fatalf("parseHello = %d, want %d", r, wireVersionMax)
+	}
+
+	cl := helloTestSession(t, RoleClient)
+	sv := helloTestSession(t, RoleServer)
+	priv := out + 256
+	for i := uint32(0); i < x25519Size; i++ {
+		arena[priv+i] = byte(i + 1)
+	}
+	clMsg, svMsg := out+512, out+1024
+	if r := handshakeStart(cl, priv, clMsg); r != handshakeMsgSize {
+		t.Fatalf("handshakeStart(client) = %d", r)
+	}
+	arena[priv] ^= 0xFF
+	if r := handshakeStart(sv, priv, svMsg); r != handshakeMsgSize {
+		t.Fatalf("handshakeStart(server) = %d", r)
+	}
+	if r := handshakeConsume(sv, clMsg, handshakeMsgSize); r != 0 {
+		t.Fatalf("handshakeConsume(server) = %d", r)
+	}
+	if r := handshakeConsume(cl, svMsg, handshakeMsgSize); r != 0 {
+		t.Fatalf("handshakeConsume(client) = %d", r)
+	}
+	if getSession(cl).key != sessionRxKey[sv] {
+		t.Fatal("client_write key mismatch after handshake")
+	}
+}
+
+// 公钥无效的握手消息不锁定线路版本: 重试应成功；宿主先 parseHello 同一 hello 也不影响 handshakeConsume
+func TestHandshakeConsumeRetry(t *testing.T) {
+	initWasm()
+	cl := helloTestSession(t, RoleClient)
+	sv := helloTestSession(t, RoleServer)
+	out := uint32(workBufBase)
+	priv := out + 256
+	for i := uint32(0); i < x25519Size; i++ {
+		arena[priv+i] = byte(i + 7)
+	}
+	clMsg, svMsg, bad := out+512, out+1024, out+1536
+	if r := handshakeStart(cl, priv, clMsg); r != handshakeMsgSize {
+		t.Fatalf("handshakeStart(client) = %d", r)
+	}
+	arena[priv] ^= 0xFF
+	if r := handshakeStart(sv, priv, svMsg); r != handshakeMsgSize {
+		t.Fatalf("handshakeStart(server) = %d", r)
+	}
+
+	copy(arena[bad:bad+helloSize], arena[clMsg:clMsg+helloSize])
+	for i := uint32(helloSize); i < handshakeMsgSize; i++ {
+		arena[bad+i] = 0
+	}
+	if r := handshakeConsume(sv, bad, handshakeMsgSize); r != -5 {
+		t.Fatalf("handshakeConsume(low-order key) = %d, want -5", r)
+	}
+	if r := handshakeConsume(sv, clMsg, handshakeMsgSize); r != 0 {
+		t.Fatalf("handshakeConsume retry = %d", r)
+	}
+
+	if r := parseHello(cl, svMsg, helloSize); r != wireVersionMax {
+		t.Fatalf("parseHello = %d", r)
+	}
+	if r := parseHello(cl, svMsg, helloSize); r != wireVersionMax {
+		t.Fatalf("repeated parseHello = %d", r)
+	}
+	if r := handshakeConsume(cl, svMsg, handshakeMsgSize); r != 0 {
+		t.Fatalf("handshakeConsume after parseHello = %d", r)
+	}
+	if getSession(cl).key != sessionRxKey[sv] {
+		t.Fatal("client_write key mismatch after handshake")
+	}
+}
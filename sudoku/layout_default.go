@@ -0,0 +1,26 @@
+//go:build !arenasmall && !arenalarge
+
+// 默认内存布局: 2MB arena, 1024 个 session, 7 个 codec 表槽位
+//   [0x000000 - 0x020000]  SudokuInstance 静态数组 (1024 * 128 bytes)
+//   [0x040000 - 0x05FFF0]  临时缓冲区 (workBuf)
+//   [0x060000 - 0x07FFF0]  输出缓冲区 (outBuf)
+//   [0x080000 - 0x0FFFF0]  自由分配区 (Bump Pointer)
+//   [0x100000 - 0x200000]  查表数据区 (编码/解码表，运行时构建)
+// 各区域之间各留 16 字节守护金丝雀 (见 guard.go)
+
+package sudoku
+
+const (
+	arenaSize   = 1 << 21
+	maxSessions = 1024
+
+	workBufBase = 0x40000
+	workBufSize = 0x1FFF0
+	outBufBase  = 0x60000
+	outBufSize  = 0x1FFF0
+	heapBase    = 0x80000
+	heapEnd     = 0x0FFFF0
+	tableBase   = 0x100000
+
+	maxCodecTables = 7
+)
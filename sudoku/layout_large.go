@@ -0,0 +1,26 @@
+//go:build arenalarge
+
+// 边缘节点内存布局: 16MB arena, 8192 个 session, 15 个 codec 表槽位
+//   [0x000000 - 0x100000]  SudokuInstance 静态数组 (8192 * 128 bytes)
+//   [0x100010 - 0x13FFF0]  临时缓冲区 (workBuf)
+//   [0x140000 - 0x17FFF0]  输出缓冲区 (outBuf)
+//   [0x180000 - 0xDFFFF0]  自由分配区 (Bump Pointer)
+//   [0xE00000 - 0x1000000] 查表数据区 (编码/解码表，运行时构建)
+// 各区域之间各留 16 字节守护金丝雀 (见 guard.go)
+
+package sudoku
+
+const (
+	arenaSize   = 1 << 24
+	maxSessions = 8192
+
+	workBufBase = 0x100010
+	workBufSize = 0x03FFE0
+	outBufBase  = 0x140000
+	outBufSize  = 0x03FFF0
+	heapBase    = 0x180000
+	heapEnd     = 0xDFFFF0
+	tableBase   = 0xE00000
+
+	maxCodecTables = 15
+)
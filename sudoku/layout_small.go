@@ -0,0 +1,26 @@
+//go:build arenasmall
+
+// 嵌入式内存布局: 256KB arena, 64 个 session, 仅默认 codec 表 (LayoutKeyed 不可用)
+//   [0x00000 - 0x02000]  SudokuInstance 静态数组 (64 * 128 bytes)
+//   [0x04000 - 0x0BFF0]  临时缓冲区 (workBuf)
+//   [0x0C000 - 0x13FF0]  输出缓冲区 (outBuf)
+//   [0x14000 - 0x1EFF0]  自由分配区 (Bump Pointer)
+//   [0x1F000 - 0x40000]  查表数据区 (1 个槽位)
+// 各区域之间各留 16 字节守护金丝雀 (见 guard.go)
+
+package sudoku
+
+const (
+	arenaSize   = 1 << 18
+	maxSessions = 64
+
+	workBufBase = 0x04000
+	workBufSize = 0x07FF0
+	outBufBase  = 0x0C000
+	outBufSize  = 0x07FF0
+	heapBase    = 0x14000
+	heapEnd     = 0x1EFF0
+	tableBase   = 0x1F000
+
+	maxCodecTables = 1
+)
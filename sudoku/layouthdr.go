@@ -0,0 +1,114 @@
+// 布局头 - 开启 SessionOptLayoutHeader 后，发送方向 mask 流的最前面先输出 8 字节布局头，
+// 接收方解码后与本端配置逐字节比较并剥离，不符时立即失败 (errLayoutMismatch)，而不是输出乱码或逐组计为解码错误
+// 布局头格式 (与数据一样经 mask 编码):
+//   [0]   0xA7
+//   [1]   layoutType
+//   [2]   gridProfile
+//   [3]   伪装流量 profile
+//   [4:8] SHA-256("sudoku layout" || codec 表种子 (8, 大端序) || padding 标记)[0:4]
+// 对 mask/maskFrame/sealAndMask 等所有发送路径生效 (只在整个 session 的第一次输出中出现一次)，双方须同时开启，且应在收发任何数据前设置；
+// 数据报模式下报文可能丢失，不发送也不校验布局头
+// 首次输出因此多出至多 8*maskPerByte 字节，设置了 MTU 时首块可能超出 MTU 该长度
+
+package sudoku
+
+import "encoding/binary"
+
+const (
+	layoutHeaderSize     = 8
+	layoutHeaderMagic    = 0xA7
+	layoutHeaderMismatch = 0xFF // stateLayoutRx: 已判定不符，之后的解码调用一律失败
+)
+
+const (
+	stateLayoutTx = 60 // 布局头已发送的字节数
+	stateLayoutRx = 61 // 布局头已校验的字节数，或 layoutHeaderMismatch
+)
+
+var layoutLabel = []byte("sudoku layout")
+
+// layoutHeaderActive - session 是否收发布局头
+func layoutHeaderActive(session *SudokuInstance) bool {
+	return session.flags&sessionFlagLayoutHeader != 0 && session.flags&sessionFlagDatagram == 0
+}
+
+// layoutHeaderBuild - 按 session 当前配置生成期望的布局头
+func layoutHeaderBuild(state *[64]byte, hdr *[layoutHeaderSize]byte) {
+	var seed [8]byte
+	binary.BigEndian.PutUint64(seed[:], sessionCodecTable(state).seed)
+
+	var ctx sha256Context
+	var digest [sha256Size]byte
+	sha256Init(&ctx)
+	sha256Update(&ctx, layoutLabel, len(layoutLabel))
+	sha256Update(&ctx, seed[:], 8)
+	sha256Update(&ctx, state[statePadMarker:statePadMarker+1], 1)
+	sha256Finalize(&ctx, &digest)
+
+	hdr[0] = layoutHeaderMagic
+	hdr[1] = state[stateLayoutType]
+	hdr[2] = state[stateGridProfile]
+	hdr[3] = state[stateCoverProfile]
+	copy(hdr[4:8], digest[0:4])
+}
+
+// layoutHeaderReserve - 尚未发出的布局头的最坏 mask 输出长度，供调用方预留输出区
+func layoutHeaderReserve(id int32) uint32 {
+	session := getSession(id)
+	sent := uint32(session.sudokuState[stateLayoutTx])
+	if !layoutHeaderActive(session) || sent >= layoutHeaderSize {
+		return 0
+	}
+	return (layoutHeaderSize - sent) * maskPerByte(&session.sudokuState)
+}
+
+// maskLayoutHeader - 将尚未发出的布局头 mask 到 out (最多 maxOut 字节)，返回输出长度
+// 放不下的部分留待下一次输出，RNG 与游标照常推进，流保持连续
+func maskLayoutHeader(id int32, out uint32, maxOut uint32) uint32 {
+	session := getSession(id)
+	state := &session.sudokuState
+	sent := state[stateLayoutTx]
+	if !layoutHeaderActive(session) || sent >= layoutHeaderSize {
+		return 0
+	}
+	var hdr [layoutHeaderSize]byte
+	layoutHeaderBuild(state, &hdr)
+	n, consumed := maskInput(id, hdr[sent:], out, maxOut)
+	state[stateLayoutTx] = sent + uint8(consumed)
+	return n
+}
+
+// layoutHeaderRxPending - 接收方向是否仍需校验布局头
+func layoutHeaderRxPending(id int32) bool {
+	session := getSession(id)
+	return layoutHeaderActive(session) && session.sudokuState[stateLayoutRx] != layoutHeaderSize
+}
+
+// layoutHeaderCheck - 校验并剥离 out 起 n 字节解码输出中属于布局头的部分
+// 布局头尚未收齐而本次调用出现了解码错误 (decodeErr)，同样视为不符
+// 返回值: (剥离后的输出长度, 是否成功)
+func layoutHeaderCheck(id int32, out uint32, n uint32, ok bool, decodeErr bool) (uint32, bool) {
+	state := &getSession(id).sudokuState
+	var hdr [layoutHeaderSize]byte
+	layoutHeaderBuild(state, &hdr)
+
+	pos := uint32(state[stateLayoutRx])
+	k := layoutHeaderSize - pos
+	if k > n {
+		k = n
+	}
+	match := !decodeErr || pos+k == layoutHeaderSize
+	for i := uint32(0); i < k && match; i++ {
+		if arena[out+i] != hdr[pos+i] {
+			match = false
+		}
+	}
+	if !match {
+		state[stateLayoutRx] = layoutHeaderMismatch
+		logEvent(LogLevelWarn, logLayoutMismatch, uint32(id))
+		return 0, false
+	}
+	state[stateLayoutRx] = uint8(pos + k)
+	copy(arena[out:out+n-k], arena[out+k:out+n])
+	return n - k, ok
+}
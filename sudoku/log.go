@@ -0,0 +1,70 @@
+// 调试日志 - 通过宿主导入函数 env.logEvent(level, codePtr, codeLen) 输出结构化事件
+// 事件文本: "<事件名>" 或 "<事件名> <参数>"，参数为十进制整数 (session ID 或请求大小)
+// 默认关闭，宿主调用 setLogLevel 开启；低于当前级别的事件不会跨越 Wasm 边界
+
+package sudoku
+
+import "unsafe"
+
+const (
+	LogLevelOff   = 0
+	LogLevelError = 1
+	LogLevelWarn  = 2
+	LogLevelInfo  = 3
+	LogLevelDebug = 4
+)
+
+// 事件名
+const (
+	logSessionOpen      = "session.open"      // 参数: session ID
+	logSessionClose     = "session.close"     // 参数: session ID
+	logSessionExhausted = "session.exhausted" // session 槽位已用尽
+	logDecodeError      = "decode.error"      // 参数: session ID，本次调用有无法解码的 hint 组
+	logTagFailure       = "aead.tag"          // 参数: session ID，AEAD 认证失败
+	logArenaExhausted   = "arena.exhausted"   // 参数: 请求的字节数
+	logPeerClose        = "session.peerclose" // 参数: session ID，收到对端的关闭记录
+	logIdentityQuota    = "session.quota"     // 参数: identity 当前的 session 数，已达配额
+	logLayoutMismatch   = "layout.mismatch"   // 参数: session ID，对端布局头与本端配置不符
+)
+
+// logNoArg - 事件不带参数
+const logNoArg = ^uint32(0)
+
+var logLevel uint32 = LogLevelOff
+var logBuf [64]byte
+
+// setLogLevel - 设置日志级别 (LogLevelOff..LogLevelDebug)，返回之前的级别
+//
+//export setLogLevel
+func setLogLevel(level uint32) uint32 {
+	prev := logLevel
+	if level > LogLevelDebug {
+		level = LogLevelDebug
+	}
+	logLevel = level
+	return prev
+}
+
+// logEvent - 级别不高于当前日志级别时，格式化事件并交给宿主
+func logEvent(level uint32, name string, arg uint32) {
+	if level > logLevel {
+		return
+	}
+	n := copy(logBuf[:], name)
+	if arg != logNoArg {
+		logBuf[n] = ' '
+		n++
+		var digits [10]byte
+		d := len(digits)
+		for {
+			d--
+			digits[d] = byte('0' + arg%10)
+			arg /= 10
+			if arg == 0 {
+				break
+			}
+		}
+		n += copy(logBuf[n:], digits[d:])
+	}
+	hostLogEvent(level, uint32(uintptr(unsafe.Pointer(&logBuf[0]))), uint32(n))
+}
@@ -0,0 +1,8 @@
+//go:build tinygo && !wasip1
+
+package sudoku
+
+// 宿主导入: 宿主需在 importObject.env 中提供 logEvent
+//
+//go:wasmimport env logEvent
+func hostLogEvent(level uint32, codePtr uint32, codeLen uint32)
@@ -0,0 +1,15 @@
+//go:build tinygo && wasip1
+
+package sudoku
+
+import "os"
+
+// wasip1 构建没有 env 导入: 事件以 "<级别> <事件文本>" 逐行写入 stderr (事件文本即 logBuf 的前 codeLen 字节)
+func hostLogEvent(level uint32, codePtr uint32, codeLen uint32) {
+	var line [len(logBuf) + 3]byte
+	line[0] = byte('0' + level)
+	line[1] = ' '
+	n := 2 + copy(line[2:], logBuf[:codeLen])
+	line[n] = '\n'
+	os.Stderr.Write(line[:n+1])
+}
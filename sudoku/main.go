@@ -0,0 +1,2532 @@
+// Sudoku Protocol - TinyGo Wasm Core
+// 严格遵循零GC、固定Arena、静态Session管理规则
+// 与官方Go客户端保持100%字节级兼容
+//
+// 内存布局说明 (各区域偏移由构建标签选择，见 layout_*.go，运行时可通过 getMemoryLayout 查询):
+//   [sessionBase, +maxSessions*128)   SudokuInstance 静态数组
+//   [workBufBase, +workBufSize)       临时缓冲区 (workBuf)，区域之间留有守护金丝雀 (guard.go)
+//   [outBufBase, +outBufSize)         输出缓冲区 (outBuf)
+//   [heapBase, heapEnd)               自由分配区 (Bump Pointer)
+//   [tableBase, arenaSize)            查表数据区 (编码/解码表，运行时构建)
+
+package sudoku
+
+import (
+	"encoding/binary"
+	"unsafe"
+)
+
+// ============================================================================
+// 1. 固定内存池配置 (Fixed Arena Configuration)
+// ============================================================================
+
+// arenaSize, maxSessions, 各区域基址/大小, maxCodecTables 定义于 layout_*.go
+// 默认 2MB / 1024 session，-tags arenasmall 为 256KB / 64，-tags arenalarge 为 16MB / 8192
+const (
+	sessionSize = 128
+	sessionBase = 0x00000
+
+	// workBuf 后半段: sealAndMask/unmaskAndOpen 的 AEAD 中间结果
+	aeadScratchBase = workBufBase + workBufSize/2
+	aeadScratchSize = workBufSize / 2
+
+	// 单次 mask 输入上限: 每字节最多膨胀为 maskMaxPerByte 字节 (4 hint + padding)，另加 1 字节末尾 padding
+	maskMaxChunk = (outBufSize - 1) / maskMaxPerByte
+
+	numGrids         = 288
+	numHintPositions = 1820
+	maxHintsPerByte  = 50
+	decodeTableBits  = 14
+	decodeTableSize  = 1 << decodeTableBits // 256*50 个 hint 组，负载约 78%
+)
+
+// 编译期检查: 各区域按顺序排列、互不重叠且之间留有守护金丝雀 (arenaGuardSize，见 guard.go)，
+// codec 表区 (tableBase 起，宿主不可访问) 对齐到 4 字节
+const (
+	_ = uint(workBufBase - arenaGuardSize - sessionBase - maxSessions*sessionSize)
+	_ = uint(outBufBase - arenaGuardSize - workBufBase - workBufSize)
+	_ = uint(heapBase - arenaGuardSize - outBufBase - outBufSize)
+	_ = uint(heapEnd - heapBase)
+	_ = uint(tableBase - arenaGuardSize - heapEnd)
+	_ = uint(0 - tableBase%4)
+)
+
+//go:export arena
+var arena [arenaSize]byte
+
+var arenaPtr uint32 = heapBase
+var sessionUsed [maxSessions]uint8
+
+// 空闲 session ID 栈: sessionFreeList[0:sessionFreeCount] 为可用 ID
+// 栈顶在末尾，initWasm 逆序压栈，使分配顺序仍从 0 开始
+var sessionFreeList [maxSessions]uint16
+var sessionFreeCount uint32
+var currentOutLen uint32
+
+// 每个 session 可绑定独立输出区 (setSessionOutBuf)，未绑定时使用共享 outBuf
+// 多个宿主线程共享同一块内存时，为每个 session 绑定不同区域即可互不干扰
+var sessionOutPtr [maxSessions]uint32
+var sessionOutCap [maxSessions]uint32
+var sessionOutLen [maxSessions]uint32
+var wasmInitialized bool = false
+
+//export initWasm
+func initWasm() int32 {
+	if wasmInitialized {
+		return 0
+	}
+	// 清零 sessionUsed 数组并重建空闲栈
+	for i := int32(0); i < maxSessions; i++ {
+		sessionUsed[i] = 0
+		sessionFreeList[i] = uint16(maxSessions - 1 - i)
+	}
+	sessionFreeCount = maxSessions
+	setupCodecTables()
+	writeArenaCanaries()
+	arenaPtr = heapBase
+	arenaTopHdr = 0
+	arenaPeak = heapBase
+	currentOutLen = 0
+	wasmInitialized = true
+	return 0
+}
+
+// ============================================================================
+// 2. 核心数据结构
+// ============================================================================
+
+type SudokuInstance struct {
+	txCounter    uint64   // 发送方向 nonce counter
+	key          [32]byte // 发送方向密钥 (接收方向密钥见 sessionRxKey)
+	rxCounter    uint64   // 接收方向: 已接受的最大 nonce counter
+	replayBitmap uint64   // 抗重放窗口: bit i 表示 rxCounter-i 已接受
+	flags        uint32   // sessionFlag* 选项位
+	cipherType   uint8
+	nonceSize    uint8
+	tagSize      uint8
+	_            uint8
+	sudokuState  [64]byte
+}
+
+// SudokuInstance.flags 位定义
+const (
+	sessionFlagConstTimeDecode = 1 << 0 // 常量时间解码 (见 codec_consttime.go)
+	sessionFlagStrictDecode    = 1 << 1 // 严格解码: 遇到无法解码的 hint 组立即中止 (errUndecodable)
+	sessionFlagWipeOnClose     = 1 << 2 // closeSession 时清零该 session 的输出区与 AEAD 暂存区
+	sessionFlagCompress        = 1 << 3 // 加密前压缩明文 (见 compress.go)
+	sessionFlagDatagram        = 1 << 4 // 数据报模式: 记录携带截断序号，每次调用独立解码 (见 datagram.go)
+	sessionFlagDRBG            = 1 << 5 // mask 随机源改用 ChaCha8 DRBG (见 drbg.go)
+	sessionFlagEarlyData       = 1 << 6 // server: 下一次 handshakeConsume 接受 0-RTT 早期数据 (见 earlydata.go)
+	sessionFlagPSK             = 1 << 7 // initSessionPSK 创建，hello 携带 identity hint (见 psk.go)
+	sessionFlagLayoutHeader    = 1 << 8 // mask 流起始处携带布局头，unmask 校验 (见 layouthdr.go)
+	sessionFlagKeyCommit       = 1 << 9 // AEAD 帧追加密钥承诺标签 (见 commit.go)
+)
+
+// 加密类型常量在 crypto.go 中定义:
+// CipherNone = 0
+// CipherAES128GCM = 1
+// CipherChaCha20Poly = 2
+// CipherXChaCha20Poly = 3
+// CipherAES256GCM = 4
+
+const (
+	LayoutASCII   = 0
+	LayoutEntropy = 1
+	// 替换 hint 输出字母表 (见 codec_alphabet.go)，仅 4x4 profile，可与 LayoutKeyed 组合
+	LayoutBase64URL   = 2
+	LayoutHeaderToken = 3
+)
+
+// session 角色: 决定两个方向是否使用独立密钥
+// RoleShared 时收发共用 key；RoleClient/RoleServer 时由 key 派生 client_write/server_write，
+// 客户端以 client_write 发送、server_write 接收，服务端相反 (见 deriveDirectionalKeys)
+// 警告: RoleShared 两端的密钥、默认 nonce salt (全 0) 与 counter 起点都相同，双向发送时两端第 n 帧的 (密钥, nonce) 完全一致，
+// 破坏 AES-GCM / ChaCha20-Poly1305 的安全性；RoleShared 只适用于单向传输，双向须用 RoleClient/RoleServer，
+// 或两端以 setNonceSalt 设置互不相同的 nonce salt (如一端 0、另一端 1)
+const (
+	RoleShared = 0
+	RoleClient = 1
+	RoleServer = 2
+)
+
+// 每个 session 的接收方向密钥 (RoleShared 时与 key 相同)
+var sessionRxKey [maxSessions][32]byte
+
+// ============================================================================
+// 3. 预计算数据
+// ============================================================================
+
+//go:generate go run gen_data.go
+// 9x9 表在 data_generated.go 中定义 (gen_data.go 生成)
+// 4x4 网格、hint 位置组合及编码/解码表均在运行时由 setupCodecTables 构建 (见 codec_table.go)；
+// gen_data.go 以相同算法另行计算，连同 9x9 表导出到 codec_tables.json 供交叉比对
+
+var perm4 = [24][4]uint8{
+	{0, 1, 2, 3}, {0, 1, 3, 2}, {0, 2, 1, 3}, {0, 2, 3, 1},
+	{0, 3, 1, 2}, {0, 3, 2, 1}, {1, 0, 2, 3}, {1, 0, 3, 2},
+	{1, 2, 0, 3}, {1, 2, 3, 0}, {1, 3, 0, 2}, {1, 3, 2, 0},
+	{2, 0, 1, 3}, {2, 0, 3, 1}, {2, 1, 0, 3}, {2, 1, 3, 0},
+	{2, 3, 0, 1}, {2, 3, 1, 0}, {3, 0, 1, 2}, {3, 0, 2, 1},
+	{3, 1, 0, 2}, {3, 1, 2, 0}, {3, 2, 0, 1}, {3, 2, 1, 0},
+}
+
+// 默认 padding 池 (initSession 时复制到 session)
+var paddingPool [32]uint8
+var paddingPoolSize uint8
+
+// 每个 session 的 padding 池 (setPaddingPolicy 可替换)
+const maxPadPoolSize = 32
+
+var sessionPadPool [maxSessions][maxPadPoolSize]uint8
+
+// ============================================================================
+// 4. 内存分配器
+// ============================================================================
+
+// 每个分配块前有 8 字节块头: [size|freeBit (LE u32)][上一个块头偏移 (LE u32)]
+// arenaFree 标记块为空闲；栈顶连续的空闲块立即回收 (arenaPtr 回退)
+// 因此按 LIFO 或"分配-处理-全部释放"模式使用时，堆空间可被完全复用
+const (
+	arenaHeaderSize = 8
+	arenaFreeBit    = 1
+)
+
+var arenaTopHdr uint32 // 栈顶块头偏移，0 表示无块
+
+//export arenaMalloc
+func arenaMalloc(size uint32) uint32 {
+	if size == 0 {
+		return 0
+	}
+	alignedSize := (size + 7) & ^uint32(7)
+	if uint64(arenaPtr)+uint64(alignedSize)+arenaHeaderSize > heapEnd {
+		logEvent(LogLevelError, logArenaExhausted, size)
+		arenaMallocFails++
+		return 0
+	}
+	hdr := arenaPtr
+	binary.LittleEndian.PutUint32(arena[hdr:hdr+4], alignedSize)
+	binary.LittleEndian.PutUint32(arena[hdr+4:hdr+8], arenaTopHdr)
+	arenaTopHdr = hdr
+	arenaPtr += arenaHeaderSize + alignedSize
+	if arenaPtr > arenaPeak {
+		arenaPeak = arenaPtr
+	}
+	return hdr + arenaHeaderSize
+}
+
+//export arenaReset
+func arenaReset() {
+	arenaPtr = heapBase
+	arenaTopHdr = 0
+}
+
+//export arenaFree
+func arenaFree(ptr uint32) {
+	if ptr < heapBase+arenaHeaderSize || ptr > arenaPtr {
+		return
+	}
+	hdr := ptr - arenaHeaderSize
+	sizeWord := binary.LittleEndian.Uint32(arena[hdr : hdr+4])
+	binary.LittleEndian.PutUint32(arena[hdr:hdr+4], sizeWord|arenaFreeBit)
+
+	// 回收栈顶连续的空闲块 (首块的上一个块头为 0)
+	for arenaTopHdr != 0 {
+		top := arenaTopHdr
+		if binary.LittleEndian.Uint32(arena[top:top+4])&arenaFreeBit == 0 {
+			break
+		}
+		arenaPtr = top
+		arenaTopHdr = binary.LittleEndian.Uint32(arena[top+4 : top+8])
+	}
+}
+
+// arenaMark - 记录当前堆位置，配合 arenaRelease 批量回收
+//
+//export arenaMark
+func arenaMark() uint32 {
+	return arenaPtr
+}
+
+// arenaRelease - 回收 mark 之后的全部分配 (例如某个 session 生命周期内的临时缓冲)
+//
+//export arenaRelease
+func arenaRelease(mark uint32) {
+	if mark < heapBase || mark > arenaPtr {
+		return
+	}
+	for arenaTopHdr != 0 && arenaTopHdr >= mark {
+		top := arenaTopHdr
+		arenaTopHdr = binary.LittleEndian.Uint32(arena[top+4 : top+8])
+	}
+	arenaPtr = mark
+}
+
+// ============================================================================
+// 5. Session 管理
+// ============================================================================
+
+// 完整版本 - 与原有协议客户端兼容
+// 参数: keyPtr, keyLen, cipherType, layoutType
+// 返回值: sessionId (>=0 成功, <0 失败)
+//
+//	-1 无可用 session
+//	-2 密钥过长 (>32)
+//	-3 未知 cipherType
+//	-4 AES-128-GCM 密钥不足 16 字节
+//	-5 (X)ChaCha20-Poly1305 密钥不是 32 字节
+//	-6 keyed codec 表槽位已满 (layoutType 含 LayoutKeyed 时)
+//	-7 指针越界
+//	-8 未知 gridProfile，或 9x9 profile 与 LayoutKeyed / 替换字母表 (LayoutBase64URL 等) 同时使用
+//	-9 未知 role
+//	-20 registerIdentity 登记的 identity 已达配额 (errQuota，见 quota.go)
+//
+// gridProfile: GridProfile4x4 (默认) 或 GridProfile9x9；旧宿主省略该参数时为 0
+// role: RoleShared (默认) / RoleClient / RoleServer；旧宿主省略该参数时为 0
+// 警告: 加密的 RoleShared session 只能单向使用，双向收发会使两端 nonce 重复 (见 RoleShared)；
+// 双向连接应使用 RoleClient/RoleServer，或两端 setNonceSalt 设置不同的 salt
+//
+//export initSession
+func initSession(keyPtr uint32, keyLen uint32, cipherType uint8, layoutType uint8, gridProfile uint8, role uint8) int32 {
+	if !inArenaRange(keyPtr, keyLen) {
+		return -7
+	}
+	return initSessionKey(arena[keyPtr:keyPtr+keyLen], cipherType, layoutType, gridProfile, role, nil)
+}
+
+// initSessionKey - initSession 的内部实现，key 可来自 arena 或派生结果
+// codecSeed 非空时作为 keyed 表种子，否则由 key 派生
+func initSessionKey(key []byte, cipherType uint8, layoutType uint8, gridProfile uint8, role uint8, codecSeed []byte) int32 {
+	keyLen := uint32(len(key))
+	if !wasmInitialized {
+		initWasm()
+	}
+	if sessionFreeCount == 0 {
+		logEvent(LogLevelError, logSessionExhausted, logNoArg)
+		return -1 // 无可用 session
+	}
+	if keyLen > 32 {
+		return -2 // 密钥过长
+	}
+
+	// 根据 cipherType 设置密钥长度、nonceSize 和 tagSize (见 cipherRegistry)
+	spec := cipherLookup(cipherType)
+	if spec == nil {
+		return -3
+	}
+	useKeyLen := uint32(spec.keyLen)
+	nonceSize, tagSize := spec.nonceLen, spec.tagLen
+	switch {
+	case cipherType == CipherNone:
+		useKeyLen = keyLen // 仅用于 codec/nonce，不参与加密
+	case spec.truncate && keyLen < useKeyLen:
+		return -4 // 如 AES-128-GCM 至少 16 字节，更长时截断
+	case !spec.truncate && keyLen != useKeyLen:
+		return -5
+	}
+
+	switch gridProfile {
+	case GridProfile4x4:
+	case GridProfile9x9:
+		// 9x9 表为预生成的固定表，不支持 keyed 派生；符号需要两类字节，不支持替换字母表
+		if layoutType&LayoutKeyed != 0 || layoutAlphabet(layoutType) != alphabetASCII {
+			return -8
+		}
+	default:
+		return -8
+	}
+
+	if role > RoleServer {
+		return -9
+	}
+
+	// keyed 布局: 取得 (或构建) 该密钥对应的 codec 表
+	tableSlot := int32(defaultCodecTable)
+	if layoutType&LayoutKeyed != 0 {
+		var seed uint64
+		if len(codecSeed) >= 8 {
+			seed = binary.BigEndian.Uint64(codecSeed[0:8])
+		} else {
+			seed = codecSeedFromKey(key)
+		}
+		tableSlot = acquireCodecTable(seed)
+		if tableSlot < 0 {
+			return -6
+		}
+	}
+
+	// 校验全部通过后才取走登记的 identity，失败的调用不消耗登记
+	var identity [32]byte
+	if !identityAdmit(&identity) {
+		releaseCodecTable(uint8(tableSlot))
+		return errQuota
+	}
+
+	// 从空闲栈弹出 session
+	sessionFreeCount--
+	id := int32(sessionFreeList[sessionFreeCount])
+	noteSessionPeak()
+
+	sessionUsed[id] = 1
+	sessionIdentity[id] = identity
+	sessionAddr := sessionBase + uint32(id)*sessionSize
+	session := (*SudokuInstance)(unsafe.Pointer(&arena[sessionAddr]))
+
+	session.txCounter = 0
+	session.rxCounter = 0
+	session.replayBitmap = 0
+	sessionOpenStatus[id] = errOK
+	sessionStats[id] = sessionStatsCounters{}
+	sessionRekey[id] = rekeyState{}
+	sessionShaping[id] = shapingPolicy{}
+	sessionMaxFrame[id] = 0
+	sessionCloseState[id] = 0
+	sessionRate[id] = rateBucket{}
+	sessionLastUsed[id] = currentTick
+	sessionDecodeErrOffset[id] = -1
+	for i := uint32(0); i < 32; i++ {
+		if i < useKeyLen {
+			session.key[i] = key[i]
+		} else {
+			session.key[i] = 0
+		}
+	}
+	if role != RoleShared && cipherType != CipherNone {
+		deriveDirectionalKeys(key, role, useKeyLen, &session.key, &sessionRxKey[id])
+	} else {
+		sessionRxKey[id] = session.key
+	}
+	sessionResumeKey[id] = [32]byte{}
+	if keyLen > 0 {
+		deriveResumeKey(key, &sessionResumeKey[id])
+	}
+	session.cipherType = cipherType
+	session.nonceSize = nonceSize
+	session.tagSize = tagSize
+	session.flags = 0
+
+	state := &session.sudokuState
+	copy(state[0:8], []byte{0x53, 0x55, 0x44, 0x4F, 0x4B, 0x55, 0x56, 0x32})
+	state[8] = cipherType
+	state[9] = nonceSize
+	state[10] = tagSize
+	state[11] = layoutType
+	state[12] = uint8(paddingPoolSize)
+	state[13] = uint8(paddingPoolSize)
+	sessionPadPool[id] = paddingPool
+	binary.BigEndian.PutUint16(state[14:16], uint16(19661))
+	binary.BigEndian.PutUint32(state[16:20], 0)
+	state[20] = 0
+	state[21] = 0
+	state[22] = 0
+	state[23] = 0
+	state[24] = 0
+	state[25] = alphabetMarker[layoutAlphabet(layoutType)]
+	state[26] = 0
+	state[27] = uint8(tableSlot)
+	state[28] = gridProfile
+	state[29] = role
+	state[stateWireVersion] = 0
+	state[stateCoverProfile] = CoverNone
+	state[stateCoverTx] = 0
+	state[stateCoverRx] = 0
+	binary.BigEndian.PutUint32(state[stateDecompressMax:stateDecompressMax+4], 0)
+	binary.BigEndian.PutUint64(state[stateDRBGWords:stateDRBGWords+8], 0)
+	binary.BigEndian.PutUint16(state[stateIdentityHint:stateIdentityHint+2], 0)
+	state[stateLayoutTx] = 0
+	state[stateLayoutRx] = 0
+	state[stateEscapeRx] = 0
+	// nonce salt 默认全 0，不再取自密钥 (setNonceSalt / initSessionDerived 可设置)
+	for i := 0; i < nonceSaltLen; i++ {
+		state[stateNonceSalt+i] = 0
+	}
+
+	logEvent(LogLevelInfo, logSessionOpen, uint32(id))
+	return id
+}
+
+// HKDF 输出布局: [AEAD 密钥 (32)][codec 种子 (8)][nonce salt (4)]
+const (
+	derivedKeyLen       = 32
+	derivedCodecSeedLen = 8
+	derivedNonceSaltLen = 4
+	derivedOutputLen    = derivedKeyLen + derivedCodecSeedLen + derivedNonceSaltLen
+)
+
+// initSessionDerived - 从主密钥派生 session 参数 (与 Go 客户端一致)
+// PRK = HKDF-Extract(salt, master)，OKM = HKDF-Expand(PRK, info, 44)
+// AEAD 密钥取 OKM[0:32] (AES-128-GCM 截断为 16 字节)，codec 种子与 nonce salt 存入 sudokuState
+// layoutType 含 LayoutKeyed 时，codec 表由派生的 codec 种子构建
+// 返回值: 同 initSession
+//
+//export initSessionDerived
+func initSessionDerived(masterPtr uint32, masterLen uint32, saltPtr uint32, saltLen uint32, infoPtr uint32, infoLen uint32, cipherType uint8, layoutType uint8, gridProfile uint8, role uint8) int32 {
+	if !inArenaRange(masterPtr, masterLen) || !inArenaRange(saltPtr, saltLen) || !inArenaRange(infoPtr, infoLen) {
+		return -7
+	}
+	var prk [sha256Size]byte
+	hkdfExtract(&prk, arena[saltPtr:saltPtr+saltLen], arena[masterPtr:masterPtr+masterLen])
+
+	var okm [derivedOutputLen]byte
+	hkdfExpand(&prk, arena[infoPtr:infoPtr+infoLen], okm[:])
+
+	id := initSessionKey(okm[:derivedKeyLen], cipherType, layoutType, gridProfile, role, okm[derivedKeyLen:derivedKeyLen+derivedCodecSeedLen])
+	if id >= 0 {
+		session := getSession(id)
+		state := &session.sudokuState
+		copy(state[stateCodecSeed:stateCodecSeed+derivedCodecSeedLen], okm[derivedKeyLen:derivedKeyLen+derivedCodecSeedLen])
+		copy(state[stateNonceSalt:stateNonceSalt+derivedNonceSaltLen], okm[derivedKeyLen+derivedCodecSeedLen:])
+		if masterLen > 0 {
+			deriveResumeKey(arena[masterPtr:masterPtr+masterLen], &sessionResumeKey[id])
+		}
+	}
+
+	// 清除栈上的密钥材料
+	for i := range okm {
+		okm[i] = 0
+	}
+	for i := range prk {
+		prk[i] = 0
+	}
+	return id
+}
+
+// initSessions 的描述符布局 (小端序): [keyPtr (4)][keyLen (4)][cipherType][layoutType][gridProfile][role]
+const sessionDescSize = 12
+
+// initSessions - 批量创建 session，减少连接突发时的边界调用次数
+// batchPtr 指向 count 个描述符，逐个按 initSession 处理；某项失败不影响其余项
+// 结果写入共享 outBuf: count 个 int32 (小端序)，每项为 session ID 或 initSession 的负错误码
+// 返回值: 结果指针 (长度 count*4，也可通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export initSessions
+func initSessions(batchPtr uint32, count uint32) uint32 {
+	if count == 0 {
+		return fail(errEmptyInput)
+	}
+	if count > maxSessions {
+		return fail(errInputTooLarge)
+	}
+	if !inArenaRange(batchPtr, count*sessionDescSize) {
+		return fail(errOutOfBounds)
+	}
+	for i := uint32(0); i < count; i++ {
+		d := arena[batchPtr+i*sessionDescSize : batchPtr+(i+1)*sessionDescSize]
+		keyPtr := binary.LittleEndian.Uint32(d[0:4])
+		keyLen := binary.LittleEndian.Uint32(d[4:8])
+		id := initSession(keyPtr, keyLen, d[8], d[9], d[10], d[11])
+		binary.LittleEndian.PutUint32(arena[outBufBase+i*4:outBufBase+i*4+4], uint32(id))
+	}
+	currentOutLen = count * 4
+	if currentOutLen > outBufPeak {
+		outBufPeak = currentOutLen
+	}
+	lastError = errOK
+	return outBufBase
+}
+
+// 方向密钥派生: PRK = HKDF-Extract(nil, key)，各方向密钥 = HKDF-Expand(PRK, label, keyLen)
+var (
+	clientWriteLabel = []byte("client_write")
+	serverWriteLabel = []byte("server_write")
+)
+
+// deriveDirectionalKeys - 按角色派生发送/接收密钥 (不足 32 字节的部分补 0)
+func deriveDirectionalKeys(key []byte, role uint8, keyLen uint32, tx *[32]byte, rx *[32]byte) {
+	var prk [sha256Size]byte
+	hkdfExtract(&prk, nil, key)
+
+	txLabel, rxLabel := clientWriteLabel, serverWriteLabel
+	if role == RoleServer {
+		txLabel, rxLabel = serverWriteLabel, clientWriteLabel
+	}
+	*tx = [32]byte{}
+	*rx = [32]byte{}
+	hkdfExpand(&prk, txLabel, tx[:keyLen])
+	hkdfExpand(&prk, rxLabel, rx[:keyLen])
+
+	for i := range prk {
+		prk[i] = 0
+	}
+}
+
+//export closeSession
+func closeSession(id int32) {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return
+	}
+	sessionUsed[id] = 0
+	sessionRxKey[id] = [32]byte{}
+	sessionResumeKey[id] = [32]byte{}
+	sessionIdentity[id] = [32]byte{}
+	sessionForbidCount[id] = 0
+	sessionHandshake[id] = handshakeState{}
+	sessionEarly[id] = earlyDataState{}
+	sessionSnapshot[id] = sessionSnapshotState{}
+	controlPurge(id)
+	muxPurge(id)
+	fecRelease(id)
+	drbgRelease(id)
+	traceRelease(id)
+	rngDrawsRelease(id)
+	logEvent(LogLevelInfo, logSessionClose, uint32(id))
+	releaseCodecTable(getSession(id).sudokuState[stateCodecTable])
+	if getSession(id).flags&sessionFlagWipeOnClose != 0 {
+		wipeSessionBuffers(id)
+	}
+	sessionOutPtr[id] = 0
+	sessionOutCap[id] = 0
+	sessionOutSlots[id] = outSlots{}
+	sessionOutLen[id] = 0
+	sessionFreeList[sessionFreeCount] = uint16(id)
+	sessionFreeCount++
+	sessionAddr := sessionBase + uint32(id)*sessionSize
+	for i := uint32(0); i < sessionSize; i++ {
+		arena[sessionAddr+i] = 0
+	}
+}
+
+// zeroArena - 清零 arena[ptr:ptr+n]
+func zeroArena(ptr uint32, n uint32) {
+	for i := uint32(0); i < n; i++ {
+		arena[ptr+i] = 0
+	}
+}
+
+// wipeSessionBuffers - 清零 session 的输出区与 AEAD 暂存区 (明文与 keystream 残留)
+// 未绑定独立输出区时清零的是共享 outBuf 与 workBuf 后半段，其他 session 尚未取走的输出也会被清除
+func wipeSessionBuffers(id int32) {
+	out, outCap := sessionOut(id)
+	zeroArena(out, outCap)
+	if sessionOutPtr[id] == 0 {
+		zeroArena(aeadScratchBase, aeadScratchSize)
+	}
+}
+
+// scrubBuffers - 清零全部共享缓冲区 (workBuf 与 outBuf)，处理敏感数据后纵深防御用
+// 不影响 session 状态与 setSessionOutBuf 绑定的独立输出区
+//
+//export scrubBuffers
+func scrubBuffers() {
+	zeroArena(workBufBase, workBufSize)
+	zeroArena(outBufBase, outBufSize)
+	currentOutLen = 0
+}
+
+// 空闲回收: 宿主通过 tick 提供单调递增的时间 (单位自定，如秒)
+// session 每次被导出函数访问 (getSession) 时记录当前 tick
+var currentTick uint32
+var sessionLastUsed [maxSessions]uint32
+
+// tick - 更新当前时间
+//
+//export tick
+func tick(now uint32) {
+	currentTick = now
+}
+
+// reapIdleSessions - 关闭超过 maxIdleTicks 未被访问的 session (清零并归还槽位)
+// tick 差值按 uint32 回绕计算
+// 返回值: 回收的 session 数量
+//
+//export reapIdleSessions
+func reapIdleSessions(maxIdleTicks uint32) uint32 {
+	reaped := uint32(0)
+	for id := int32(0); id < maxSessions; id++ {
+		if sessionUsed[id] == 0 {
+			continue
+		}
+		if currentTick-sessionLastUsed[id] > maxIdleTicks {
+			closeSession(id)
+			reaped++
+		}
+	}
+	return reaped
+}
+
+// ============================================================================
+// 6. 编解码辅助函数
+// ============================================================================
+
+// packHintsToKey - hint 组的解码键: 4 个 hint 字节排序后打包，与到达顺序无关
+func packHintsToKey(hints [4]uint8) uint32 {
+	if hints[0] > hints[1] {
+		hints[0], hints[1] = hints[1], hints[0]
+	}
+	if hints[2] > hints[3] {
+		hints[2], hints[3] = hints[3], hints[2]
+	}
+	if hints[0] > hints[2] {
+		hints[0], hints[2] = hints[2], hints[0]
+	}
+	if hints[1] > hints[3] {
+		hints[1], hints[3] = hints[3], hints[1]
+	}
+	if hints[1] > hints[2] {
+		hints[1], hints[2] = hints[2], hints[1]
+	}
+	return uint32(hints[0])<<24 | uint32(hints[1])<<16 | uint32(hints[2])<<8 | uint32(hints[3])
+}
+
+func decodeTableLookup(key uint32) (uint8, bool) {
+	t := &codecTables[defaultCodecTable]
+	if !ensureCodecDecode(t) {
+		return 0, false
+	}
+	return decodeTableLookupIn(t, key)
+}
+
+// isHintASCII - 是否为 4x4 hint 字节 (0x40-0x7F，见 hintByte)
+func isHintASCII(b uint8) bool {
+	return b&0xC0 == 0x40
+}
+
+// ============================================================================
+// 7. Mask/Unmask 核心
+// ============================================================================
+
+// sudokuState 字段偏移
+const (
+	stateMagic         = 0  // [0:8]   "SUDOKUV2"
+	stateCipherType    = 8  // cipherType
+	stateNonceSize     = 9  // nonceSize
+	stateTagSize       = 10 // tagSize
+	stateLayoutType    = 11 // layoutType
+	statePadPoolSize   = 12 // padding 池大小
+	statePadPoolSize2  = 13 // padding 池大小 (副本)
+	statePadThreshold  = 14 // [14:16] padding 阈值 (大端序, /65536)
+	stateRngState      = 16 // [16:20] LCG 状态 / DRBG 当前字 (大端序)
+	stateStreamFlags   = 20 // 流式处理标志
+	stateHintBuf       = 21 // [21:25] 未完成的 hint 组
+	statePadMarker     = 25 // padding 标记
+	stateHintCount     = 26 // 未完成 hint 组中的 hint 数
+	stateCodecTable    = 27 // codec 表槽位 (见 codec_table.go)
+	stateGridProfile   = 28 // GridProfile4x4 / GridProfile9x9
+	stateRole          = 29 // RoleShared / RoleClient / RoleServer
+	stateWireVersion   = 30 // 协商后的线路版本，0 表示尚未协商 (见 hello.go)
+	stateCoverProfile  = 31 // 伪装流量 profile (见 cover.go)
+	stateCodecSeed     = 32 // [32:40] codec 表种子 (initSessionDerived)
+	stateNonceSalt     = 40 // [40:44] nonce salt (setNonceSalt / initSessionDerived)
+	stateCoverTx       = 44 // 发送方向模板游标
+	stateCoverRx       = 45 // 接收方向模板游标
+	stateDecompressMax = 46 // [46:50] 解压后长度上限 (大端序，0 表示仅受输出区容量限制)
+	// [50:58] DRBG 已消费的字数 (stateDRBGWords，见 drbg.go)
+	// [58:60] identity hint (stateIdentityHint，见 psk.go)
+	// [60] / [61] 布局头已发送 / 已校验的字节数 (stateLayoutTx / stateLayoutRx，见 layouthdr.go)
+	// [62] 转义前缀待接字面组 (stateEscapeRx，见 escape.go)
+)
+
+// stateStreamFlags 位定义
+const (
+	streamMaskActive = 1 << 0 // maskBegin 后、maskEnd 前
+)
+
+func lcgNext(rngState uint32) uint32 {
+	return rngState*1664525 + 1013904223
+}
+
+// 单个输入字节的最大输出: 1 个前置 padding + 4 组 (padding + hint)
+const maskMaxPerByte = 9
+
+// maskEmitPadding - 按 RNG 决定是否插入一个 padding 字节 (写入 grp)
+func maskEmitPadding(rng *maskRng, pool *[maxPadPoolSize]uint8, padPoolSize uint8, paddingThreshold32 uint32, grp *[maskMaxPerByte]byte, n *uint32) {
+	if rng.x < paddingThreshold32 && padPoolSize > 0 {
+		rngAdvance(rng)
+		padIdx := rng.x % uint32(padPoolSize)
+		grp[*n] = pool[padIdx]
+		*n++
+	}
+}
+
+// maskEmitInnerPadding - hint 组内部的 padding: 与 maskEmitPadding 消耗相同的 RNG，
+// 但不输出 padding 标记 (接收端遇到标记会丢弃未完成的 hint 组)，
+// 选中标记时顺延到池中下一个非标记字节，池中全为标记时不插入
+func maskEmitInnerPadding(rng *maskRng, pool *[maxPadPoolSize]uint8, padPoolSize uint8, paddingThreshold32 uint32, marker uint8, grp *[maskMaxPerByte]byte, n *uint32) {
+	if rng.x < paddingThreshold32 && padPoolSize > 0 {
+		rngAdvance(rng)
+		padIdx := rng.x % uint32(padPoolSize)
+		for k := uint8(0); k < padPoolSize; k++ {
+			if pool[padIdx] != marker {
+				grp[*n] = pool[padIdx]
+				*n++
+				return
+			}
+			padIdx = (padIdx + 1) % uint32(padPoolSize)
+		}
+	}
+}
+
+// maskBody - 编码 arena[inPtr:inPtr+inLen] (不含流末尾 padding)
+// 开启布局头时，尚未发出的头部先于输入输出 (见 layouthdr.go)，计入输出长度但不计入已消费的输入；
+// 头部未能全部输出时不编码输入，保证头部之后才出现数据
+// 返回值: (输出长度, 已消费的输入字节数)
+func maskBody(id int32, inPtr uint32, inLen uint32, out uint32, maxOut uint32) (uint32, uint32) {
+	hdr := maskLayoutHeader(id, out, maxOut)
+	if layoutHeaderReserve(id) != 0 {
+		return hdr, 0
+	}
+	outPos, consumed := maskInput(id, arena[inPtr:inPtr+inLen], out+hdr, maxOut-hdr)
+	return hdr + outPos, consumed
+}
+
+// maskInput - 编码 in 的各字节
+// RNG 状态 (LCG 或 DRBG，见 drbg.go) 读写于 sudokuState，多次调用的输出拼接与单次调用完全一致
+// 每个字节的输出先在本地组装，放不下时不写出且不推进 RNG，保证不会静默截断
+// 设置了禁用字节 (见 forbid.go) 而某个字节无法输出时同样在该字节前停止，并置 maskUnrepresentable
+// 返回值: (输出长度, 已消费的字节数)
+func maskInput(id int32, in []byte, out uint32, maxOut uint32) (uint32, uint32) {
+	inLen := uint32(len(in))
+	state := &getSession(id).sudokuState
+	pool := &sessionPadPool[id]
+	rngState := maskRngLoad(id)
+	outPos := uint32(0)
+	maskUnrepresentable = false
+
+	padPoolSize := state[statePadPoolSize]
+	forbid := sessionForbid(id)
+	var allowedPool [maxPadPoolSize]uint8
+	if forbid != nil {
+		padPoolSize = forbidFilterPool(pool, padPoolSize, forbid, &allowedPool)
+		pool = &allowedPool
+	}
+	paddingThreshold := binary.BigEndian.Uint16(state[statePadThreshold : statePadThreshold+2])
+	paddingThreshold32 := uint32(paddingThreshold) << 16
+	table := sessionCodecTable(state)
+	profile9 := state[stateGridProfile] == GridProfile9x9
+	marker := state[statePadMarker]
+	alpha := &alphabetEncode[sessionAlphabet(state)]
+	cover := sessionCover(state)
+	cursor := state[stateCoverTx]
+	dataBytes := uint32(0)
+
+	var grp [maskMaxPerByte]byte
+	var wrapped [coverMaxPerByte]byte
+	consumed := uint32(0)
+	for ; consumed < inLen; consumed++ {
+		b := in[consumed]
+		rng := rngState
+		n := uint32(0)
+		var data uint32
+		if profile9 {
+			data = maskGroup9(b, forbid, &rng, pool, padPoolSize, paddingThreshold32, marker, &grp, &n)
+		} else {
+			data = maskGroup4(b, table, alpha, forbid, &rng, pool, padPoolSize, paddingThreshold32, marker, &grp, &n)
+		}
+
+		src := grp[:]
+		c := cursor
+		if cover != nil {
+			n = coverWrap(cover, &c, &grp, n, &wrapped)
+			src = wrapped[:]
+		}
+		if forbid != nil && !maskAllowed(src[:n], data, forbid) {
+			maskUnrepresentable = true
+			break
+		}
+
+		if n > maxOut-outPos {
+			break
+		}
+		for k := uint32(0); k < n; k++ {
+			arena[out+outPos+k] = src[k]
+		}
+		outPos += n
+		dataBytes += data
+		rngState = rng
+		cursor = c
+	}
+
+	maskRngStore(state, &rngState)
+	state[stateCoverTx] = cursor
+	st := &sessionStats[id]
+	st.bytesMasked += uint64(consumed)
+	st.padBytesEmitted += uint64(outPos - dataBytes)
+	return outPos, consumed
+}
+
+// maskGroup4 - 按 4x4 profile 组装一个字节的输出 (含 padding)，返回其中的数据字节数
+// hint 字节经 alpha 映射到 session 的输出字母表；选中的编码变体含禁用字节 (forbid) 时顺延到下一个变体，
+// 全部含禁用字节时返回 0；码表中没有编码变体的字节以转义组输出 (见 escape.go)
+func maskGroup4(b uint8, table *codecTable, alpha *[64]uint8, forbid *[32]byte, rng *maskRng, pool *[maxPadPoolSize]uint8, padPoolSize uint8, paddingThreshold32 uint32, marker uint8, grp *[maskMaxPerByte]byte, n *uint32) uint32 {
+	maskEmitPadding(rng, pool, padPoolSize, paddingThreshold32, grp, n)
+	rngAdvance(rng)
+
+	if uint16(b) >= table.rows {
+		ensureCodecRows(table, uint16(b)+1)
+	}
+	count := table.count[b]
+	if count == 0 {
+		return maskEscape4(b, alpha, forbid, rng, grp, n)
+	}
+
+	hintIdx := rng.x % uint32(count)
+	rngAdvance(rng)
+	if forbid != nil {
+		k := uint8(0)
+		for ; k < count && !hints4Allowed(&table.encode[b][hintIdx], alpha, forbid); k++ {
+			hintIdx = (hintIdx + 1) % uint32(count)
+		}
+		if k == count {
+			return 0
+		}
+	}
+	hints := table.encode[b][hintIdx]
+
+	permIdx := rng.x % 24
+	rngAdvance(rng)
+	perm := perm4[permIdx]
+
+	for j := 0; j < 4; j++ {
+		maskEmitInnerPadding(rng, pool, padPoolSize, paddingThreshold32, marker, grp, n)
+		rngAdvance(rng)
+
+		grp[*n] = alpha[hints[perm[j]]&0x3F]
+		*n++
+	}
+	return 4
+}
+
+// maskTail - 流末尾 padding (至多 1 字节)
+// 调用方需为其预留 1 字节 (见 maskMaxOut)；开启伪装流量 profile 时不输出
+func maskTail(id int32, out uint32, outPos uint32, maxOut uint32) uint32 {
+	state := &getSession(id).sudokuState
+	if sessionCover(state) != nil {
+		return outPos
+	}
+	rngState := maskRngLoad(id)
+	pool := &sessionPadPool[id]
+	padPoolSize := state[statePadPoolSize]
+	paddingThreshold32 := uint32(binary.BigEndian.Uint16(state[statePadThreshold:statePadThreshold+2])) << 16
+	var allowedPool [maxPadPoolSize]uint8
+	if forbid := sessionForbid(id); forbid != nil {
+		padPoolSize = forbidFilterPool(pool, padPoolSize, forbid, &allowedPool)
+		pool = &allowedPool
+	}
+
+	var grp [maskMaxPerByte]byte
+	n := uint32(0)
+	maskEmitPadding(&rngState, pool, padPoolSize, paddingThreshold32, &grp, &n)
+	if n > maxOut-outPos {
+		return outPos
+	}
+	if n > 0 {
+		arena[out+outPos] = grp[0]
+		outPos++
+	}
+
+	maskRngStore(state, &rngState)
+	sessionStats[id].padBytesEmitted += uint64(n)
+	return outPos
+}
+
+// getSession - 取得 session 实例，同时记录最近访问时间 (见 reapIdleSessions)
+func getSession(id int32) *SudokuInstance {
+	sessionLastUsed[id] = currentTick
+	sessionAddr := sessionBase + uint32(id)*sessionSize
+	return (*SudokuInstance)(unsafe.Pointer(&arena[sessionAddr]))
+}
+
+// sessionRxKeyOf - session 的接收方向密钥
+func sessionRxKeyOf(session *SudokuInstance) *[32]byte {
+	addr := uint32(uintptr(unsafe.Pointer(session)) - uintptr(unsafe.Pointer(&arena[sessionBase])))
+	return &sessionRxKey[addr/sessionSize]
+}
+
+// maskMaxOut - 单次 mask 输出上限 (最坏情况，不超过输出区容量)
+func maskMaxOut(id int32, inLen uint32, outCap uint32) uint32 {
+	maxOut := inLen*maskPerByte(&getSession(id).sudokuState) + 1 + layoutHeaderReserve(id)
+	if maxOut > outCap {
+		maxOut = outCap
+	}
+	return maxOut
+}
+
+// sessionOut - session 的输出区 (指针, 容量)
+func sessionOut(id int32) (uint32, uint32) {
+	if sessionOutPtr[id] != 0 {
+		return sessionOutPtr[id], sessionOutCap[id]
+	}
+	return outBufBase, outBufSize
+}
+
+// setOutLen - 记录输出长度 (全局 getOutLen 与 per-session getSessionOutLen)
+func setOutLen(id int32, n uint32) {
+	currentOutLen = n
+	sessionOutLen[id] = n
+	noteOutPeak(id, n)
+}
+
+// setOutLenInPlace - 同 setOutLen，输出位于宿主缓冲区 (maskInPlace)，不计入输出区高水位
+func setOutLenInPlace(id int32, n uint32) {
+	currentOutLen = n
+	sessionOutLen[id] = n
+}
+
+//export mask
+func mask(id int32, inPtr uint32, inLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(inPtr, inLen) {
+		return fail(errOutOfBounds)
+	}
+	out, outCap := sessionOut(id)
+	n, code := maskOut(id, inPtr, inLen, out, outCap)
+	setOutLen(id, n)
+	if code != errOK {
+		return fail(code)
+	}
+	lastError = errOK
+	return out
+}
+
+// maskTo - 同 mask，但输出写入宿主指定的 arena[outPtr:outPtr+outCap] (如 WebSocket 发送缓冲区的槽位)，省去从输出区的拷贝
+// 输入不得与输出区重叠 (原地处理见 maskInPlace)；输出区不足时与 mask 相同: 已消费部分的输出有效 (getMaskConsumed)
+// 返回值: outPtr (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export maskTo
+func maskTo(id int32, inPtr uint32, inLen uint32, outPtr uint32, outCap uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(inPtr, inLen) || outPtr == 0 || outCap < 1 || !inArenaRange(outPtr, outCap) {
+		return fail(errOutOfBounds)
+	}
+	n, code := maskOut(id, inPtr, inLen, outPtr, outCap)
+	setOutLenInPlace(id, n)
+	if code != errOK {
+		return fail(code)
+	}
+	lastError = errOK
+	return outPtr
+}
+
+// maskOut - mask/maskTo 的主体: 编码输入并追加末尾 padding，输出写入 arena[out:out+outCap]
+// 返回值: (输出长度, 错误码)；输出区不足时输出长度为已消费部分的输出
+func maskOut(id int32, inPtr uint32, inLen uint32, out uint32, outCap uint32) (uint32, int32) {
+	if inLen == 0 {
+		return 0, errOK
+	}
+
+	if !rateCheck(id, inLen) {
+		return 0, errRateLimited
+	}
+
+	maxOut := maskMaxOut(id, inLen, outCap)
+
+	// 末尾 padding 预留 1 字节
+	outPos, consumed := maskBody(id, inPtr, inLen, out, maxOut-1)
+	sessionMaskConsumed[id] = consumed
+	rateCharge(id, consumed)
+	if consumed < inLen {
+		// 已消费部分的输出有效，宿主发送后从 inPtr+consumed 继续
+		return outPos, maskShortError()
+	}
+	return maskTail(id, out, outPos, maxOut), errOK
+}
+
+// maskInPlace - 原地 mask: 输入位于宿主缓冲区 [bufPtr, bufPtr+bufCap) 的末尾 inLen 字节，
+// 输出从 bufPtr 起向后写，覆盖已消费的输入，省去输入拷贝与输出区拷贝
+// 输出与 mask 逐字节一致；bufCap >= inLen*9+1 (开启伪装流量 profile 时按其最坏膨胀，布局头尚未发出时另加其最坏输出) 时保证不会追上未读输入，
+// 否则输出追上未读输入时返回 0 (getLastError = -4)，未消费的输入仍在原位 (getMaskConsumed 返回已消费字节数)
+// 返回值: bufPtr (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export maskInPlace
+func maskInPlace(id int32, bufPtr uint32, bufCap uint32, inLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(bufPtr, bufCap) || inLen > bufCap {
+		return fail(errOutOfBounds)
+	}
+	sessionMaskConsumed[id] = 0
+	if inLen == 0 {
+		setOutLenInPlace(id, 0)
+		lastError = errOK
+		return bufPtr
+	}
+	if !rateCheck(id, inLen) {
+		return fail(errRateLimited)
+	}
+
+	inStart := bufPtr + bufCap - inLen
+	perByte := maskPerByte(&getSession(id).sudokuState)
+	// 布局头只能写入输入之前的空闲区，不计入下面按输入分块的预算
+	outPos := maskLayoutHeader(id, bufPtr, inStart-bufPtr)
+	consumed := uint32(0)
+	for consumed < inLen {
+		// 输出与未读输入之间的空闲字节数；按最坏膨胀分块，保证写出不覆盖未读输入
+		space := inStart + consumed - (bufPtr + outPos)
+		k := space / perByte
+		maxOut := space
+		if k == 0 {
+			// 单字节: 该字节在写出前已读取，可覆盖其自身位置
+			k = 1
+			maxOut = space + 1
+		}
+		if k > inLen-consumed {
+			k = inLen - consumed
+		}
+		written, c := maskBody(id, inStart+consumed, k, bufPtr+outPos, maxOut)
+		outPos += written
+		consumed += c
+		if c < k {
+			sessionMaskConsumed[id] = consumed
+			rateCharge(id, consumed)
+			setOutLenInPlace(id, outPos)
+			return fail(maskShortError())
+		}
+	}
+	sessionMaskConsumed[id] = consumed
+	rateCharge(id, consumed)
+	outPos = maskTail(id, bufPtr, outPos, bufCap)
+
+	setOutLenInPlace(id, outPos)
+	lastError = errOK
+	return bufPtr
+}
+
+// 最近一次 mask / maskUpdate 消费的输入字节数 (maskBatch 为已完成的帧数)
+var sessionMaskConsumed [maxSessions]uint32
+
+// getMaskConsumed - 最近一次 mask / maskUpdate 消费的输入字节数
+// 输出区不足 (errOutputTooSmall) 时，已消费部分的输出仍有效，
+// 宿主发送该部分后从 inPtr+consumed 继续调用即可，流保持同步
+// maskBatch 之后返回已完整输出的帧数
+//
+//export getMaskConsumed
+func getMaskConsumed(id int32) uint32 {
+	if id < 0 || id >= maxSessions {
+		return 0
+	}
+	return sessionMaskConsumed[id]
+}
+
+// estimateMaskedSize - 估算对 inLen 字节调用 mask 的输出长度 (按 session 当前的 profile 与 padding 策略)
+// 结果写入共享 outBuf: [上界 (u32)][期望值 (u32)]，小端序
+// 上界对任何 RNG 状态都成立；期望值按每个插入点以 阈值/65536 的概率插入 padding 计算 (向上取整)
+// 开启伪装流量 profile 时上界按其最坏膨胀计算，期望值按模板长度与槽位数之比放大
+// 返回值: 结果指针 (长度 8，也可通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export estimateMaskedSize
+func estimateMaskedSize(id int32, inLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	state := &getSession(id).sudokuState
+
+	// 每字节的 hint 字节数与 padding 插入点数 (见 maskGroup4 / maskGroup9)
+	dataPerByte, padPoints := uint64(4), uint64(5)
+	if state[stateGridProfile] == GridProfile9x9 {
+		padPoints = 3
+	}
+	threshold := uint64(binary.BigEndian.Uint16(state[statePadThreshold : statePadThreshold+2]))
+	if state[statePadPoolSize] == 0 {
+		threshold = 0
+	}
+
+	upper, expected := uint64(0), uint64(0)
+	if inLen > 0 {
+		n := uint64(inLen)
+		upper = n * dataPerByte
+		if threshold > 0 {
+			// 含流末尾 padding (至多 1 字节)
+			upper += n*padPoints + 1
+		}
+		expected = (n*(dataPerByte<<16+padPoints*threshold) + threshold + 0xFFFF) >> 16
+		if cover := sessionCover(state); cover != nil {
+			// 伪装流量: 每个槽位平均伴随 (模板长度/槽位数 - 1) 个字面字节，不输出流末尾 padding
+			if threshold > 0 {
+				expected = (n*(dataPerByte<<16+padPoints*threshold) + 0xFFFF) >> 16
+			}
+			upper = n * uint64(cover.maxPerByte)
+			expected = (expected*uint64(cover.length) + uint64(cover.slots) - 1) / uint64(cover.slots)
+		}
+	}
+	if upper > 0xFFFFFFFF {
+		upper = 0xFFFFFFFF
+	}
+	if expected > 0xFFFFFFFF {
+		expected = 0xFFFFFFFF
+	}
+	binary.LittleEndian.PutUint32(arena[outBufBase:outBufBase+4], uint32(upper))
+	binary.LittleEndian.PutUint32(arena[outBufBase+4:outBufBase+8], uint32(expected))
+	currentOutLen = 8
+	lastError = errOK
+	return outBufBase
+}
+
+// iovec 项: {ptr uint32, len uint32}，小端序
+const iovEntrySize = 8
+
+// maskv - 将多段缓冲区 (iovec 数组) 作为一段连续数据 mask，免去宿主拼接
+// 输出与先拼接再调用 mask 逐字节一致；输出区不足时同 mask，
+// getMaskConsumed 返回跨所有分段累计的已消费字节数
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export maskv
+func maskv(id int32, iovPtr uint32, iovCount uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	if uint64(iovCount)*iovEntrySize > arenaSize || !inArenaRange(iovPtr, iovCount*iovEntrySize) {
+		return fail(errOutOfBounds)
+	}
+
+	// 先校验全部分段，避免中途失败留下半条输出
+	total := uint64(0)
+	for i := uint32(0); i < iovCount; i++ {
+		entry := iovPtr + i*iovEntrySize
+		ptr := binary.LittleEndian.Uint32(arena[entry : entry+4])
+		n := binary.LittleEndian.Uint32(arena[entry+4 : entry+8])
+		if !inArenaRange(ptr, n) {
+			return fail(errOutOfBounds)
+		}
+		total += uint64(n)
+	}
+	if total > arenaSize {
+		return fail(errInputTooLarge)
+	}
+
+	out, outCap := sessionOut(id)
+	sessionMaskConsumed[id] = 0
+	if total == 0 {
+		setOutLen(id, 0)
+		lastError = errOK
+		return out
+	}
+	if !rateCheck(id, uint32(total)) {
+		return fail(errRateLimited)
+	}
+
+	maxOut := maskMaxOut(id, uint32(total), outCap)
+	outPos := uint32(0)
+	consumed := uint32(0)
+	for i := uint32(0); i < iovCount; i++ {
+		entry := iovPtr + i*iovEntrySize
+		ptr := binary.LittleEndian.Uint32(arena[entry : entry+4])
+		n := binary.LittleEndian.Uint32(arena[entry+4 : entry+8])
+
+		// 末尾 padding 预留 1 字节
+		written, c := maskBody(id, ptr, n, out+outPos, maxOut-1-outPos)
+		outPos += written
+		consumed += c
+		if c < n {
+			sessionMaskConsumed[id] = consumed
+			rateCharge(id, consumed)
+			setOutLen(id, outPos)
+			return fail(maskShortError())
+		}
+	}
+	sessionMaskConsumed[id] = consumed
+	rateCharge(id, consumed)
+	outPos = maskTail(id, out, outPos, maxOut)
+
+	setOutLen(id, outPos)
+	lastError = errOK
+	return out
+}
+
+// maskBatch 的帧描述符 (小端序): [inPtr (4)][inLen (4)][outOff (4)][outLen (4)]
+// 宿主填写前 8 字节，outOff/outLen (相对返回指针) 由 maskBatch 回写
+const batchEntrySize = 16
+
+// maskBatch - 一次 mask 多个独立帧，输出紧密拼接在 session 输出区
+// 每帧的输出与对该帧单独调用 mask 逐字节一致 (含各自的末尾 padding)
+// 输出区放不下某帧时，该帧及之后的帧不输出 (RNG 与统计回退到该帧之前)，返回 0 (getLastError = -4)；
+// 此时 getMaskConsumed 返回已完整输出的帧数，宿主发送已有输出后从该帧继续。单帧超过 maskMaxChunk 时应改用 mask
+// 返回值: 输出指针 (总长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export maskBatch
+func maskBatch(id int32, descPtr uint32, count uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	if uint64(count)*batchEntrySize > arenaSize || !inArenaRange(descPtr, count*batchEntrySize) {
+		return fail(errOutOfBounds)
+	}
+	total := uint64(0)
+	for i := uint32(0); i < count; i++ {
+		entry := descPtr + i*batchEntrySize
+		ptr := binary.LittleEndian.Uint32(arena[entry : entry+4])
+		n := binary.LittleEndian.Uint32(arena[entry+4 : entry+8])
+		if !inArenaRange(ptr, n) {
+			return fail(errOutOfBounds)
+		}
+		total += uint64(n)
+	}
+	if total > arenaSize {
+		return fail(errInputTooLarge)
+	}
+	if !rateCheck(id, uint32(total)) {
+		return fail(errRateLimited)
+	}
+
+	state := &getSession(id).sudokuState
+	out, outCap := sessionOut(id)
+	outPos := uint32(0)
+	for i := uint32(0); i < count; i++ {
+		entry := descPtr + i*batchEntrySize
+		ptr := binary.LittleEndian.Uint32(arena[entry : entry+4])
+		n := binary.LittleEndian.Uint32(arena[entry+4 : entry+8])
+
+		start := outPos
+		if n > 0 {
+			var rngSaved [4]byte
+			copy(rngSaved[:], state[stateRngState:stateRngState+4])
+			drbgSaved := binary.BigEndian.Uint64(state[stateDRBGWords : stateDRBGWords+8])
+			cursorSaved := state[stateCoverTx]
+			layoutSaved := state[stateLayoutTx]
+			statsSaved := sessionStats[id]
+
+			// 末尾 padding 预留 1 字节
+			written, c := uint32(0), uint32(0)
+			if outCap-outPos > 1 {
+				written, c = maskBody(id, ptr, n, out+outPos, outCap-outPos-1)
+			}
+			if c < n {
+				copy(state[stateRngState:stateRngState+4], rngSaved[:])
+				binary.BigEndian.PutUint64(state[stateDRBGWords:stateDRBGWords+8], drbgSaved)
+				state[stateCoverTx] = cursorSaved
+				state[stateLayoutTx] = layoutSaved
+				sessionStats[id] = statsSaved
+				sessionMaskConsumed[id] = i
+				setOutLen(id, start)
+				return fail(maskShortError())
+			}
+			outPos = maskTail(id, out, outPos+written, outCap)
+			rateCharge(id, n)
+		}
+		binary.LittleEndian.PutUint32(arena[entry+8:entry+12], start)
+		binary.LittleEndian.PutUint32(arena[entry+12:entry+16], outPos-start)
+	}
+	sessionMaskConsumed[id] = count
+
+	setOutLen(id, outPos)
+	lastError = errOK
+	return out
+}
+
+// maskBegin - 开始流式 mask
+// 之后可多次调用 maskUpdate 分片输入，最后以 maskEnd 收尾；
+// 所有分片输出按序拼接后与对整段数据调用一次 mask 的结果逐字节一致
+// 返回值: 0 成功, <0 失败
+//
+//export maskBegin
+func maskBegin(id int32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	state := &getSession(id).sudokuState
+	state[stateStreamFlags] |= streamMaskActive
+	return 0
+}
+
+// maskUpdate - 流式 mask 一个分片
+// 单个分片的输出最多为 inLen*9 字节，分片超过 maskMaxChunk (约 14KB) 时可能返回 errOutputTooSmall
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export maskUpdate
+func maskUpdate(id int32, inPtr uint32, inLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(inPtr, inLen) {
+		return fail(errOutOfBounds)
+	}
+	state := &getSession(id).sudokuState
+	if state[stateStreamFlags]&streamMaskActive == 0 {
+		return fail(errStreamState)
+	}
+
+	if !rateCheck(id, inLen) {
+		return fail(errRateLimited)
+	}
+
+	out, outCap := sessionOut(id)
+	outPos, consumed := maskBody(id, inPtr, inLen, out, maskMaxOut(id, inLen, outCap))
+	sessionMaskConsumed[id] = consumed
+	rateCharge(id, consumed)
+	setOutLen(id, outPos)
+	if consumed < inLen {
+		return fail(maskShortError())
+	}
+	lastError = errOK
+	return out
+}
+
+// maskEnd - 结束流式 mask，输出流末尾 padding (0 或 1 字节)
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export maskEnd
+func maskEnd(id int32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	state := &getSession(id).sudokuState
+	if state[stateStreamFlags]&streamMaskActive == 0 {
+		return fail(errStreamState)
+	}
+	state[stateStreamFlags] &^= streamMaskActive
+
+	out, outCap := sessionOut(id)
+	setOutLen(id, maskTail(id, out, 0, outCap))
+	lastError = errOK
+	return out
+}
+
+//export unmask
+func unmask(id int32, inPtr uint32, inLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if rxClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(inPtr, inLen) {
+		return fail(errOutOfBounds)
+	}
+	out, outCap := sessionOut(id)
+	n, code := unmaskOut(id, inPtr, inLen, out, outCap)
+	setOutLen(id, n)
+	if code != errOK {
+		return fail(code)
+	}
+	lastError = errOK
+	return out
+}
+
+// unmaskTo - 同 unmask，但输出写入宿主指定的 arena[outPtr:outPtr+outCap]
+// 输入不得与输出区重叠；解码输出至多为输入长度的 1/4，输出区写满时剩余输入不被解码 (与 unmask 相同)
+// 返回值: outPtr (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export unmaskTo
+func unmaskTo(id int32, inPtr uint32, inLen uint32, outPtr uint32, outCap uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if rxClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(inPtr, inLen) || outPtr == 0 || !inArenaRange(outPtr, outCap) {
+		return fail(errOutOfBounds)
+	}
+	n, code := unmaskOut(id, inPtr, inLen, outPtr, outCap)
+	setOutLenInPlace(id, n)
+	if code != errOK {
+		return fail(code)
+	}
+	lastError = errOK
+	return outPtr
+}
+
+// unmaskOut - unmask/unmaskTo 的主体
+// 返回值: (输出长度, 错误码)；失败时输出长度为 0
+func unmaskOut(id int32, inPtr uint32, inLen uint32, out uint32, outCap uint32) (uint32, int32) {
+	if inLen == 0 {
+		return 0, errOK
+	}
+	n, ok := unmaskBody(id, inPtr, inLen, out, outCap)
+	if !ok {
+		return 0, unmaskFailure(id)
+	}
+	return n, errOK
+}
+
+// 每个 session 最近一次 unmask 中止时的输入偏移，-1 表示未中止
+var sessionDecodeErrOffset [maxSessions]int32
+
+// unmaskBody - 解码 hint 流，输出写入 out
+// 开启布局头时先校验并剥离流起始处的头部 (见 layouthdr.go)，不符时返回 false，原因见 unmaskFailure
+// 返回值: (输出长度, 是否完成)
+func unmaskBody(id int32, inPtr uint32, inLen uint32, out uint32, maxOut uint32) (uint32, bool) {
+	if !layoutHeaderRxPending(id) {
+		return unmaskDecode(id, inPtr, inLen, out, maxOut)
+	}
+	if getSession(id).sudokuState[stateLayoutRx] == layoutHeaderMismatch {
+		return 0, false
+	}
+	decodeErrors := sessionStats[id].decodeErrors
+	n, ok := unmaskDecode(id, inPtr, inLen, out, maxOut)
+	return layoutHeaderCheck(id, out, n, ok, sessionStats[id].decodeErrors != decodeErrors)
+}
+
+// unmaskFailure - unmaskBody 返回 false 时的错误码，同时记入 trace
+func unmaskFailure(id int32) int32 {
+	code := int32(errUndecodable)
+	if getSession(id).sudokuState[stateLayoutRx] == layoutHeaderMismatch {
+		code = errLayoutMismatch
+	}
+	offset := uint32(0)
+	if sessionDecodeErrOffset[id] > 0 {
+		offset = uint32(sessionDecodeErrOffset[id])
+	}
+	traceEvent(id, traceDecodeFail, code, offset, 0)
+	return code
+}
+
+// unmaskDecode - 解码 hint 流，输出写入 out
+// 返回值: (输出长度, 是否完成)；严格模式下遇到无法解码的 hint 组时返回 false，
+// 偏移记录于 sessionDecodeErrOffset，未完成的 hint 组被丢弃
+func unmaskDecode(id int32, inPtr uint32, inLen uint32, out uint32, maxOut uint32) (uint32, bool) {
+	state := &getSession(id).sudokuState
+	sessionDecodeErrOffset[id] = -1
+	if getSession(id).flags&sessionFlagDatagram != 0 {
+		// 报文之间可能丢失或乱序，不接续上一次调用的 hint 组与模板游标
+		state[stateHintCount] = 0
+		state[stateEscapeRx] = 0
+		state[stateCoverRx] = 0
+	}
+	if state[stateGridProfile] == GridProfile9x9 {
+		return unmaskBody9(id, inPtr, inLen, out, maxOut)
+	}
+	st := &sessionStats[id]
+	decodeErrors := st.decodeErrors
+	outPos := uint32(0)
+
+	// 未凑满 4 个的 hint 组保存在 sudokuState 中，跨调用继续拼接
+	var hintBuf [4]uint8
+	copy(hintBuf[:], state[stateHintBuf:stateHintBuf+4])
+	hintCount := state[stateHintCount]
+	escaped := state[stateEscapeRx] != 0
+
+	padMarker := state[statePadMarker]
+	table := sessionCodecTable(state)
+	if !ensureCodecDecode(table) {
+		return 0, false
+	}
+	alphaDec := &alphabetDecode[sessionAlphabet(state)]
+	cover := sessionCover(state)
+	cursor := state[stateCoverRx]
+	flags := getSession(id).flags
+	constTime := flags&sessionFlagConstTimeDecode != 0
+	strict := flags&sessionFlagStrictDecode != 0
+	ok := true
+
+	for i := uint32(0); i < inLen && outPos < maxOut; i++ {
+		b := arena[inPtr+i]
+
+		// 伪装流量模板的字面字节按位置剥离，与模板不符时计为解码错误
+		if cover != nil {
+			if slot, bad := coverStrip(cover, &cursor, b); !slot {
+				if bad {
+					st.decodeErrors++
+					if strict {
+						sessionDecodeErrOffset[id] = int32(i)
+						ok = false
+						break
+					}
+				}
+				continue
+			}
+		}
+
+		// padding 标记只出现在 hint 组之间: 遇到时丢弃未完成的组重新同步
+		if b == padMarker {
+			if hintCount != 0 || escaped {
+				hintCount = 0
+				escaped = false
+				st.decodeErrors++
+				if strict {
+					sessionDecodeErrOffset[id] = int32(i)
+					ok = false
+					break
+				}
+			}
+			continue
+		}
+		// 按字母表还原为规范 hint 字节，其余字节视为 padding 跳过
+		b = alphaDec[b]
+		if !isHintASCII(b) {
+			continue
+		}
+
+		hintBuf[hintCount] = b
+		hintCount++
+
+		if hintCount == 4 {
+			var val uint8
+			var found bool
+			if escaped {
+				escaped = false
+				val, found = escapeLiteral(&hintBuf)
+			} else if escapePrefix(&hintBuf) {
+				escaped = true
+				hintCount = 0
+				continue
+			} else if key := packHintsToKey(hintBuf); constTime {
+				val, found = decodeTableLookupCT(table, key)
+			} else {
+				val, found = decodeTableLookupIn(table, key)
+			}
+			hintCount = 0
+			if found {
+				arena[out+outPos] = val
+				outPos++
+			} else {
+				st.decodeErrors++
+				if strict {
+					sessionDecodeErrOffset[id] = int32(i)
+					ok = false
+					break
+				}
+			}
+		}
+	}
+
+	copy(state[stateHintBuf:stateHintBuf+4], hintBuf[:])
+	state[stateHintCount] = hintCount
+	state[stateEscapeRx] = 0
+	if escaped {
+		state[stateEscapeRx] = 1
+	}
+	state[stateCoverRx] = cursor
+
+	st.bytesUnmasked += uint64(outPos)
+	if st.decodeErrors != decodeErrors {
+		logEvent(LogLevelWarn, logDecodeError, uint32(id))
+	}
+	return outPos, ok
+}
+
+// unmaskFlush - 取出并清空未完成的 hint 组
+// 流结束时调用: 输出为残留的原始 hint 字节 (0-3 个)，非空说明对端数据被截断
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export unmaskFlush
+func unmaskFlush(id int32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if rxClosed(id) {
+		return fail(errClosed)
+	}
+	state := &getSession(id).sudokuState
+
+	out, _ := sessionOut(id)
+	hintCount := uint32(state[stateHintCount])
+	// 4x4 未完成组以规范 hint 字节保存，按字母表还原为线路上的原始字节
+	alpha := &alphabetEncode[sessionAlphabet(state)]
+	profile9 := state[stateGridProfile] == GridProfile9x9
+	for i := uint32(0); i < hintCount; i++ {
+		b := state[stateHintBuf+i]
+		if !profile9 {
+			b = alpha[b&0x3F]
+		}
+		arena[out+i] = b
+	}
+	for i := uint32(0); i < 4; i++ {
+		state[stateHintBuf+i] = 0
+	}
+	state[stateHintCount] = 0
+	state[stateEscapeRx] = 0
+
+	setOutLen(id, hintCount)
+	lastError = errOK
+	return out
+}
+
+// setStrictDecode - 开启/关闭 session 的严格解码模式 (enable 非 0 为开启)
+// 开启后 unmask/unmaskAndOpen/unmaskFrame 遇到无法解码的 hint 组时中止并返回 errUndecodable，
+// 宿主可据此断开被篡改的连接，而不是交付错误数据
+// 返回值: 0 成功, -1 session 无效
+//
+//export setStrictDecode
+func setStrictDecode(id int32, enable uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	session := getSession(id)
+	if enable != 0 {
+		session.flags |= sessionFlagStrictDecode
+	} else {
+		session.flags &^= sessionFlagStrictDecode
+	}
+	return 0
+}
+
+// getDecodeErrorOffset - 最近一次解码调用因 errUndecodable 中止时，出错 hint 字节在该次输入中的偏移
+// 返回值: 偏移 (>= 0), -1 表示未中止或 session 无效
+//
+//export getDecodeErrorOffset
+func getDecodeErrorOffset(id int32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	return sessionDecodeErrOffset[id]
+}
+
+// getFreeSessionCount - 剩余可用 session 槽数量
+//
+//export getFreeSessionCount
+func getFreeSessionCount() uint32 {
+	if !wasmInitialized {
+		return maxSessions
+	}
+	return sessionFreeCount
+}
+
+// aeadOverhead - AEAD 帧的 nonce+tag 开销 (含密钥承诺标签，见 commit.go)
+func aeadOverhead(session *SudokuInstance) uint32 {
+	if session.flags&sessionFlagDatagram != 0 {
+		return datagramSeqSize + uint32(session.tagSize) + commitOverhead(session)
+	}
+	return uint32(session.nonceSize) + uint32(session.tagSize) + commitOverhead(session)
+}
+
+// sealAndMask - AEAD 加密后直接 mask，一次调用完成发送路径
+// 密文暂存于 workBuf 后半段 (aeadScratchBase)，输入不得与该区域重叠；
+// 若 session 绑定了独立输出区，则暂存于该区域尾部
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export sealAndMask
+func sealAndMask(id int32, inPtr uint32, inLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(inPtr, inLen) {
+		return fail(errOutOfBounds)
+	}
+	if inLen == 0 {
+		return fail(errEmptyInput)
+	}
+	if !rateCheck(id, inLen) {
+		return fail(errRateLimited)
+	}
+	session := getSession(id)
+	// 开启压缩时按最坏情况 (原样存储) 预留，压缩后再确定实际长度
+	sealedLen := sealPlainMax(id, inLen) + aeadOverhead(session)
+	out, outCap := sessionOut(id)
+	scratch := uint32(aeadScratchBase)
+	if sessionOutPtr[id] != 0 {
+		// [mask 输出 (最多 sealedLen*6+32) | 密文暂存 (sealedLen)]
+		if sealedLen*7+32 > outCap {
+			return fail(errOutputTooSmall)
+		}
+		outCap -= sealedLen
+		scratch = out + outCap
+	} else if sealedLen > maskMaxChunk {
+		return fail(errInputTooLarge)
+	}
+
+	plainPtr, plainLen := compressForSeal(id, inPtr, inLen, out)
+	sealedLen = plainLen + aeadOverhead(session)
+	if recordSeal(session, plainPtr, plainLen, scratch) != sealedLen {
+		return fail(errCipher)
+	}
+	sessionStats[id].framesSealed++
+	traceSealed(id, inLen)
+	rekeyAfterSeal(id, inLen)
+	rateCharge(id, inLen)
+
+	maxOut := maskMaxOut(id, sealedLen, outCap)
+	outPos, ok := maskSealed(id, scratch, sealedLen, out, maxOut)
+	if !ok {
+		setOutLen(id, 0)
+		return fail(maskShortError())
+	}
+
+	setOutLen(id, outPos)
+	lastError = errOK
+	return out
+}
+
+// maskSealed - mask 一个完整的 AEAD 帧/记录
+// 帧不能拆分发送: 放不下时回滚 RNG 状态与模板游标，不产生任何输出
+// nonce counter 不回滚，以免同一 nonce 被再次使用
+func maskSealed(id int32, framePtr uint32, frameLen uint32, out uint32, maxOut uint32) (uint32, bool) {
+	state := &getSession(id).sudokuState
+	if getSession(id).flags&sessionFlagDatagram != 0 {
+		// 每条记录可能单独成为一个报文，模板从起点开始
+		state[stateCoverTx] = 0
+	}
+	var savedRng [4]byte
+	copy(savedRng[:], state[stateRngState:stateRngState+4])
+	savedCursor := state[stateCoverTx]
+	savedLayout := state[stateLayoutTx]
+
+	outPos, consumed := maskBody(id, framePtr, frameLen, out, maxOut-1)
+	if consumed < frameLen {
+		copy(state[stateRngState:stateRngState+4], savedRng[:])
+		state[stateCoverTx] = savedCursor
+		state[stateLayoutTx] = savedLayout
+		return 0, false
+	}
+	return maskTail(id, out, outPos, maxOut), true
+}
+
+// unmaskAndOpen - unmask 后直接 AEAD 解密，一次调用完成接收路径
+// 输入必须包含一个完整的 sealAndMask 帧
+// 若 session 绑定了独立输出区，则在该区域内原地解码、解密
+// 每个 session 维护 64 帧的抗重放窗口，重复或过旧的 nonce counter 被拒绝
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败，原因通过 getLastError / getOpenStatus 获取
+//
+//export unmaskAndOpen
+func unmaskAndOpen(id int32, inPtr uint32, inLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if rxClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(inPtr, inLen) {
+		return fail(errOutOfBounds)
+	}
+	if inLen == 0 {
+		return fail(errEmptyInput)
+	}
+	out, outCap := sessionOut(id)
+	scratch, scratchCap := uint32(aeadScratchBase), uint32(aeadScratchSize)
+	if sessionOutPtr[id] != 0 {
+		// 明文写回密文起始处: 解密按地址递增读写，读位置始终领先写位置
+		scratch, scratchCap = out, outCap
+	}
+
+	sealedLen, ok := unmaskBody(id, inPtr, inLen, scratch, scratchCap)
+	if !ok {
+		sessionOpenStatus[id] = unmaskFailure(id)
+		return fail(sessionOpenStatus[id])
+	}
+	if sealedLen == 0 {
+		sessionOpenStatus[id] = errDecode
+		return fail(errDecode)
+	}
+
+	plainLen, status := openChecked(id, scratch, sealedLen, out)
+	if status == errOK {
+		plainLen, status = decompressOpened(id, out, outCap, plainLen)
+	}
+	sessionOpenStatus[id] = status
+	if status != errOK {
+		setOutLen(id, 0)
+		return fail(status)
+	}
+
+	setOutLen(id, plainLen)
+	lastError = errOK
+	return out
+}
+
+// openChecked - 经抗重放检查的 AEAD 解密
+// 先检查窗口，认证通过后才更新，伪造帧不会推进窗口
+func openChecked(id int32, framePtr uint32, frameLen uint32, out uint32) (uint32, int32) {
+	session := getSession(id)
+	nonceCtr, hasCtr := replayCounter(session, framePtr, frameLen)
+	if hasCtr && (!replayCheck(session, nonceCtr) || datagramTooOld(id, session, nonceCtr)) {
+		sessionStats[id].decodeErrors++
+		traceEvent(id, traceOpenFail, errReplay, frameLen, nonceCtr)
+		return 0, errReplay
+	}
+
+	plainLen := recordOpen(session, framePtr, frameLen, out, nonceCtr)
+	if plainLen == 0 {
+		sessionStats[id].decodeErrors++
+		logEvent(LogLevelWarn, logTagFailure, uint32(id))
+		traceEvent(id, traceOpenFail, errAuth, frameLen, nonceCtr)
+		return 0, errAuth
+	}
+	if hasCtr {
+		replayAccept(session, nonceCtr)
+	}
+	sessionStats[id].framesOpened++
+	traceEvent(id, traceOpen, errOK, plainLen, nonceCtr)
+	rekeyAfterOpen(id, plainLen)
+	return plainLen, errOK
+}
+
+// 抗重放窗口大小 (replayBitmap 位数)
+const replayWindowSize = 64
+
+var sessionOpenStatus [maxSessions]int32
+
+// replayCounter - 取帧中 nonce 的 counter (nonce 末 8 字节，大端序)；数据报模式下由截断序号还原
+func replayCounter(session *SudokuInstance, framePtr uint32, frameLen uint32) (uint64, bool) {
+	if session.flags&sessionFlagDatagram != 0 {
+		if frameLen < datagramSeqSize {
+			return 0, false
+		}
+		return datagramCounter(session.rxCounter, binary.BigEndian.Uint32(arena[framePtr:framePtr+datagramSeqSize])), true
+	}
+	n := uint32(session.nonceSize)
+	if n < 8 || frameLen < n {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(arena[framePtr+n-8 : framePtr+n]), true
+}
+
+// replayCheck - counter 未被接受过且未落在窗口之外时返回 true
+func replayCheck(session *SudokuInstance, ctr uint64) bool {
+	if ctr > session.rxCounter {
+		return true
+	}
+	diff := session.rxCounter - ctr
+	if diff >= replayWindowSize {
+		return false
+	}
+	return session.replayBitmap&(1<<diff) == 0
+}
+
+// replayAccept - 将 counter 记入窗口
+func replayAccept(session *SudokuInstance, ctr uint64) {
+	if ctr > session.rxCounter {
+		shift := ctr - session.rxCounter
+		if shift >= replayWindowSize {
+			session.replayBitmap = 0
+		} else {
+			session.replayBitmap <<= shift
+		}
+		session.replayBitmap |= 1
+		session.rxCounter = ctr
+		return
+	}
+	session.replayBitmap |= 1 << (session.rxCounter - ctr)
+}
+
+// getOpenStatus - 最近一次 unmaskAndOpen / unmaskFrame 的结果
+// 与 getLastError 不同，该状态按 session 保存，不受其他 session 调用影响
+// 返回值: 0 成功，否则为 errors.go 中的错误码
+//
+//export getOpenStatus
+func getOpenStatus(id int32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return errBadSession
+	}
+	return sessionOpenStatus[id]
+}
+
+// 记录层: [长度 (2 字节, 大端序)][载荷]，整条记录整体 mask
+// 载荷为 AEAD 帧 [nonce][ct][tag] (CipherNone 时为明文)，长度字段为载荷长度
+// 数据记录的载荷不可能为空，长度 0 留作控制记录的前缀 (见 control.go)
+const (
+	frameHeaderSize = 2
+	frameMaxPayload = 0xFFFF
+)
+
+// maskFrame - 将 inLen 字节封装为一条记录并 mask
+// 若 session 绑定了独立输出区，则记录暂存于该区域尾部
+// setShapingPolicy 开启后输出前部为块描述符 (格式见 shaping.go)
+// setMaxFrameSize 设置了 MTU 时切分为多条记录，输出同样以块描述符开头 (见 mtu.go)
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取)
+//
+//export maskFrame
+func maskFrame(id int32, inPtr uint32, inLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(inPtr, inLen) {
+		return fail(errOutOfBounds)
+	}
+	if inLen == 0 {
+		return fail(errEmptyInput)
+	}
+	if !rateCheck(id, inLen) {
+		return fail(errRateLimited)
+	}
+	if sessionFECSlot[id] != 0 {
+		return maskFrameFEC(id, inPtr, inLen)
+	}
+	if sessionMaxFrame[id] != 0 {
+		return maskFrameSplit(id, inPtr, inLen)
+	}
+	session := getSession(id)
+	// 开启压缩时按最坏情况 (原样存储) 预留，压缩后再确定实际长度
+	payloadLen := sealPlainMax(id, inLen) + aeadOverhead(session)
+	if payloadLen > frameMaxPayload {
+		return fail(errInputTooLarge)
+	}
+	recordLen := frameHeaderSize + payloadLen
+	out, outCap := sessionOut(id)
+	scratch := uint32(aeadScratchBase)
+	if sessionOutPtr[id] != 0 {
+		// [mask 输出 (最多 recordLen*6+32) | 记录暂存 (recordLen)]
+		if recordLen*7+32 > outCap {
+			return fail(errOutputTooSmall)
+		}
+		outCap -= recordLen
+		scratch = out + outCap
+	} else if recordLen > maskMaxChunk {
+		return fail(errInputTooLarge)
+	}
+
+	plainPtr, plainLen := compressForSeal(id, inPtr, inLen, out)
+	payloadLen = plainLen + aeadOverhead(session)
+	recordLen = frameHeaderSize + payloadLen
+	binary.BigEndian.PutUint16(arena[scratch:scratch+frameHeaderSize], uint16(payloadLen))
+	if recordSeal(session, plainPtr, plainLen, scratch+frameHeaderSize) != payloadLen {
+		return fail(errCipher)
+	}
+	sessionStats[id].framesSealed++
+	traceSealed(id, inLen)
+	rekeyAfterSeal(id, inLen)
+	rateCharge(id, inLen)
+	return maskRecordOut(id, scratch, recordLen, out, outCap)
+}
+
+// maskRecordOut - mask scratch 中已封装的一条记录，按 maskFrame 的输出格式写入 out
+func maskRecordOut(id int32, scratch uint32, recordLen uint32, out uint32, outCap uint32) uint32 {
+	reserved, maxOut, ok := maskOutReserve(id, maskMaxOut(id, recordLen, outCap), outCap)
+	if !ok {
+		setOutLen(id, 0)
+		return fail(errOutputTooSmall)
+	}
+	outPos, ok := maskSealed(id, scratch, recordLen, out+reserved, maxOut)
+	if !ok {
+		setOutLen(id, 0)
+		return fail(maskShortError())
+	}
+	return maskOutFinish(id, out, reserved, outPos)
+}
+
+// maskOutReserve - 按 maskFrame 的输出格式为最长 maxOut 字节的 mask 字节流预留块描述符区
+// 开启流量整形时按整形策略预留 (见 shaping.go)；只设置了 MTU 时为单个块描述符 (见 mtu.go)
+// 返回值: (预留长度, 截断到输出区的 maxOut, 输出区是否足够)
+func maskOutReserve(id int32, maxOut uint32, outCap uint32) (uint32, uint32, bool) {
+	reserved := shapingReserve(id, maxOut)
+	if reserved == 0 && sessionMaxFrame[id] != 0 {
+		reserved = 4 + shapingDescSize
+	}
+	if reserved >= outCap {
+		return 0, 0, false
+	}
+	if maxOut > outCap-reserved {
+		maxOut = outCap - reserved
+	}
+	return reserved, maxOut, true
+}
+
+// maskOutFinish - 为 out+reserved 起 outPos 字节的 mask 字节流写入块描述符 (预留见 maskOutReserve) 并设置输出长度
+func maskOutFinish(id int32, out uint32, reserved uint32, outPos uint32) uint32 {
+	if sessionShaping[id].target != 0 {
+		outPos = shapeChunks(id, out, reserved, outPos)
+	} else if reserved > 0 {
+		binary.LittleEndian.PutUint32(arena[out:out+4], 1)
+		binary.LittleEndian.PutUint32(arena[out+4:out+8], reserved)
+		binary.LittleEndian.PutUint32(arena[out+8:out+12], outPos)
+		binary.LittleEndian.PutUint32(arena[out+12:out+16], 0)
+		outPos += reserved
+	}
+
+	setOutLen(id, outPos)
+	lastError = errOK
+	return out
+}
+
+// unmaskFrame - unmask 并逐条解开记录，输出各记录明文的拼接
+// 输入须以记录边界结束 (可包含多条记录)；首条记录失败则整体失败，
+// 之后的记录失败时返回此前各记录的明文并停止处理，getOpenStatus 返回该记录的错误码
+// (此前的记录已计入抗重放窗口，丢弃其明文会使重传被当作重放拒绝)
+// 控制记录 (心跳等，见 control.go) 在模块内处理，不计入输出；只含 padding 的输入 (buildCoverFrame) 输出为空
+// 遇到关闭记录时返回此前的数据并停止处理，getOpenStatus 返回 errClosed (见 close.go)
+// 若 session 绑定了独立输出区，则在该区域内原地解码、解密
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败，原因通过 getLastError / getOpenStatus 获取
+//
+//export unmaskFrame
+func unmaskFrame(id int32, inPtr uint32, inLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if rxClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(inPtr, inLen) {
+		return fail(errOutOfBounds)
+	}
+	if inLen == 0 {
+		return fail(errEmptyInput)
+	}
+	session := getSession(id)
+	out, outCap := sessionOut(id)
+	scratch, scratchCap := uint32(aeadScratchBase), uint32(aeadScratchSize)
+	if sessionOutPtr[id] != 0 {
+		// 同 unmaskAndOpen: 明文写回位置始终不超过当前记录的读位置
+		scratch, scratchCap = out, outCap
+	}
+
+	decodedLen, ok := unmaskBody(id, inPtr, inLen, scratch, scratchCap)
+	if !ok {
+		sessionOpenStatus[id] = unmaskFailure(id)
+		setOutLen(id, 0)
+		return fail(sessionOpenStatus[id])
+	}
+
+	overhead := aeadOverhead(session)
+	pos := uint32(0)
+	outPos := uint32(0)
+	closed := false
+	partial := int32(errOK) // 首条之后的记录失败时的错误码
+	for pos < decodedLen {
+		start := pos
+		status := int32(errOK)
+		if decodedLen-pos < frameHeaderSize {
+			status = errBadFrame
+		} else {
+			payloadLen := uint32(binary.BigEndian.Uint16(arena[scratch+pos : scratch+pos+frameHeaderSize]))
+			pos += frameHeaderSize
+			if payloadLen == 0 {
+				// 长度 0 为控制记录 (见 control.go)，除 FEC 数据外明文不计入输出
+				n, dataLen, st := openControlRecord(id, scratch+pos, decodedLen-pos, out+outPos)
+				if st == errClosed {
+					closed = true
+					break
+				}
+				status = st
+				if st == errOK {
+					pos += n
+					outPos += dataLen
+				}
+			} else if payloadLen <= overhead || payloadLen > decodedLen-pos {
+				sessionStats[id].decodeErrors++
+				status = errBadFrame
+			} else {
+				plainLen, st := openChecked(id, scratch+pos, payloadLen, out+outPos)
+				status = st
+				if st == errOK {
+					pos += payloadLen
+					outPos += plainLen
+				}
+			}
+		}
+		if status == errOK {
+			continue
+		}
+		if start == 0 {
+			sessionOpenStatus[id] = status
+			setOutLen(id, 0)
+			return fail(status)
+		}
+		partial = status
+		break
+	}
+
+	// 各记录明文连续排列，压缩载荷自带边界，统一解压
+	outPos, status := decompressOpened(id, out, outCap, outPos)
+	if status != errOK {
+		sessionOpenStatus[id] = status
+		setOutLen(id, 0)
+		return fail(status)
+	}
+
+	sessionOpenStatus[id] = partial
+	if closed {
+		sessionOpenStatus[id] = errClosed
+	}
+	setOutLen(id, outPos)
+	lastError = errOK
+	return out
+}
+
+//export getOutLen
+func getOutLen() uint32 {
+	return currentOutLen
+}
+
+// getSessionOutLen - 该 session 最近一次输出的长度 (不受其他 session 调用影响)
+//
+//export getSessionOutLen
+func getSessionOutLen(id int32) uint32 {
+	if id < 0 || id >= maxSessions {
+		return 0
+	}
+	return sessionOutLen[id]
+}
+
+// setPaddingPolicy - 设置 session 的 padding 比例与 padding 池
+// thresholdPercent: 每个插入点插入 padding 的概率 (0-100)
+// poolPtr/poolLen: 自定义 padding 字节池 (最多 32 字节)，poolLen=0 时保留当前池
+// padding 字节不得落在 hint 字节范围内，否则接收端无法区分；
+// 替换字母表的 layout 只允许其 padding 字符集中的字节 (见 codec_alphabet.go)
+// 池中的 padding 标记 (LayoutASCII 为 0x3F，替换字母表为其 padding 字符集的首字符) 只会插在 hint 组之间，接收端据此重新同步
+// 返回值: 0 成功, -1 session 无效, -2 参数越界, -3 池中含 hint 字节或该 layout 不允许的字节
+//
+//export setPaddingPolicy
+func setPaddingPolicy(id int32, thresholdPercent uint32, poolPtr uint32, poolLen uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if thresholdPercent > 100 || poolLen > maxPadPoolSize {
+		return -2
+	}
+	if poolLen > 0 && !inArenaRange(poolPtr, poolLen) {
+		return -2
+	}
+	state := &getSession(id).sudokuState
+	for i := uint32(0); i < poolLen; i++ {
+		if !isPaddingAllowed(state, arena[poolPtr+i]) {
+			return -3
+		}
+	}
+
+	threshold := thresholdPercent * 65536 / 100
+	if threshold > 0xFFFF {
+		threshold = 0xFFFF
+	}
+	binary.BigEndian.PutUint16(state[statePadThreshold:statePadThreshold+2], uint16(threshold))
+
+	if poolLen > 0 {
+		pool := &sessionPadPool[id]
+		for i := uint32(0); i < maxPadPoolSize; i++ {
+			if i < poolLen {
+				pool[i] = arena[poolPtr+i]
+			} else {
+				pool[i] = 0
+			}
+		}
+		state[statePadPoolSize] = uint8(poolLen)
+		state[statePadPoolSize2] = uint8(poolLen)
+	}
+	return 0
+}
+
+// session 统计计数 (getSessionStats 按字段顺序输出 6 个 uint64，小端序)
+// SudokuInstance 已无空余字节，计数单独存放
+type sessionStatsCounters struct {
+	bytesMasked     uint64 // 送入 mask 的输入字节数
+	bytesUnmasked   uint64 // unmask 解出的字节数
+	framesSealed    uint64 // 加密的 AEAD 帧数
+	framesOpened    uint64 // 解密并通过认证的 AEAD 帧数
+	decodeErrors    uint64 // 无法解码的 hint 组，以及认证失败/重放/记录格式错误的帧
+	padBytesEmitted uint64 // 输出的 padding 字节数
+	rekeyFlags      uint32 // 自上次 getSessionStats 以来的密钥轮换 (rekeyFlagTx / rekeyFlagRx，读取后清零)
+	rekeyCount      uint32 // 自动轮换总次数
+}
+
+const sessionStatsSize = 56
+
+var sessionStats [maxSessions]sessionStatsCounters
+
+// nonce salt 长度: nonce 前 4 字节
+const nonceSaltLen = 4
+
+// setNonceSalt - 设置 session 的 nonce salt (nonce 前 4 字节)
+// 应在第一次加密前调用，之后修改会导致对端看到不连续的 nonce 前缀
+// 返回值: 0 成功, -1 session 无效, -2 指针越界, -3 长度不是 4
+//
+//export setNonceSalt
+func setNonceSalt(id int32, saltPtr uint32, saltLen uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(saltPtr, saltLen) {
+		return -2
+	}
+	if saltLen != nonceSaltLen {
+		return -3
+	}
+	state := &getSession(id).sudokuState
+	copy(state[stateNonceSalt:stateNonceSalt+nonceSaltLen], arena[saltPtr:saltPtr+saltLen])
+	return 0
+}
+
+// getSessionStats - 将 session 统计计数写入 arena[outPtr:outPtr+56]
+// 前 48 字节为 6 个 u64 计数，之后为 rekeyFlags (u32，读取后清零) 与 rekeyCount (u32)
+// 返回值: 0 成功, -1 session 无效, -2 输出越界
+//
+//export getSessionStats
+func getSessionStats(id int32, outPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(outPtr, sessionStatsSize) {
+		return -2
+	}
+	st := &sessionStats[id]
+	out := arena[outPtr : outPtr+sessionStatsSize]
+	binary.LittleEndian.PutUint64(out[0:8], st.bytesMasked)
+	binary.LittleEndian.PutUint64(out[8:16], st.bytesUnmasked)
+	binary.LittleEndian.PutUint64(out[16:24], st.framesSealed)
+	binary.LittleEndian.PutUint64(out[24:32], st.framesOpened)
+	binary.LittleEndian.PutUint64(out[32:40], st.decodeErrors)
+	binary.LittleEndian.PutUint64(out[40:48], st.padBytesEmitted)
+	binary.LittleEndian.PutUint32(out[48:52], st.rekeyFlags)
+	binary.LittleEndian.PutUint32(out[52:56], st.rekeyCount)
+	st.rekeyFlags = 0
+	return 0
+}
+
+// packOutResult - 将 (指针, 长度) 打包为单个返回值: ptr<<32 | len
+func packOutResult(ptr uint32, n uint32) uint64 {
+	return uint64(ptr)<<32 | uint64(n)
+}
+
+// maskV2 - 同 mask，但指针与长度一并返回，无需再调用 getOutLen
+// 返回值: ptr<<32 | len, 0 表示失败 (原因通过 getLastError 获取)
+//
+//export maskV2
+func maskV2(id int32, inPtr uint32, inLen uint32) uint64 {
+	out := mask(id, inPtr, inLen)
+	if out == 0 {
+		return 0
+	}
+	return packOutResult(out, sessionOutLen[id])
+}
+
+// unmaskV2 - 同 unmask，但指针与长度一并返回
+// 返回值: ptr<<32 | len, 0 表示失败 (原因通过 getLastError 获取)
+//
+//export unmaskV2
+func unmaskV2(id int32, inPtr uint32, inLen uint32) uint64 {
+	out := unmask(id, inPtr, inLen)
+	if out == 0 {
+		return 0
+	}
+	return packOutResult(out, sessionOutLen[id])
+}
+
+// setSessionOutBuf - 为 session 绑定独立输出区
+// ptr=0 解除绑定，恢复使用共享 outBuf
+// 返回值: 0 成功, -1 session 无效, -2 区域越界
+//
+//export setSessionOutBuf
+func setSessionOutBuf(id int32, ptr uint32, capacity uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	sessionOutSlots[id] = outSlots{}
+	if ptr == 0 {
+		sessionOutPtr[id] = 0
+		sessionOutCap[id] = 0
+		return 0
+	}
+	if capacity < 64 || !inArenaRange(ptr, capacity) {
+		return -2
+	}
+	sessionOutPtr[id] = ptr
+	sessionOutCap[id] = capacity
+	return 0
+}
+
+//export getArenaPtr
+func getArenaPtr() uint32 {
+	return 0
+}
+
+//export getSessionAddr
+func getSessionAddr(id int32) uint32 {
+	if id < 0 || id >= maxSessions {
+		return 0
+	}
+	return sessionBase + uint32(id)*sessionSize
+}
+
+//export getWorkBuf
+func getWorkBuf() uint32 {
+	return workBufBase
+}
+
+//export getOutBuf
+func getOutBuf() uint32 {
+	return outBufBase
+}
+
+// getMemoryLayout 输出: 12 个 uint32 (小端序)
+// arenaSize, maxSessions, sessionBase, sessionSize, workBufBase, workBufSize,
+// outBufBase, outBufSize, heapBase, heapEnd, tableBase, maxCodecTables
+const memoryLayoutSize = 48
+
+// getMemoryLayout - 报告当前构建的内存布局，宿主据此定位各区域而无需硬编码地址
+// 返回值: 输出指针 (位于 outBuf，长度 48，也可通过 getOutLen 获取)
+//
+//export getMemoryLayout
+func getMemoryLayout() uint32 {
+	fields := [memoryLayoutSize / 4]uint32{
+		arenaSize, maxSessions, sessionBase, sessionSize, workBufBase, workBufSize,
+		outBufBase, outBufSize, heapBase, heapEnd, tableBase, maxCodecTables,
+	}
+	for i, v := range fields {
+		binary.LittleEndian.PutUint32(arena[outBufBase+uint32(i)*4:outBufBase+uint32(i)*4+4], v)
+	}
+	currentOutLen = memoryLayoutSize
+	return outBufBase
+}
+
+// getCapabilities 输出: 9 个 uint32 (小端序)
+//
+//	[0]  ABI 版本 (capAbiVersion)
+//	[1]  支持的 cipherType 位图 (bit n = cipherType n)
+//	[2]  支持的 layoutType 位图 (bit0 ASCII, bit2 Base64URL, bit3 HeaderToken, bit7 LayoutKeyed)
+//	[3]  支持的 gridProfile 位图 (bit n = gridProfile n)
+//	[4]  maxSessions
+//	[5]  arenaSize
+//	[6]  功能位图 (capFeature*)
+//	[7]  单次 mask 输入上限 (maskMaxChunk)
+//	[8]  功能位图 2 (capFeature2*，[6] 的 32 位用尽后新增，旧宿主只读前 8 项不受影响)
+const capabilitiesSize = 36
+
+// ABI 版本: 导出函数签名或输出布局不兼容变化时递增
+const capAbiVersion = 1
+
+// getCapabilities 功能位
+const (
+	capFeatureStreaming     = 1 << 0  // maskBegin/maskUpdate/maskEnd, unmaskFlush
+	capFeatureSealAndMask   = 1 << 1  // sealAndMask/unmaskAndOpen
+	capFeatureFrames        = 1 << 2  // maskFrame/unmaskFrame
+	capFeatureExplicitNonce = 1 << 3  // aeadEncryptWithNonce/aeadDecryptWithNonce
+	capFeatureRekey         = 1 << 4  // rekeySession/setRekeyPolicy
+	capFeatureRoles         = 1 << 5  // initSession role 参数 (方向密钥)
+	capFeatureLogging       = 1 << 6  // setLogLevel + env.logEvent
+	capFeatureBoundsTrap    = 1 << 7  // arenadebug 构建: 越界指针直接 trap
+	capFeatureSimdChaCha    = 1 << 8  // chachasimd 构建: ChaCha20 4 块并行 (wasm SIMD128)
+	capFeatureHandshake     = 1 << 9  // handshakeStart/handshakeConsume (X25519)
+	capFeatureCoverProfile  = 1 << 10 // setCoverProfile 伪装流量模板
+	capFeatureShaping       = 1 << 11 // setShapingPolicy 流量整形 (maskFrame 块描述符)
+	capFeatureSessionExport = 1 << 12 // exportSession/importSession
+	capFeaturePipe          = 1 << 13 // pipeTransform
+	capFeatureBench         = 1 << 14 // maskBench/aeadBench
+	capFeatureLazyTables    = 1 << 15 // codec 表首次使用时构建，warmCodecTables
+	capFeatureMaxFrame      = 1 << 16 // setMaxFrameSize 记录 MTU
+	capFeatureKeepalive     = 1 << 17 // buildKeepalive 控制记录
+	capFeatureCloseNotify   = 1 << 18 // buildCloseNotify/getCloseReason
+	capFeatureControlMsg    = 1 << 19 // buildControlMessage/getControlMessage
+	capFeatureMux           = 1 << 20 // openStream/streamSend/streamRecv/closeStream/streamCredit/getSendWindow
+	capFeatureFEC           = 1 << 21 // setFECPolicy/flushFEC/getFECStats
+	capFeatureDatagram      = 1 << 22 // setDatagramMode
+	capFeatureCoverFrame    = 1 << 23 // buildCoverFrame
+	capFeatureAnalyze       = 1 << 24 // analyzeOutput
+	capFeatureDRBG          = 1 << 25 // SessionOptDRBG (ChaCha8 mask 随机源)
+	capFeatureEntropy       = 1 << 26 // env.getRandom: handshakeStartRandom/randomizeNonceSalt/shufflePaddingPool
+	capFeatureResume        = 1 << 27 // issueResumptionToken/resumeSession
+	capFeatureEarlyData     = 1 << 28 // sealEarlyData/openEarlyData, SessionOptEarlyData
+	capFeaturePSK           = 1 << 29 // initSessionPSK/pskIdentityHint/helloIdentityHint
+	capFeatureIdentityQuota = 1 << 30 // setIdentityQuota/registerIdentity
+	capFeatureLayoutHeader  = 1 << 31 // SessionOptLayoutHeader
+)
+
+// getCapabilities 功能位 (第二个位图)
+const (
+	capFeature2CodecFingerprint = 1 << 0  // getCodecFingerprint
+	capFeature2Trace            = 1 << 1  // SessionOptTrace/getTrace
+	capFeature2Poly1305         = 1 << 2  // poly1305Sum/poly1305Verify
+	capFeature2ChaChaXor        = 1 << 3  // chachaXor
+	capFeature2KeyCommit        = 1 << 4  // SessionOptKeyCommit
+	capFeature2ForbidBytes      = 1 << 5  // setForbiddenBytes
+	capFeature2Escape           = 1 << 6  // 无编码变体的字节以转义组输出 (escape.go)
+	capFeature2SelfRoundTrip    = 1 << 7  // selfRoundTrip
+	capFeature2RNGDraws         = 1 << 8  // getLastRNGDraws / setRNGDrawLog
+	capFeature2OutputTo         = 1 << 9  // maskTo / unmaskTo
+	capFeature2OutSlots         = 1 << 10 // setOutBufSlots / acquireOutBuf / releaseOutBuf
+	capFeature2BuildInfo        = 1 << 11 // getBuildInfo
+)
+
+// getCapabilities - 报告当前构建支持的功能，供宿主在多个 .wasm 构建间做运行时检测
+// 返回值: 输出指针 (位于 outBuf，长度 36，也可通过 getOutLen 获取)
+//
+//export getCapabilities
+func getCapabilities() uint32 {
+	layouts := uint32(1<<LayoutASCII | 1<<LayoutBase64URL | 1<<LayoutHeaderToken)
+	if maxCodecTables > 1 {
+		layouts |= LayoutKeyed
+	}
+	features := uint32(capFeatureStreaming | capFeatureSealAndMask | capFeatureFrames |
+		capFeatureExplicitNonce | capFeatureRekey | capFeatureRoles | capFeatureLogging | capFeatureHandshake |
+		capFeatureCoverProfile | capFeatureShaping | capFeatureSessionExport | capFeaturePipe | capFeatureBench |
+		capFeatureLazyTables | capFeatureMaxFrame | capFeatureKeepalive | capFeatureCloseNotify |
+		capFeatureControlMsg | capFeatureMux | capFeatureFEC | capFeatureDatagram | capFeatureCoverFrame | capFeatureAnalyze |
+		capFeatureDRBG | capFeatureEntropy | capFeatureResume | capFeatureEarlyData | capFeaturePSK | capFeatureIdentityQuota | capFeatureLayoutHeader)
+	if boundsTrap {
+		features |= capFeatureBoundsTrap
+	}
+	if chachaSimd {
+		features |= capFeatureSimdChaCha
+	}
+	fields := [capabilitiesSize / 4]uint32{
+		capAbiVersion,
+		cipherTypeMask(),
+		layouts,
+		1<<GridProfile4x4 | 1<<GridProfile9x9,
+		maxSessions,
+		arenaSize,
+		features,
+		maskMaxChunk,
+		capFeature2CodecFingerprint | capFeature2Trace | capFeature2Poly1305 | capFeature2ChaChaXor |
+			capFeature2KeyCommit | capFeature2ForbidBytes | capFeature2Escape |
+			capFeature2SelfRoundTrip | capFeature2RNGDraws | capFeature2OutputTo |
+			capFeature2OutSlots | capFeature2BuildInfo,
+	}
+	for i, v := range fields {
+		binary.LittleEndian.PutUint32(arena[outBufBase+uint32(i)*4:outBufBase+uint32(i)*4+4], v)
+	}
+	currentOutLen = capabilitiesSize
+	return outBufBase
+}
+
+// initCodecTables - 将默认 codec 表恢复为默认种子，keyed 槽位不受影响
+// 表在首次 mask/unmask 时构建 (见 ensureCodecRows)，需要提前构建时调用 warmCodecTables
+//
+//export initCodecTables
+func initCodecTables() {
+	if !wasmInitialized {
+		initWasm()
+		return
+	}
+	resetCodecTable(&codecTables[defaultCodecTable], defaultCodecSeed)
+}
+
+// initCodecTablesWithKey - 以密钥派生的种子 (SHA-256(key) 前 8 字节) 重建默认 codec 表
+// 之后所有未置 LayoutKeyed 的 session 使用该表，应在 initSession 之前调用
+// 按 session 区分的表应改用 initSession 的 LayoutKeyed
+// 只登记种子，表在首次使用时构建；解码表构建失败时该次 unmask 返回 errUndecodable
+// 返回值: 0 成功, -1 密钥为空, -2 指针越界
+//
+//export initCodecTablesWithKey
+func initCodecTablesWithKey(keyPtr uint32, keyLen uint32) int32 {
+	if keyLen == 0 {
+		return -1
+	}
+	if !inArenaRange(keyPtr, keyLen) {
+		return -2
+	}
+	if !wasmInitialized {
+		initWasm()
+	}
+	seed := codecSeedFromKey(arena[keyPtr : keyPtr+keyLen])
+	resetCodecTable(&codecTables[defaultCodecTable], seed)
+	return 0
+}
+
+// warmCodecTables - 立即构建默认表与所有使用中的 keyed 表 (编码表全部行与解码表)
+// 宿主可在空闲时调用，避免首个请求承担建表耗时 (约数毫秒)
+// 返回值: 0 成功, -3 解码表构建失败
+//
+//export warmCodecTables
+func warmCodecTables() int32 {
+	if !wasmInitialized {
+		initWasm()
+	}
+	for i := 0; i < maxCodecTables; i++ {
+		t := &codecTables[i]
+		if i != defaultCodecTable && t.refs == 0 {
+			continue
+		}
+		if !ensureCodecDecode(t) {
+			return -3
+		}
+	}
+	return 0
+}
@@ -0,0 +1,87 @@
+package sudoku
+
+import (
+	"bytes"
+	"testing"
+)
+
+// maskTestSession - 以固定密钥创建指定 gridProfile 的 session
+func maskTestSession(t *testing.T, gridProfile uint8) int32 {
+	t.Helper()
+	initWasm()
+	id := initSessionKey(bytes.Repeat([]byte{0x37}, 32), CipherNone, LayoutASCII, gridProfile, RoleShared, nil)
+	if id < 0 {
+		t.Fatalf("initSessionKey: %d", id)
+	}
+	t.Cleanup(func() { closeSession(id) })
+	return id
+}
+
+// 输出区不足时 mask 不静默截断: 返回 errOutputTooSmall 与已消费字节数，
+// 宿主按 getMaskConsumed 续传的拼接与一次性 mask 的输出逐字节一致，对端可完整解码
+func TestMaskOutputTooSmall(t *testing.T) {
+	in := make([]byte, 500)
+	for i := range in {
+		in[i] = byte(i * 7)
+	}
+	tests := []struct {
+		name        string
+		gridProfile uint8
+		padPercent  uint32
+		outCap      uint32
+	}{
+		{"4x4 min buffer", GridProfile4x4, 0, 64},
+		{"4x4 padding", GridProfile4x4, 50, 200},
+		{"9x9", GridProfile9x9, 0, 100},
+		{"9x9 padding", GridProfile9x9, 30, 1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref := maskTestSession(t, tt.gridProfile)
+			tx := maskTestSession(t, tt.gridProfile)
+			rx := maskTestSession(t, tt.gridProfile)
+			for _, id := range []int32{ref, tx} {
+				if r := setPaddingPolicy(id, tt.padPercent, 0, 0); r != 0 {
+					t.Fatalf("setPaddingPolicy: %d", r)
+				}
+			}
+			want, st := testCall(ref, in, mask)
+			if st != errOK {
+				t.Fatalf("mask: %d", st)
+			}
+
+			outPtr := uint32(workBufBase + 0x1000)
+			if r := setSessionOutBuf(tx, outPtr, tt.outCap); r != 0 {
+				t.Fatalf("setSessionOutBuf: %d", r)
+			}
+			copy(arena[workBufBase:], in)
+			var got []byte
+			short := 0
+			for pos := uint32(0); pos < uint32(len(in)); {
+				p := mask(tx, workBufBase+pos, uint32(len(in))-pos)
+				got = append(got, arena[outPtr:outPtr+sessionOutLen[tx]]...)
+				if p != 0 {
+					break
+				}
+				if lastError != errOutputTooSmall {
+					t.Fatalf("mask: lastError = %d, want %d", lastError, errOutputTooSmall)
+				}
+				c := getMaskConsumed(tx)
+				if c == 0 || sessionOutLen[tx] > tt.outCap {
+					t.Fatalf("mask: consumed %d, output %d of %d", c, sessionOutLen[tx], tt.outCap)
+				}
+				pos += c
+				short++
+			}
+			if short == 0 {
+				t.Fatal("output buffer never filled")
+			}
+			if !bytes.Equal(got, want) {
+				t.Fatalf("split output (%d bytes, %d short calls) differs from single mask (%d bytes)", len(got), short, len(want))
+			}
+			if dec, st := testCall(rx, got, unmask); st != errOK || !bytes.Equal(dec, in) {
+				t.Fatalf("unmask: %d bytes (%d)", len(dec), st)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// 内存使用统计 - 供容量规划: 堆高水位、并发 session 峰值、输出区高水位
+// 峰值自 initWasm 起累计，arenaReset / closeSession 不会降低
+// 输出区高水位按 setOutLen 记录的输出长度统计 (共享 outBuf 与绑定的 session 输出区分开)，
+// maskInPlace 写入宿主缓冲区，不计入
+
+package sudoku
+
+import "encoding/binary"
+
+var (
+	arenaPeak        uint32 // arenaPtr 峰值
+	arenaMallocFails uint32 // arenaMalloc 因堆空间不足失败的次数
+	sessionPeak      uint32 // 同时存在的 session 数峰值
+	outBufPeak       uint32 // 共享 outBuf 单次输出长度峰值
+	boundOutPeak     uint32 // 绑定输出区 (setSessionOutBuf) 单次输出长度峰值
+)
+
+// getMemoryStats 输出: 12 个 uint32 (小端序)
+//
+//	[0]  当前堆使用量 (arenaPtr - heapBase)
+//	[1]  堆使用量峰值
+//	[2]  堆容量 (heapEnd - heapBase)
+//	[3]  当前 session 数
+//	[4]  session 数峰值
+//	[5]  maxSessions
+//	[6]  共享 outBuf 高水位
+//	[7]  outBufSize
+//	[8]  绑定输出区高水位 (所有 session 中的最大值)
+//	[9]  使用中的 codec 表槽位 (含默认表)
+//	[10] maxCodecTables
+//	[11] arenaMalloc 失败次数
+const memoryStatsSize = 48
+
+// getMemoryStats - 将内存使用统计写入 arena[outPtr:outPtr+48]
+// 返回值: 48, -1 输出越界
+//
+//export getMemoryStats
+func getMemoryStats(outPtr uint32) int32 {
+	if !inArenaRange(outPtr, memoryStatsSize) {
+		return -1
+	}
+	if !wasmInitialized {
+		initWasm()
+	}
+	tables := uint32(1)
+	for i := 1; i < maxCodecTables; i++ {
+		if codecTables[i].refs > 0 {
+			tables++
+		}
+	}
+	fields := [memoryStatsSize / 4]uint32{
+		arenaPtr - heapBase, arenaPeak - heapBase, heapEnd - heapBase,
+		maxSessions - sessionFreeCount, sessionPeak, maxSessions,
+		outBufPeak, outBufSize, boundOutPeak,
+		tables, maxCodecTables, arenaMallocFails,
+	}
+	for i, v := range fields {
+		binary.LittleEndian.PutUint32(arena[outPtr+uint32(i)*4:outPtr+uint32(i)*4+4], v)
+	}
+	return memoryStatsSize
+}
+
+// noteSessionPeak - session 分配后更新并发峰值
+func noteSessionPeak() {
+	if n := maxSessions - sessionFreeCount; n > sessionPeak {
+		sessionPeak = n
+	}
+}
+
+// noteOutPeak - 记录输出长度高水位
+func noteOutPeak(id int32, n uint32) {
+	if sessionOutPtr[id] != 0 {
+		if n > boundOutPeak {
+			boundOutPeak = n
+		}
+	} else if n > outBufPeak {
+		outBufPeak = n
+	}
+}
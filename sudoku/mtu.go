@@ -0,0 +1,132 @@
+// 记录层 MTU - 不同传输的单条消息上限不同 (WebSocket 1MB、WebRTC datachannel 64KB、UDP 约 1200 字节)
+// setMaxFrameSize 后 maskFrame 把输入切成多条记录，保证每条记录 mask 后的长度不超过 MTU，
+// 输出布局与流量整形相同 (小端序，delayMs 恒为 0)，每个块恰好是一条完整记录:
+//   [块数 n (4)][n 个块描述符 {offset (4), length (4), delayMs (4)}][mask 字节流]
+// 宿主按块逐条发送，无需再分片；接收端可对每条消息单独调用 unmaskFrame，
+// 也可拼接后一次调用，输出按顺序拼接即为原输入
+// 每条记录的明文长度按最坏膨胀率 (maskPerByte) 计算，实际消息长度通常明显小于 MTU
+// 同时开启整形时块按整形策略切分并截断到 MTU，块不再与记录对齐，接收端须拼接后 unmaskFrame
+
+package sudoku
+
+import "encoding/binary"
+
+var sessionMaxFrame [maxSessions]uint32 // 0 表示不限制
+
+// setMaxFrameSize - 设置 session 单条 mask 记录的长度上限 (字节)，0 关闭
+// 上限按当前的 cipherType、压缩选项与伪装流量 profile 校验，之后更改这些设置时由 maskFrame 重新检查
+// 返回值: 0 成功, -1 session 无效, -2 上限过小 (容纳不下 1 字节明文的记录)
+//
+//export setMaxFrameSize
+func setMaxFrameSize(id int32, n uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if n != 0 && frameChunkPlain(id, n) == 0 {
+		return -2
+	}
+	sessionMaxFrame[id] = n
+	return 0
+}
+
+// frameChunkPlain - MTU 为 mtu 时单条记录可容纳的最大明文长度，0 表示一个字节也容纳不下
+func frameChunkPlain(id int32, mtu uint32) uint32 {
+	session := getSession(id)
+	// mask 输出最坏为 记录长度*maskPerByte + 1 (maskTail)
+	recordMax := (mtu - 1) / maskPerByte(&session.sudokuState)
+	if recordMax > frameHeaderSize+frameMaxPayload {
+		recordMax = frameHeaderSize + frameMaxPayload
+	}
+	fixed := frameHeaderSize + sealPlainMax(id, 0) + aeadOverhead(session)
+	if recordMax <= fixed {
+		return 0
+	}
+	return recordMax - fixed
+}
+
+// maskFrameSplit - maskFrame 在设置了 MTU 时的路径: 逐条封装、mask 记录并生成块描述符
+// 调用方已完成 session、指针、空输入与限速检查
+func maskFrameSplit(id int32, inPtr uint32, inLen uint32) uint32 {
+	session := getSession(id)
+	perByte := maskPerByte(&session.sudokuState)
+	chunk := frameChunkPlain(id, sessionMaxFrame[id])
+	if chunk == 0 {
+		return fail(errOutputTooSmall)
+	}
+	records := (inLen + chunk - 1) / chunk
+	recordMax := frameHeaderSize + sealPlainMax(id, chunk) + aeadOverhead(session)
+	streamMax := uint64(inLen)*uint64(perByte) + uint64(records)*uint64((recordMax-chunk)*perByte+1) + uint64(layoutHeaderReserve(id))
+
+	out, outCap := sessionOut(id)
+	scratch := uint32(aeadScratchBase)
+	if sessionOutPtr[id] != 0 {
+		// [描述符 | mask 输出 | 记录暂存 (recordMax)]
+		if recordMax >= outCap {
+			return fail(errOutputTooSmall)
+		}
+		outCap -= recordMax
+		scratch = out + outCap
+	}
+	var reserved uint32
+	if sessionShaping[id].target != 0 {
+		maxOut := outCap
+		if streamMax < uint64(outCap) {
+			maxOut = uint32(streamMax)
+		}
+		reserved = shapingReserve(id, maxOut)
+	} else {
+		reserved = 4 + records*shapingDescSize
+	}
+	if uint64(reserved)+streamMax > uint64(outCap) {
+		if sessionOutPtr[id] != 0 {
+			return fail(errOutputTooSmall)
+		}
+		return fail(errInputTooLarge)
+	}
+
+	stream := out + reserved
+	outPos := uint32(0)
+	for r := uint32(0); r < records; r++ {
+		off := r * chunk
+		n := chunk
+		if n > inLen-off {
+			n = inLen - off
+		}
+		// 压缩结果暂存于本条记录的输出位置，加密读取完毕后才被 mask 输出覆盖
+		plainPtr, plainLen := compressForSeal(id, inPtr+off, n, stream+outPos)
+		payloadLen := plainLen + aeadOverhead(session)
+		recordLen := frameHeaderSize + payloadLen
+		binary.BigEndian.PutUint16(arena[scratch:scratch+frameHeaderSize], uint16(payloadLen))
+		if recordSeal(session, plainPtr, plainLen, scratch+frameHeaderSize) != payloadLen {
+			setOutLen(id, 0)
+			return fail(errCipher)
+		}
+		sessionStats[id].framesSealed++
+		traceSealed(id, n)
+		rekeyAfterSeal(id, n)
+
+		masked, ok := maskSealed(id, scratch, recordLen, stream+outPos, recordLen*perByte+1+layoutHeaderReserve(id))
+		if !ok {
+			setOutLen(id, 0)
+			return fail(maskShortError())
+		}
+		if sessionShaping[id].target == 0 {
+			d := out + 4 + r*shapingDescSize
+			binary.LittleEndian.PutUint32(arena[d:d+4], reserved+outPos)
+			binary.LittleEndian.PutUint32(arena[d+4:d+8], masked)
+			binary.LittleEndian.PutUint32(arena[d+8:d+12], 0)
+		}
+		outPos += masked
+	}
+	rateCharge(id, inLen)
+
+	if sessionShaping[id].target != 0 {
+		outPos = shapeChunks(id, out, reserved, outPos)
+	} else {
+		binary.LittleEndian.PutUint32(arena[out:out+4], records)
+		outPos += reserved
+	}
+	setOutLen(id, outPos)
+	lastError = errOK
+	return out
+}
@@ -0,0 +1,494 @@
+// 多路复用 - 一个 session 上承载多条逻辑 stream (如一条 mask 隧道代理多个 TCP 连接)
+// stream 数据以控制记录 (controlStream) 传输，正文为 [streamId (2, 大端序)][op (1)][内容]:
+//   op 0 数据: 内容为 stream 字节
+//   op 1 FIN:  本方向结束，无内容
+//   op 2 窗口: 内容为 4 字节窗口增量 (大端序)；本端已 FIN 或不存在的 stream 的窗口更新被丢弃
+// streamId 0 保留 (streamRecv 以 stream 0 表示普通数据记录)，1-65535 由双方约定分配 (如客户端奇数、服务端偶数)
+// 对端首次出现的 streamId 在首条记录为合法数据或 FIN 时自动建立 (非法记录不占用 stream)；双方方向都 FIN 后释放
+//
+// 流量控制: 每个方向初始窗口 muxInitialWindow 字节，发送方超出窗口视为协议错误；
+// 接收方把数据交给宿主后不自动归还窗口，宿主消化数据后调用 streamCredit 生成窗口更新记录
+// stream 表为模块级 (所有 session 共用 muxMaxStreams 条)，每个 session 最多 muxSessionStreams 条，
+// 单个对端无法占满其他 session 的 stream；closeSession 释放该 session 的全部 stream
+
+package sudoku
+
+import "encoding/binary"
+
+const (
+	muxMaxStreams     = 256
+	muxSessionStreams = 64 // 每个 session 的 stream 上限
+	muxInitialWindow  = 65536
+	muxMaxWindow      = 1 << 30
+	muxHeaderSize     = 3 // streamId + op
+
+	muxOpData   = 0
+	muxOpFin    = 1
+	muxOpWindow = 2
+
+	muxLocalFin  = 1 << 0
+	muxRemoteFin = 1 << 1
+
+	streamRecvMaxEvents = 128
+)
+
+// streamRecv 事件标志
+const (
+	streamEventNew    = 1 << 0 // 对端新建的 stream (首个事件)
+	streamEventFin    = 1 << 1 // 对端结束发送
+	streamEventWindow = 1 << 2 // 发送窗口增大，被阻塞的 streamSend 可以重试
+)
+
+type muxStream struct {
+	owner   int32 // session ID + 1，0 表示空闲
+	sid     uint16
+	flags   uint8  // muxLocalFin | muxRemoteFin
+	sendWin uint32 // 对端允许本端继续发送的字节数
+	recvWin uint32 // 本端允许对端继续发送的字节数
+}
+
+type streamEvent struct {
+	sid   uint16
+	flags uint8
+	off   uint32
+	n     uint32
+}
+
+var (
+	muxStreams   [muxMaxStreams]muxStream
+	streamEvents [streamRecvMaxEvents]streamEvent // streamRecv 单次调用的事件暂存
+	streamEventN uint32
+)
+
+// muxLookup - session id 的 stream sid，不存在时返回 nil
+func muxLookup(id int32, sid uint16) *muxStream {
+	for i := range muxStreams {
+		s := &muxStreams[i]
+		if s.owner == id+1 && s.sid == sid {
+			return s
+		}
+	}
+	return nil
+}
+
+// muxCreate - 为 session id 建立 stream sid，表满或 session 已有 muxSessionStreams 条时返回 nil
+func muxCreate(id int32, sid uint16) *muxStream {
+	var free *muxStream
+	n := 0
+	for i := range muxStreams {
+		s := &muxStreams[i]
+		if s.owner == id+1 {
+			n++
+		} else if s.owner == 0 && free == nil {
+			free = s
+		}
+	}
+	if free == nil || n >= muxSessionStreams {
+		return nil
+	}
+	*free = muxStream{owner: id + 1, sid: sid, sendWin: muxInitialWindow, recvWin: muxInitialWindow}
+	return free
+}
+
+// muxRelease - 双方向都结束后释放 stream
+func muxRelease(s *muxStream) {
+	if s.flags&(muxLocalFin|muxRemoteFin) == muxLocalFin|muxRemoteFin {
+		*s = muxStream{}
+	}
+}
+
+// muxPurge - 释放 session id 的全部 stream (closeSession)
+func muxPurge(id int32) {
+	for i := range muxStreams {
+		if muxStreams[i].owner == id+1 {
+			muxStreams[i] = muxStream{}
+		}
+	}
+}
+
+// muxHasStreams - session id 是否持有 stream
+func muxHasStreams(id int32) bool {
+	for i := range muxStreams {
+		if muxStreams[i].owner == id+1 {
+			return true
+		}
+	}
+	return false
+}
+
+// openStream - 在 session 上建立 stream (双方向窗口均为 muxInitialWindow)，不产生输出
+// 对端在收到该 stream 的第一条记录时自动建立
+// 返回值: 0 成功, -1 session 无效, -2 streamId 为 0 或超过 65535, -3 stream 已存在, -4 stream 表已满或 session 的 stream 数已达上限
+//
+//export openStream
+func openStream(id int32, streamId uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if streamId == 0 || streamId > 0xFFFF {
+		return -2
+	}
+	if muxLookup(id, uint16(streamId)) != nil {
+		return -3
+	}
+	if muxCreate(id, uint16(streamId)) == nil {
+		return -4
+	}
+	return 0
+}
+
+// muxStreamFor - 本端仍可发送的 stream，失败时设置 lastError 并返回 nil
+func muxStreamFor(id int32, streamId uint32) *muxStream {
+	if streamId == 0 || streamId > 0xFFFF {
+		lastError = errNoStream
+		return nil
+	}
+	s := muxLookup(id, uint16(streamId))
+	if s == nil || s.flags&muxLocalFin != 0 {
+		lastError = errNoStream
+		return nil
+	}
+	return s
+}
+
+// streamSend - 将最多 inLen 字节作为 stream 数据封装为一条记录并 mask (输出格式同 maskFrame)
+// 实际发送量受发送窗口与单条记录容量限制，通过 getMaskConsumed 获取，宿主从 inPtr+consumed 继续发送剩余部分
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取:
+// -16 发送窗口为 0 (getSendWindow 为 0，等待 streamRecv 报告 streamEventWindow), -17 stream 不存在或本端已 closeStream)
+//
+//export streamSend
+func streamSend(id int32, streamId uint32, inPtr uint32, inLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(inPtr, inLen) {
+		return fail(errOutOfBounds)
+	}
+	if inLen == 0 {
+		return fail(errEmptyInput)
+	}
+	s := muxStreamFor(id, streamId)
+	if s == nil {
+		return 0
+	}
+	if s.sendWin == 0 {
+		return fail(errStreamBlocked)
+	}
+	n := inLen
+	if n > s.sendWin {
+		n = s.sendWin
+	}
+	room := controlBodyCap(id)
+	if room <= muxHeaderSize {
+		return fail(errOutputTooSmall)
+	}
+	if n > room-muxHeaderSize {
+		n = room - muxHeaderSize
+	}
+	if !rateCheck(id, n) {
+		return fail(errRateLimited)
+	}
+
+	out, _ := sessionOut(id)
+	copy(arena[out+1+muxHeaderSize:out+1+muxHeaderSize+n], arena[inPtr:inPtr+n])
+	binary.BigEndian.PutUint16(arena[out+1:out+3], uint16(streamId))
+	arena[out+3] = muxOpData
+	p := maskControlRecord(id, controlStream, muxHeaderSize+n)
+	if p == 0 {
+		return 0
+	}
+	s.sendWin -= n
+	rateCharge(id, n)
+	sessionMaskConsumed[id] = n
+	return p
+}
+
+// closeStream - 生成本方向的 FIN 记录并 mask (输出格式同 maskFrame)，之后不能再 streamSend
+// 对端也 FIN 后 stream 释放，streamId 可重新使用
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取，-17 stream 不存在或已关闭)
+//
+//export closeStream
+func closeStream(id int32, streamId uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	s := muxStreamFor(id, streamId)
+	if s == nil {
+		return 0
+	}
+	out, _ := sessionOut(id)
+	binary.BigEndian.PutUint16(arena[out+1:out+3], uint16(streamId))
+	arena[out+3] = muxOpFin
+	p := maskControlRecord(id, controlStream, muxHeaderSize)
+	if p == 0 {
+		return 0
+	}
+	s.flags |= muxLocalFin
+	muxRelease(s)
+	return p
+}
+
+// streamCredit - 宿主已消化 stream 的 n 字节接收数据，生成窗口更新记录并 mask (输出格式同 maskFrame)
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取:
+// -2 n 为 0, -3 窗口将超过 muxMaxWindow, -17 stream 不存在)
+//
+//export streamCredit
+func streamCredit(id int32, streamId uint32, n uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if txClosed(id) {
+		return fail(errClosed)
+	}
+	if streamId == 0 || streamId > 0xFFFF {
+		return fail(errNoStream)
+	}
+	s := muxLookup(id, uint16(streamId))
+	if s == nil {
+		return fail(errNoStream)
+	}
+	if n == 0 {
+		return fail(errEmptyInput)
+	}
+	if n > muxMaxWindow-s.recvWin {
+		return fail(errInputTooLarge)
+	}
+	out, _ := sessionOut(id)
+	binary.BigEndian.PutUint16(arena[out+1:out+3], uint16(streamId))
+	arena[out+3] = muxOpWindow
+	binary.BigEndian.PutUint32(arena[out+4:out+8], n)
+	p := maskControlRecord(id, controlStream, muxHeaderSize+4)
+	if p == 0 {
+		return 0
+	}
+	s.recvWin += n
+	return p
+}
+
+// getSendWindow - stream 当前的发送窗口 (对端尚未确认消化、本端还能发送的字节数)
+// 宿主据此对本地数据源施加背压: 窗口为 0 时停止读取本地 socket，直到 streamRecv 报告 streamEventWindow，
+// 避免对端较慢时数据在 Worker 中无限堆积
+// 返回值: 窗口字节数 (0 - muxMaxWindow), -1 session 无效, -2 stream 不存在或本端已关闭
+//
+//export getSendWindow
+func getSendWindow(id int32, streamId uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if streamId == 0 || streamId > 0xFFFF {
+		return -2
+	}
+	s := muxLookup(id, uint16(streamId))
+	if s == nil || s.flags&muxLocalFin != 0 {
+		return -2
+	}
+	return int32(s.sendWin)
+}
+
+// pushStreamEvent - 追加一个 streamRecv 事件，与前一个同 stream 的连续数据事件合并
+func pushStreamEvent(sid uint16, flags uint8, off uint32, n uint32) int32 {
+	if k := streamEventN; k > 0 && flags == 0 && n > 0 {
+		e := &streamEvents[k-1]
+		if e.sid == sid && e.n > 0 && e.flags&^streamEventNew == 0 && e.off+e.n == off {
+			e.n += n
+			return errOK
+		}
+	}
+	if streamEventN == streamRecvMaxEvents {
+		return errOutputTooSmall
+	}
+	streamEvents[streamEventN] = streamEvent{sid: sid, flags: flags, off: off, n: n}
+	streamEventN++
+	return errOK
+}
+
+// muxReceive - 处理明文 [类型][streamId][op][内容] (位于 plain，数据将前移到 plain)
+// 返回值: (留在输出中的数据长度, 错误码)
+func muxReceive(id int32, plain uint32, plainLen uint32, outPos uint32) (uint32, int32) {
+	if plainLen < 1+muxHeaderSize {
+		sessionStats[id].decodeErrors++
+		return 0, errBadFrame
+	}
+	sid := binary.BigEndian.Uint16(arena[plain+1 : plain+3])
+	op := arena[plain+3]
+	body := plain + 1 + muxHeaderSize
+	bodyLen := plainLen - 1 - muxHeaderSize
+	if sid == 0 {
+		sessionStats[id].decodeErrors++
+		return 0, errBadFrame
+	}
+	flags := uint8(0)
+	s := muxLookup(id, sid)
+	if op == muxOpWindow && bodyLen == 4 && (s == nil || s.flags&muxLocalFin != 0) {
+		// 本端已 FIN (或双方 FIN 后已释放) 的 stream 不再发送，迟到的窗口更新直接丢弃，不建立新 stream
+		return 0, errOK
+	}
+	if s == nil {
+		// 先校验再建立: 只有合法的首条记录 (窗口内的数据或 FIN) 才占用 stream
+		if !(op == muxOpData && bodyLen > 0 && bodyLen <= muxInitialWindow) && !(op == muxOpFin && bodyLen == 0) {
+			sessionStats[id].decodeErrors++
+			return 0, errBadFrame
+		}
+		if s = muxCreate(id, sid); s == nil {
+			return 0, errNoStream
+		}
+		flags = streamEventNew
+	}
+
+	switch {
+	case op == muxOpData && bodyLen > 0 && bodyLen <= s.recvWin && s.flags&muxRemoteFin == 0:
+		s.recvWin -= bodyLen
+		copy(arena[plain:plain+bodyLen], arena[body:body+bodyLen])
+		return bodyLen, pushStreamEvent(sid, flags, outPos, bodyLen)
+	case op == muxOpFin && bodyLen == 0 && s.flags&muxRemoteFin == 0:
+		s.flags |= muxRemoteFin
+		muxRelease(s)
+		return 0, pushStreamEvent(sid, flags|streamEventFin, outPos, 0)
+	case op == muxOpWindow && bodyLen == 4:
+		inc := binary.BigEndian.Uint32(arena[body : body+4])
+		if inc == 0 || inc > muxMaxWindow-s.sendWin {
+			break
+		}
+		s.sendWin += inc
+		return 0, pushStreamEvent(sid, flags|streamEventWindow, outPos, 0)
+	}
+	// 未知 op、超出窗口、FIN 之后的数据
+	sessionStats[id].decodeErrors++
+	return 0, errBadFrame
+}
+
+// streamRecvFail - 记录接收失败状态
+func streamRecvFail(id int32, status int32) uint32 {
+	sessionOpenStatus[id] = status
+	setOutLen(id, 0)
+	return fail(status)
+}
+
+// streamRecv - unmask 并逐条解开记录，按 stream 拆分输出
+// 输出布局 (小端序): [事件数 n (4)][n 个事件 {streamId (2), flags (1), 0 (1), offset (4), length (4)}][数据]
+// offset 相对返回指针；同一 stream 的连续数据合并为一个事件；
+// stream 0 为普通数据记录 (maskFrame 发送，开启压缩的 session 不支持，返回 errBadFrame)
+// 心跳、控制消息、关闭记录与只含 padding 的输入的处理同 unmaskFrame；单次输入最多 streamRecvMaxEvents 个事件 (超出时 errOutputTooSmall)
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败，原因通过 getLastError / getOpenStatus 获取
+// (-8 记录非法或违反流量控制, -17 stream 表已满)
+//
+//export streamRecv
+func streamRecv(id int32, inPtr uint32, inLen uint32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	if rxClosed(id) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(inPtr, inLen) {
+		return fail(errOutOfBounds)
+	}
+	if inLen == 0 {
+		return fail(errEmptyInput)
+	}
+	session := getSession(id)
+	out, outCap := sessionOut(id)
+	scratch, scratchCap := uint32(aeadScratchBase), uint32(aeadScratchSize)
+	if sessionOutPtr[id] != 0 {
+		// 同 unmaskFrame: 明文写回位置始终不超过当前记录的读位置
+		scratch, scratchCap = out, outCap
+	}
+
+	decodedLen, ok := unmaskBody(id, inPtr, inLen, scratch, scratchCap)
+	if !ok {
+		return streamRecvFail(id, unmaskFailure(id))
+	}
+
+	compressed := session.flags&sessionFlagCompress != 0
+	overhead := aeadOverhead(session)
+	streamEventN = 0
+	pos := uint32(0)
+	outPos := uint32(0)
+	closed := false
+	for pos < decodedLen {
+		if decodedLen-pos < frameHeaderSize {
+			return streamRecvFail(id, errBadFrame)
+		}
+		payloadLen := uint32(binary.BigEndian.Uint16(arena[scratch+pos : scratch+pos+frameHeaderSize]))
+		pos += frameHeaderSize
+
+		if payloadLen != 0 {
+			if compressed || payloadLen <= overhead || payloadLen > decodedLen-pos {
+				sessionStats[id].decodeErrors++
+				return streamRecvFail(id, errBadFrame)
+			}
+			plainLen, status := openChecked(id, scratch+pos, payloadLen, out+outPos)
+			if status == errOK {
+				status = pushStreamEvent(0, 0, outPos, plainLen)
+			}
+			if status != errOK {
+				return streamRecvFail(id, status)
+			}
+			pos += payloadLen
+			outPos += plainLen
+			continue
+		}
+
+		n, plainLen, status := openControlPlain(id, scratch+pos, decodedLen-pos, out+outPos)
+		if status != errOK {
+			return streamRecvFail(id, status)
+		}
+		pos += n
+		switch t := arena[out+outPos]; {
+		case t == controlStream:
+			var dataLen uint32
+			dataLen, status = muxReceive(id, out+outPos, plainLen, outPos)
+			outPos += dataLen
+		case t == controlFECData || t == controlFECParity:
+			// FEC 保护的是 maskFrame 数据，按 stream 0 输出
+			if compressed {
+				sessionStats[id].decodeErrors++
+				return streamRecvFail(id, errBadFrame)
+			}
+			var dataLen uint32
+			if dataLen, status = fecReceive(id, out+outPos, plainLen); status == errOK && dataLen > 0 {
+				status = pushStreamEvent(0, 0, outPos, dataLen)
+			}
+			outPos += dataLen
+		default:
+			status = dispatchControl(id, out+outPos, plainLen)
+		}
+		if status == errClosed {
+			closed = true
+			break
+		}
+		if status != errOK {
+			return streamRecvFail(id, status)
+		}
+	}
+
+	hdr := 4 + streamEventN*shapingDescSize
+	if hdr > outCap || outPos > outCap-hdr {
+		return streamRecvFail(id, errOutputTooSmall)
+	}
+	copy(arena[out+hdr:out+hdr+outPos], arena[out:out+outPos])
+	binary.LittleEndian.PutUint32(arena[out:out+4], streamEventN)
+	for i := uint32(0); i < streamEventN; i++ {
+		e := &streamEvents[i]
+		d := out + 4 + i*shapingDescSize
+		binary.LittleEndian.PutUint16(arena[d:d+2], e.sid)
+		arena[d+2] = e.flags
+		arena[d+3] = 0
+		binary.LittleEndian.PutUint32(arena[d+4:d+8], hdr+e.off)
+		binary.LittleEndian.PutUint32(arena[d+8:d+12], e.n)
+	}
+
+	sessionOpenStatus[id] = errOK
+	if closed {
+		sessionOpenStatus[id] = errClosed
+	}
+	setOutLen(id, hdr+outPos)
+	lastError = errOK
+	return out
+}
@@ -0,0 +1,293 @@
+package sudoku
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+// muxEvent - streamRecv 输出中的一个事件
+type muxEvent struct {
+	sid   uint16
+	flags uint8
+	data  string
+}
+
+// muxTestOut - 复制导出函数的输出，失败时返回 lastError
+func muxTestOut(id int32, p uint32) ([]byte, int32) {
+	if p == 0 {
+		return nil, lastError
+	}
+	return append([]byte(nil), arena[p:p+sessionOutLen[id]]...), errOK
+}
+
+// muxTestSend - streamSend 的输出与实际发送的字节数
+func muxTestSend(t *testing.T, id int32, sid uint32, data string) ([]byte, uint32) {
+	t.Helper()
+	copy(arena[workBufBase:], data)
+	out, st := muxTestOut(id, streamSend(id, sid, workBufBase, uint32(len(data))))
+	if st != errOK {
+		t.Fatalf("streamSend(%d): %d", sid, st)
+	}
+	return out, getMaskConsumed(id)
+}
+
+// muxTestRecv - streamRecv 并解析事件
+func muxTestRecv(id int32, in []byte) ([]muxEvent, int32) {
+	out, st := testCall(id, in, streamRecv)
+	if st != errOK {
+		return nil, st
+	}
+	n := binary.LittleEndian.Uint32(out[0:4])
+	events := make([]muxEvent, n)
+	for i := range events {
+		d := out[4+i*shapingDescSize:]
+		off, l := binary.LittleEndian.Uint32(d[4:8]), binary.LittleEndian.Uint32(d[8:12])
+		events[i] = muxEvent{binary.LittleEndian.Uint16(d[0:2]), d[2], string(out[off : off+l])}
+	}
+	return events, errOK
+}
+
+// 多条 stream 交错发送: 接收端按 stream 拆分数据，首个事件带 streamEventNew，双方 FIN 后 streamId 可重用
+func TestMuxStreams(t *testing.T) {
+	type send struct {
+		sid  uint32
+		data string // 空表示 closeStream
+	}
+	tests := []struct {
+		name  string
+		sends []send
+		want  []muxEvent
+	}{
+		{"single", []send{{1, "hello"}}, []muxEvent{{1, streamEventNew, "hello"}}},
+		{"interleaved", []send{{1, "a1"}, {3, "b1"}, {1, "a2"}, {3, "b2"}},
+			[]muxEvent{{1, streamEventNew, "a1"}, {3, streamEventNew, "b1"}, {1, 0, "a2"}, {3, 0, "b2"}}},
+		{"merge consecutive", []send{{5, "ab"}, {5, "cd"}, {7, "x"}},
+			[]muxEvent{{5, streamEventNew, "abcd"}, {7, streamEventNew, "x"}}},
+		{"fin", []send{{1, "bye"}, {1, ""}}, []muxEvent{{1, streamEventNew, "bye"}, {1, streamEventFin, ""}}},
+		{"fin only", []send{{9, ""}}, []muxEvent{{9, streamEventNew | streamEventFin, ""}}},
+		{"max stream id", []send{{0xFFFF, "z"}}, []muxEvent{{0xFFFF, streamEventNew, "z"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, rx := testPair(t, CipherChaCha20Poly)
+			var in []byte
+			for _, s := range tt.sends {
+				if muxLookup(tx, uint16(s.sid)) == nil {
+					if r := openStream(tx, s.sid); r != 0 {
+						t.Fatalf("openStream(%d): %d", s.sid, r)
+					}
+				}
+				var out []byte
+				if s.data == "" {
+					var st int32
+					if out, st = muxTestOut(tx, closeStream(tx, s.sid)); st != errOK {
+						t.Fatalf("closeStream(%d): %d", s.sid, st)
+					}
+				} else {
+					out, _ = muxTestSend(t, tx, s.sid, s.data)
+				}
+				in = append(in, out...)
+			}
+			got, st := muxTestRecv(rx, in)
+			if st != errOK {
+				t.Fatalf("streamRecv: %d", st)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("events = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("event %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+
+	// 双方 FIN 后释放，同一 streamId 可重新建立
+	tx, rx := testPair(t, CipherChaCha20Poly)
+	openStream(tx, 2)
+	out, _ := muxTestSend(t, tx, 2, "one")
+	muxTestRecv(rx, out)
+	out, _ = muxTestOut(tx, closeStream(tx, 2))
+	muxTestRecv(rx, out)
+	out, _ = muxTestOut(rx, closeStream(rx, 2))
+	muxTestRecv(tx, out)
+	if muxLookup(tx, 2) != nil || muxLookup(rx, 2) != nil {
+		t.Fatal("stream not released after both FINs")
+	}
+	if r := openStream(tx, 2); r != 0 {
+		t.Fatalf("reopen stream 2: %d", r)
+	}
+	out, _ = muxTestSend(t, tx, 2, "two")
+	if got, st := muxTestRecv(rx, out); st != errOK || len(got) != 1 || got[0] != (muxEvent{2, streamEventNew, "two"}) {
+		t.Fatalf("reused stream: %+v (%d)", got, st)
+	}
+}
+
+// 每个 session 的 stream 数上限: 本端 openStream 与对端自动建立都受限，不影响其他 session
+func TestMuxStreamCap(t *testing.T) {
+	tx, rx := testPair(t, CipherChaCha20Poly)
+	for sid := uint32(1); sid <= muxSessionStreams; sid++ {
+		if r := openStream(rx, sid); r != 0 {
+			t.Fatalf("openStream(%d): %d", sid, r)
+		}
+	}
+	tests := []struct {
+		name string
+		sid  uint32
+		want int32
+	}{
+		{"over cap", muxSessionStreams + 1, -4},
+		{"existing", 1, -3},
+		{"stream 0", 0, -2},
+		{"too large", 0x10000, -2},
+	}
+	for _, tt := range tests {
+		if r := openStream(rx, tt.sid); r != tt.want {
+			t.Fatalf("openStream(%s) = %d, want %d", tt.name, r, tt.want)
+		}
+	}
+
+	// 对端新建的 stream 超出上限时拒绝 (errNoStream)，已有 stream 照常接收
+	openStream(tx, 1000)
+	out, _ := muxTestSend(t, tx, 1000, "x")
+	if _, st := muxTestRecv(rx, out); st != errNoStream {
+		t.Fatalf("streamRecv(new stream over cap) = %d, want %d", st, errNoStream)
+	}
+	openStream(tx, 1)
+	out, _ = muxTestSend(t, tx, 1, "y")
+	if got, st := muxTestRecv(rx, out); st != errOK || len(got) != 1 || got[0].data != "y" {
+		t.Fatalf("streamRecv(existing stream) = %+v (%d)", got, st)
+	}
+
+	// 其他 session 不受影响；关闭 session 释放其全部 stream
+	other := testSession(t, CipherChaCha20Poly, RoleServer)
+	if r := openStream(other, muxSessionStreams+1); r != 0 {
+		t.Fatalf("openStream on another session: %d", r)
+	}
+	closeSession(rx)
+	if muxHasStreams(rx) {
+		t.Fatal("closeSession left streams behind")
+	}
+}
+
+// muxTestDrain - 以 chunk 字节的 streamSend 耗尽 stream 的发送窗口并逐条交给对端，返回发送总量
+func muxTestDrain(t *testing.T, tx, rx int32, sid uint32, chunk int) uint32 {
+	t.Helper()
+	data := make([]byte, chunk)
+	total := uint32(0)
+	for getSendWindow(tx, sid) > 0 {
+		out, n := muxTestSend(t, tx, sid, string(data))
+		if _, st := muxTestRecv(rx, out); st != errOK {
+			t.Fatalf("streamRecv: %d", st)
+		}
+		total += n
+	}
+	return total
+}
+
+// 发送窗口耗尽后 streamSend 返回 errStreamBlocked，对端 streamCredit 的窗口更新恢复发送
+func TestMuxWindowUpdate(t *testing.T) {
+	tests := []struct {
+		name   string
+		chunk  int
+		credit []uint32 // 对端依次归还的窗口
+	}{
+		{"single credit", 4096, []uint32{1000}},
+		{"accumulated credits", 3000, []uint32{100, 200, 300}},
+		{"full window", 5000, []uint32{muxInitialWindow}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, rx := testPair(t, CipherChaCha20Poly)
+			openStream(tx, 1)
+			if sent := muxTestDrain(t, tx, rx, 1, tt.chunk); sent != muxInitialWindow {
+				t.Fatalf("sent %d bytes before blocking, want %d", sent, muxInitialWindow)
+			}
+			copy(arena[workBufBase:], "x")
+			if p := streamSend(tx, 1, workBufBase, 1); p != 0 || lastError != errStreamBlocked {
+				t.Fatalf("streamSend(blocked) = %d (%d), want %d", p, lastError, errStreamBlocked)
+			}
+
+			var in []byte
+			want := uint32(0)
+			for _, c := range tt.credit {
+				out, st := muxTestOut(rx, streamCredit(rx, 1, c))
+				if st != errOK {
+					t.Fatalf("streamCredit(%d): %d", c, st)
+				}
+				in = append(in, out...)
+				want += c
+			}
+			got, st := muxTestRecv(tx, in)
+			if st != errOK || len(got) != len(tt.credit) {
+				t.Fatalf("streamRecv(window updates) = %+v (%d)", got, st)
+			}
+			for _, e := range got {
+				if e != (muxEvent{1, streamEventWindow, ""}) {
+					t.Fatalf("window event = %+v", e)
+				}
+			}
+			if w := getSendWindow(tx, 1); w != int32(want) {
+				t.Fatalf("getSendWindow = %d, want %d", w, want)
+			}
+			if sent := muxTestDrain(t, tx, rx, 1, tt.chunk); sent != want {
+				t.Fatalf("sent %d bytes after credit, want %d", sent, want)
+			}
+		})
+	}
+}
+
+// 流量控制的错误路径: 非法 credit、超出窗口的数据、未知或本端已 FIN 的 stream 的窗口更新
+func TestMuxFlowControlErrors(t *testing.T) {
+	tx, rx := testPair(t, CipherChaCha20Poly)
+	openStream(tx, 1)
+	out, _ := muxTestSend(t, tx, 1, "abc")
+	muxTestRecv(rx, out)
+
+	creditTests := []struct {
+		name string
+		sid  uint32
+		n    uint32
+		want int32
+	}{
+		{"zero", 1, 0, errEmptyInput},
+		{"over max window", 1, muxMaxWindow, errInputTooLarge},
+		{"unknown stream", 2, 10, errNoStream},
+		{"stream 0", 0, 10, errNoStream},
+	}
+	for _, tt := range creditTests {
+		if p := streamCredit(rx, tt.sid, tt.n); p != 0 || lastError != tt.want {
+			t.Fatalf("streamCredit(%s) = %d (%d), want %d", tt.name, p, lastError, tt.want)
+		}
+	}
+	if w := getSendWindow(tx, 2); w != -2 {
+		t.Fatalf("getSendWindow(unknown) = %d, want -2", w)
+	}
+
+	// 发送方越过窗口视为协议错误
+	muxLookup(tx, 1).sendWin = muxMaxWindow
+	out, n := muxTestSend(t, tx, 1, string(make([]byte, 4000)))
+	for n <= muxLookup(rx, 1).recvWin {
+		muxTestRecv(rx, out)
+		out, n = muxTestSend(t, tx, 1, string(make([]byte, 4000)))
+	}
+	if _, st := muxTestRecv(rx, out); st != errBadFrame {
+		t.Fatalf("streamRecv(over window) = %d, want %d", st, errBadFrame)
+	}
+
+	// 未知 stream 与本端已 FIN 的 stream 的窗口更新被丢弃，不建立 stream、不产生事件
+	openStream(rx, 7)
+	update, _ := muxTestOut(rx, streamCredit(rx, 7, 100))
+	if got, st := muxTestRecv(tx, update); st != errOK || len(got) != 0 || muxLookup(tx, 7) != nil {
+		t.Fatalf("window update for unknown stream: %+v (%d)", got, st)
+	}
+	openStream(tx, 9)
+	out, _ = muxTestSend(t, tx, 9, "q")
+	muxTestRecv(rx, out)
+	muxTestOut(tx, closeStream(tx, 9))
+	update, _ = muxTestOut(rx, streamCredit(rx, 9, 100))
+	if got, st := muxTestRecv(tx, update); st != errOK || len(got) != 0 {
+		t.Fatalf("window update for finished stream: %+v (%d)", got, st)
+	}
+}
@@ -0,0 +1,131 @@
+// 通用 session 选项 - 新增的小开关统一经 setSessionOption/getSessionOption 设置，不必每次新增导出
+// 布尔选项存放于 SudokuInstance.flags (sessionFlag*)，数值选项存放于 sudokuState 既有字段
+
+package sudoku
+
+import "encoding/binary"
+
+// optionId 取值
+const (
+	SessionOptConstTimeDecode = 1  // 0/1，同 setConstantTimeDecode
+	SessionOptStrictDecode    = 2  // 0/1，同 setStrictDecode
+	SessionOptPaddingPercent  = 3  // 0-100，同 setPaddingPolicy 的 thresholdPercent (保留当前 padding 池)
+	SessionOptWipeOnClose     = 4  // 0/1，closeSession 时清零该 session 的输出区与 AEAD 暂存区
+	SessionOptCompress        = 5  // 0/1，sealAndMask/maskFrame 加密前压缩，unmaskAndOpen/unmaskFrame 解压 (双方须一致)
+	SessionOptDecompressLimit = 6  // 单次调用解压后的字节数上限，0 表示仅受输出区容量限制
+	SessionOptDRBG            = 7  // 0/1，mask 随机源改用以 session 密钥派生的 ChaCha8 DRBG (仅影响本端输出，见 drbg.go)
+	SessionOptEarlyData       = 8  // 0/1，server: 宿主确认 client 消息不在重放缓存中，下一次 handshakeConsume 接受早期数据
+	SessionOptLayoutHeader    = 9  // 0/1，首次输出携带布局头并校验对端的布局头 (双方须一致，见 layouthdr.go)
+	SessionOptTrace           = 10 // 0/1，记录最近的协议事件供 getTrace 读取 (见 trace.go)，关闭时丢弃已有记录
+	SessionOptKeyCommit       = 11 // 0/1，AEAD 帧追加 HMAC-SHA256 密钥承诺标签 (双方须一致，见 commit.go)
+)
+
+// sessionOptionFlag - 布尔选项对应的 flags 位，非布尔选项返回 0
+func sessionOptionFlag(optionId uint32) uint32 {
+	switch optionId {
+	case SessionOptConstTimeDecode:
+		return sessionFlagConstTimeDecode
+	case SessionOptStrictDecode:
+		return sessionFlagStrictDecode
+	case SessionOptWipeOnClose:
+		return sessionFlagWipeOnClose
+	case SessionOptCompress:
+		return sessionFlagCompress
+	case SessionOptDRBG:
+		return sessionFlagDRBG
+	case SessionOptEarlyData:
+		return sessionFlagEarlyData
+	case SessionOptLayoutHeader:
+		return sessionFlagLayoutHeader
+	case SessionOptKeyCommit:
+		return sessionFlagKeyCommit
+	}
+	return 0
+}
+
+// setSessionOption - 设置 session 选项
+// 返回值: 0 成功, -1 session 无效, -2 未知选项, -3 取值非法, -4 trace 槽位已用完
+//
+//export setSessionOption
+func setSessionOption(id int32, optionId uint32, value uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if flag := sessionOptionFlag(optionId); flag != 0 {
+		if value > 1 {
+			return -3
+		}
+		session := getSession(id)
+		if flag == sessionFlagKeyCommit && value != 0 && session.cipherType == CipherNone {
+			return -3
+		}
+		if value != 0 {
+			enable := session.flags&flag == 0
+			session.flags |= flag
+			if flag == sessionFlagDRBG && enable {
+				drbgStart(id)
+			}
+		} else {
+			session.flags &^= flag
+		}
+		return 0
+	}
+	switch optionId {
+	case SessionOptPaddingPercent:
+		if setPaddingPolicy(id, value, 0, 0) != 0 {
+			return -3
+		}
+		return 0
+	case SessionOptDecompressLimit:
+		if value > 0x7FFFFFFF {
+			return -3
+		}
+		state := &getSession(id).sudokuState
+		binary.BigEndian.PutUint32(state[stateDecompressMax:stateDecompressMax+4], value)
+		return 0
+	case SessionOptTrace:
+		if value > 1 {
+			return -3
+		}
+		if value == 0 {
+			traceRelease(id)
+			return 0
+		}
+		if !traceEnable(id) {
+			return -4
+		}
+		return 0
+	}
+	return -2
+}
+
+// getSessionOption - 读取 session 选项的当前值
+// 返回值: 选项值 (>=0), -1 session 无效, -2 未知选项
+//
+//export getSessionOption
+func getSessionOption(id int32, optionId uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	session := getSession(id)
+	if flag := sessionOptionFlag(optionId); flag != 0 {
+		if session.flags&flag != 0 {
+			return 1
+		}
+		return 0
+	}
+	switch optionId {
+	case SessionOptPaddingPercent:
+		state := &session.sudokuState
+		threshold := uint32(binary.BigEndian.Uint16(state[statePadThreshold : statePadThreshold+2]))
+		return int32((threshold*100 + 32768) >> 16)
+	case SessionOptDecompressLimit:
+		return int32(sessionDecompressLimit(id))
+	case SessionOptTrace:
+		if sessionTraceSlot[id] != 0 {
+			return 1
+		}
+		return 0
+	}
+	return -2
+}
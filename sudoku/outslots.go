@@ -0,0 +1,94 @@
+// 双缓冲输出区 - setOutBufSlots 把宿主划定的区域等分为两个槽位，session 的输出轮流写入其中一个:
+// 宿主以 acquireOutBuf 取得最近一次结果所在的槽位 (此后模块不再写入)，session 的输出区随即切换到另一个槽位，
+// 宿主异步处理完结果 N (如等待 WebSocket 发送完成) 后以 releaseOutBuf 归还，期间模块可以继续把结果 N+1 写入另一个槽位
+// 两个槽位都被取走时 acquireOutBuf 失败，当前槽位保持可写，宿主须先归还一个槽位才能再次取走
+// 槽位即 setSessionOutBuf 绑定的独立输出区，之后调用 setSessionOutBuf 会解除双缓冲
+
+package sudoku
+
+type outSlots struct {
+	base    uint32 // 0 表示未开启
+	slotCap uint32
+	cur     uint8 // 当前绑定为输出区的槽位
+	held    uint8 // bit i: 槽位 i 已被宿主取走
+}
+
+var sessionOutSlots [maxSessions]outSlots
+
+// outSlotPtr - 槽位 i 的起始地址
+func outSlotPtr(s *outSlots, i uint8) uint32 {
+	return s.base + uint32(i)*s.slotCap
+}
+
+// setOutBufSlots - 以 arena[ptr:ptr+capacity] 的前后两半作为 session 的双缓冲输出区，槽位 0 先绑定；ptr=0 关闭并恢复使用共享 outBuf
+// 返回值: 0 成功
+//
+//	-1 session 无效
+//	-2 区域越界或每个槽位不足 64 字节
+//
+//export setOutBufSlots
+func setOutBufSlots(id int32, ptr uint32, capacity uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if ptr == 0 {
+		sessionOutSlots[id] = outSlots{}
+		sessionOutPtr[id] = 0
+		sessionOutCap[id] = 0
+		return 0
+	}
+	slotCap := (capacity / 2) &^ 7
+	if slotCap < 64 || !inArenaRange(ptr, capacity) {
+		return -2
+	}
+	sessionOutSlots[id] = outSlots{base: ptr, slotCap: slotCap}
+	sessionOutPtr[id] = ptr
+	sessionOutCap[id] = slotCap
+	return 0
+}
+
+// acquireOutBuf - 取走最近一次结果所在的槽位 (即当前输出区)，并把 session 的输出区切换到另一个槽位
+// 结果长度仍通过 getOutLen / getSessionOutLen 获取
+// 返回值: 槽位指针, 0 表示失败 (原因通过 getLastError 获取: -1 session 无效, -23 未开启双缓冲或另一个槽位尚未归还)
+//
+//export acquireOutBuf
+func acquireOutBuf(id int32) uint32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return fail(errBadSession)
+	}
+	s := &sessionOutSlots[id]
+	next := s.cur ^ 1
+	if s.base == 0 || s.held&(1<<next) != 0 {
+		return fail(errOutBufBusy)
+	}
+	ptr := outSlotPtr(s, s.cur)
+	s.held |= 1 << s.cur
+	s.cur = next
+	sessionOutPtr[id] = outSlotPtr(s, next)
+	lastError = errOK
+	return ptr
+}
+
+// releaseOutBuf - 归还 acquireOutBuf 取走的槽位
+// 返回值: 0 成功
+//
+//	-1 session 无效
+//	-2 ptr 不是已取走的槽位
+//
+//export releaseOutBuf
+func releaseOutBuf(id int32, ptr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	s := &sessionOutSlots[id]
+	if s.base == 0 {
+		return -2
+	}
+	for i := uint8(0); i < 2; i++ {
+		if ptr == outSlotPtr(s, i) && s.held&(1<<i) != 0 {
+			s.held &^= 1 << i
+			return 0
+		}
+	}
+	return -2
+}
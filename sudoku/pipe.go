@@ -0,0 +1,108 @@
+// 中继管道 - 一个 session 收、另一个 session 发 (relay Worker: client → upstream)
+// pipeTransform 在模块内完成 unmask → open → seal → mask，中间结果不经过宿主
+//
+// 区域复用 (dst 为发送方向 session):
+//   dst 输出区: 解码与解密均在此原地进行，密文读取完毕后再被 mask 输出覆盖
+//   密文暂存: dst 未绑定输出区时为 workBuf 后半段 (aeadScratchBase)，否则为其输出区尾部 (同 sealAndMask)
+// 输入不得与 dst 输出区及暂存区重叠
+
+package sudoku
+
+// pipeTransform - 以 srcId 解出一个完整的 sealAndMask 帧，再以 dstId 重新加密并 mask
+// src/dst 的 cipherType 可不同 (CipherNone 时跳过对应的 open/seal)，srcId 与 dstId 可相同
+// 输出与对明文调用 sealAndMask(dstId) 一致，写入 dst 的输出区
+// 返回值: 输出指针 (长度通过 getOutLen 获取), 0 表示失败 (原因通过 getLastError 获取，
+// 接收阶段的失败同时记入 getOpenStatus(srcId))
+//
+//export pipeTransform
+func pipeTransform(srcId int32, dstId int32, inPtr uint32, inLen uint32) uint32 {
+	if srcId < 0 || srcId >= maxSessions || sessionUsed[srcId] == 0 ||
+		dstId < 0 || dstId >= maxSessions || sessionUsed[dstId] == 0 {
+		return fail(errBadSession)
+	}
+	if rxClosed(srcId) || txClosed(dstId) {
+		return fail(errClosed)
+	}
+	if !inArenaRange(inPtr, inLen) {
+		return fail(errOutOfBounds)
+	}
+	if inLen == 0 {
+		return fail(errEmptyInput)
+	}
+	out, outCap := sessionOut(dstId)
+
+	// 接收: 在 dst 输出区内原地解码、解密 (同 unmaskAndOpen 绑定输出区的情形)
+	sealedLen, ok := unmaskBody(srcId, inPtr, inLen, out, outCap)
+	if !ok {
+		sessionOpenStatus[srcId] = unmaskFailure(srcId)
+		setOutLen(dstId, 0)
+		return fail(sessionOpenStatus[srcId])
+	}
+	if sealedLen == 0 {
+		sessionOpenStatus[srcId] = errDecode
+		setOutLen(dstId, 0)
+		return fail(errDecode)
+	}
+	plainLen, status := openChecked(srcId, out, sealedLen, out)
+	if status == errOK {
+		plainLen, status = decompressOpened(srcId, out, outCap, plainLen)
+	}
+	sessionOpenStatus[srcId] = status
+	if status != errOK {
+		setOutLen(dstId, 0)
+		return fail(status)
+	}
+	if plainLen == 0 {
+		setOutLen(dstId, 0)
+		return fail(errEmptyInput)
+	}
+
+	// dst 限速在解密后按明文长度检查: 被拒绝时该帧已被 src 接收 (需要重试时可配合 snapshotSession(srcId))
+	if !rateCheck(dstId, plainLen) {
+		setOutLen(dstId, 0)
+		return fail(errRateLimited)
+	}
+
+	// 发送: 同 sealAndMask，明文位于 [out, out+plainLen)，压缩暂存紧随其后
+	session := getSession(dstId)
+	sealedLen = sealPlainMax(dstId, plainLen) + aeadOverhead(session)
+	scratch := uint32(aeadScratchBase)
+	if sessionOutPtr[dstId] != 0 {
+		// [mask 输出 (最多 sealedLen*6+32) | 密文暂存 (sealedLen)]
+		if sealedLen*7+32 > outCap {
+			setOutLen(dstId, 0)
+			return fail(errOutputTooSmall)
+		}
+		outCap -= sealedLen
+		scratch = out + outCap
+	} else if sealedLen > maskMaxChunk {
+		setOutLen(dstId, 0)
+		return fail(errInputTooLarge)
+	}
+	if session.flags&sessionFlagCompress != 0 && plainLen+sealPlainMax(dstId, plainLen) > outCap {
+		setOutLen(dstId, 0)
+		return fail(errOutputTooSmall)
+	}
+
+	plainPtr, n := compressForSeal(dstId, out, plainLen, out+plainLen)
+	sealedLen = n + aeadOverhead(session)
+	if recordSeal(session, plainPtr, n, scratch) != sealedLen {
+		setOutLen(dstId, 0)
+		return fail(errCipher)
+	}
+	sessionStats[dstId].framesSealed++
+	traceSealed(dstId, plainLen)
+	rekeyAfterSeal(dstId, plainLen)
+	rateCharge(dstId, plainLen)
+
+	maxOut := maskMaxOut(dstId, sealedLen, outCap)
+	outPos, ok := maskSealed(dstId, scratch, sealedLen, out, maxOut)
+	if !ok {
+		setOutLen(dstId, 0)
+		return fail(maskShortError())
+	}
+
+	setOutLen(dstId, outPos)
+	lastError = errOK
+	return out
+}
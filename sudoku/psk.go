@@ -0,0 +1,167 @@
+// PSK 模式 - 多个预共享密钥共用同一端口，以 identity 区分
+// session 密钥 = HKDF-Expand(HKDF-Extract(identity, psk), "sudoku psk", 32)，再按 role 派生 client_write/server_write 方向密钥
+// hello 的保留字节 [14:16] 携带 identity hint = SHA-256(identity)[0:2]，以 HMAC-SHA256(hint 密钥, "sudoku psk hint" || hello[16:24])[0:2]
+// 异或混淆；hint 密钥由宿主以 setPSKHintKey 设置 (部署内所有 client 与 server 共用，与各 identity 的 psk 无关)，
+// 不持有 hint 密钥的旁观者无法去掉掩码，同一 client 的各次连接不能据 hint 关联
+// 未设置 hint 密钥时掩码退化为 SHA-256("sudoku psk hint" || hello[16:24])[0:2]，混淆时间戳在线路上可见，
+// 任何旁观者都能还原 hint，此时 hint 是 identity 的固定标识
+// server 宿主以 helloIdentityHint 取回 hint (不需要 psk)，在自己的 identity 表中找到候选 psk 后以 initSessionPSK 创建 session，
+// parseHello 的时间戳校验即可排除 hint 碰撞的错误候选
+// 混淆时间戳的掩码按 [14:16] 为 0 的头部计算，非 PSK session 的 hello 与旧版本逐字节相同
+
+package sudoku
+
+import "encoding/binary"
+
+const stateIdentityHint = 58 // [58:60] identity hint (大端序，仅 sessionFlagPSK 时有效)
+
+const pskIdentityMax = 128
+
+var (
+	pskLabel     = []byte("sudoku psk")
+	pskHintLabel = []byte("sudoku psk hint")
+)
+
+// identityHintOf - identity 的 16 位 hint
+func identityHintOf(identity []byte) uint16 {
+	var ctx sha256Context
+	var digest [sha256Size]byte
+	sha256Init(&ctx)
+	sha256Update(&ctx, identity, len(identity))
+	sha256Finalize(&ctx, &digest)
+	return binary.BigEndian.Uint16(digest[0:2])
+}
+
+// 模块级 hint 密钥 (setPSKHintKey)
+var pskHintKey [32]byte
+var pskHintKeySet bool
+
+// setPSKHintKey - 设置混淆 identity hint 的 32 字节密钥 (client 与 server 须一致)，keyLen 为 0 时清除
+// 返回值: 0 成功, -1 长度不是 0 或 32, -2 指针越界
+//
+//export setPSKHintKey
+func setPSKHintKey(keyPtr uint32, keyLen uint32) int32 {
+	if keyLen != 0 && keyLen != 32 {
+		return -1
+	}
+	if !inArenaRange(keyPtr, keyLen) {
+		return -2
+	}
+	if keyLen == 0 {
+		pskHintKey = [32]byte{}
+		pskHintKeySet = false
+		return 0
+	}
+	copy(pskHintKey[:], arena[keyPtr:keyPtr+32])
+	pskHintKeySet = true
+	return 0
+}
+
+// identityHintMask - hint 的混淆掩码，由 hint 密钥与 hello 的混淆时间戳 [16:24] 决定
+func identityHintMask(hello []byte) uint16 {
+	var digest [sha256Size]byte
+	if pskHintKeySet {
+		var mac hmacContext
+		hmacInit(&mac, pskHintKey[:])
+		hmacUpdate(&mac, pskHintLabel, len(pskHintLabel))
+		hmacUpdate(&mac, hello[16:24], 8)
+		hmacFinalize(&mac, &digest)
+		return binary.BigEndian.Uint16(digest[0:2])
+	}
+	var ctx sha256Context
+	sha256Init(&ctx)
+	sha256Update(&ctx, pskHintLabel, len(pskHintLabel))
+	sha256Update(&ctx, hello[16:24], 8)
+	sha256Finalize(&ctx, &digest)
+	return binary.BigEndian.Uint16(digest[0:2])
+}
+
+// helloHint - 取回 hello 中的 identity hint (len(hello) >= helloSize)
+func helloHint(hello []byte) uint16 {
+	return binary.BigEndian.Uint16(hello[14:16]) ^ identityHintMask(hello)
+}
+
+// helloPutHint - PSK session 在 hello 写完混淆时间戳后填入混淆的 identity hint
+func helloPutHint(session *SudokuInstance, hello []byte) {
+	if session.flags&sessionFlagPSK == 0 {
+		return
+	}
+	state := &session.sudokuState
+	hint := binary.BigEndian.Uint16(state[stateIdentityHint : stateIdentityHint+2])
+	binary.BigEndian.PutUint16(hello[14:16], hint^identityHintMask(hello))
+}
+
+// initSessionPSK - 以 psk 与 identity 创建 RoleClient/RoleServer session，hello 中携带该 identity 的 hint
+// 恢复密钥由 psk 派生 (resumeSession 的初始密钥即 psk)
+// 返回值: 同 initSession，另有
+//
+//	-9  role 不是 RoleClient/RoleServer
+//	-10 psk 为空
+//	-11 identity 为空或超过 pskIdentityMax 字节
+//
+//export initSessionPSK
+func initSessionPSK(pskPtr uint32, pskLen uint32, identityPtr uint32, identityLen uint32, cipherType uint8, layoutType uint8, gridProfile uint8, role uint8) int32 {
+	if !inArenaRange(pskPtr, pskLen) || !inArenaRange(identityPtr, identityLen) {
+		return -7
+	}
+	if role != RoleClient && role != RoleServer {
+		return -9
+	}
+	if pskLen == 0 {
+		return -10
+	}
+	if identityLen == 0 || identityLen > pskIdentityMax {
+		return -11
+	}
+	psk := arena[pskPtr : pskPtr+pskLen]
+	identity := arena[identityPtr : identityPtr+identityLen]
+
+	var prk [sha256Size]byte
+	var key [32]byte
+	hkdfExtract(&prk, identity, psk)
+	hkdfExpand(&prk, pskLabel, key[:])
+
+	id := initSessionKey(key[:], cipherType, layoutType, gridProfile, role, nil)
+	if id >= 0 {
+		session := getSession(id)
+		session.flags |= sessionFlagPSK
+		binary.BigEndian.PutUint16(session.sudokuState[stateIdentityHint:stateIdentityHint+2], identityHintOf(identity))
+		deriveResumeKey(psk, &sessionResumeKey[id])
+	}
+
+	key = [32]byte{}
+	prk = [sha256Size]byte{}
+	return id
+}
+
+// pskIdentityHint - 计算 identity 的 hint，供 server 宿主建立 hint -> identity 表
+// 返回值: hint (0-65535), -1 输入越界, -2 identity 为空或超过 pskIdentityMax 字节
+//
+//export pskIdentityHint
+func pskIdentityHint(identityPtr uint32, identityLen uint32) int32 {
+	if !inArenaRange(identityPtr, identityLen) {
+		return -1
+	}
+	if identityLen == 0 || identityLen > pskIdentityMax {
+		return -2
+	}
+	return int32(identityHintOf(arena[identityPtr : identityPtr+identityLen]))
+}
+
+// helloIdentityHint - 从尚未绑定 session 的 hello (或握手消息) 中取回 identity hint，不需要 psk (需要与 client 相同的 hint 密钥)
+// 返回值: hint (0-65535), -1 输入越界或长度不足, -2 magic 不匹配
+//
+//export helloIdentityHint
+func helloIdentityHint(inPtr uint32, inLen uint32) int32 {
+	if inLen < helloSize || !inArenaRange(inPtr, inLen) {
+		return -1
+	}
+	in := arena[inPtr : inPtr+helloSize]
+	magic := [8]byte{0x53, 0x55, 0x44, 0x4F, 0x4B, 0x55, 0x56, 0x32}
+	for i := 0; i < 8; i++ {
+		if in[i] != magic[i] {
+			return -2
+		}
+	}
+	return int32(helloHint(in))
+}
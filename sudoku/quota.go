@@ -0,0 +1,75 @@
+// 每 identity session 配额 - 多租户中继中防止单个客户端占满 session 槽位
+// 宿主在创建 session 前以 registerIdentity 登记 32 字节 identity 哈希 (如 SHA-256(客户端 IP) 或 SHA-256(PSK identity))，
+// 下一次进入分配的 session 创建 (initSession 系列、initSessions 的首个描述符、importSession、resumeSession) 取走该登记:
+// 该 identity 已有 identityQuota 个 session 时创建失败 (errQuota)，否则新 session 计入该 identity，closeSession 时释放
+// 因指针越界、参数或 blob/令牌校验失败、无空闲槽位提前返回的调用不取走登记 (登记在即将分配槽位时才取走)，宿主可以 registerIdentity(全 0) 清除；未登记 identity 的 session 不受配额限制
+
+package sudoku
+
+// 每个 identity 的 session 上限，0 表示不限
+var identityQuota uint32
+
+// 每个 session 所属的 identity，全 0 表示未登记
+var sessionIdentity [maxSessions][32]byte
+
+// 待下一次 session 创建使用的 identity
+var pendingIdentity [32]byte
+
+// setIdentityQuota - 设置每个 identity 的 session 上限 (0 关闭)，已存在的 session 不受影响
+//
+//export setIdentityQuota
+func setIdentityQuota(maxPerIdentity uint32) {
+	identityQuota = maxPerIdentity
+}
+
+// identitySessions - identity 当前的 session 数
+func identitySessions(identity *[32]byte) uint32 {
+	n := uint32(0)
+	for i := 0; i < maxSessions; i++ {
+		if sessionUsed[i] != 0 && sessionIdentity[i] == *identity {
+			n++
+		}
+	}
+	return n
+}
+
+// registerIdentity - 为下一次 session 创建登记 identity (arena[hashPtr:hashPtr+32])，全 0 清除登记
+// 返回值: 该 identity 当前的 session 数
+//
+//	-1 输入越界
+//	errQuota (-20) 已达配额 (不登记)
+//
+//export registerIdentity
+func registerIdentity(hashPtr uint32) int32 {
+	if !inArenaRange(hashPtr, 32) {
+		return -1
+	}
+	var identity [32]byte
+	copy(identity[:], arena[hashPtr:hashPtr+32])
+	pendingIdentity = [32]byte{}
+	if identity == [32]byte{} {
+		return 0
+	}
+	n := identitySessions(&identity)
+	if identityQuota != 0 && n >= identityQuota {
+		logEvent(LogLevelWarn, logIdentityQuota, n)
+		return errQuota
+	}
+	pendingIdentity = identity
+	return int32(n)
+}
+
+// identityAdmit - 取走登记的 identity 并检查配额，超出时返回 false
+func identityAdmit(identity *[32]byte) bool {
+	*identity = pendingIdentity
+	pendingIdentity = [32]byte{}
+	if *identity == [32]byte{} || identityQuota == 0 {
+		return true
+	}
+	n := identitySessions(identity)
+	if n >= identityQuota {
+		logEvent(LogLevelWarn, logIdentityQuota, n)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+// 限速 - 每个 session 一个令牌桶，限制发送方向 (mask / 加密) 的吞吐
+// 令牌按 tick 补充: 每个 tick 单位补充 rate 字节，最多累积 burst 字节 (宿主以秒调用 tick 时即 bytes/sec)
+// 发送类导出函数在处理前检查桶内令牌是否足够覆盖本次输入，不足时整次调用失败 (errRateLimited)，
+// 不产生输出也不改变 session 状态；成功后按实际消费的输入字节扣除令牌
+// 单次输入超过 burst 的调用永远不会通过，宿主应按 burst 分片
+
+package sudoku
+
+import "encoding/binary"
+
+type rateBucket struct {
+	rate         uint32 // 每 tick 补充的字节数，0 表示不限速
+	burst        uint32
+	tokens       uint32
+	last         uint32 // 上次补充时的 tick
+	limitedCalls uint32 // 被拒绝的调用数
+	limitedBytes uint64 // 被拒绝调用的输入字节数
+}
+
+var sessionRate [maxSessions]rateBucket
+
+// setRateLimit - 设置 session 的发送限速，桶初始为满
+// burst 为 0 时取 bytesPerTick；bytesPerTick 为 0 关闭限速 (拒绝计数保留)
+// 返回值: 0 成功, -1 session 无效
+//
+//export setRateLimit
+func setRateLimit(id int32, bytesPerTick uint32, burst uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	b := &sessionRate[id]
+	if burst == 0 {
+		burst = bytesPerTick
+	}
+	b.rate = bytesPerTick
+	b.burst = burst
+	b.tokens = burst
+	b.last = currentTick
+	return 0
+}
+
+// rateStatusSize - getRateLimitStatus 输出长度
+const rateStatusSize = 16
+
+// getRateLimitStatus - 将限速状态写入 arena[outPtr:outPtr+16] (小端序):
+// [当前令牌 (4)][被拒绝调用数 (4)][被拒绝字节数 (8)]
+// 返回值: 16, -1 session 无效, -2 输出越界
+//
+//export getRateLimitStatus
+func getRateLimitStatus(id int32, outPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(outPtr, rateStatusSize) {
+		return -2
+	}
+	b := &sessionRate[id]
+	rateRefill(b)
+	out := arena[outPtr : outPtr+rateStatusSize]
+	binary.LittleEndian.PutUint32(out[0:4], b.tokens)
+	binary.LittleEndian.PutUint32(out[4:8], b.limitedCalls)
+	binary.LittleEndian.PutUint64(out[8:16], b.limitedBytes)
+	return rateStatusSize
+}
+
+// rateRefill - 按距上次补充经过的 tick 补充令牌 (tick 差值按 uint32 回绕计算)
+func rateRefill(b *rateBucket) {
+	if b.rate == 0 {
+		return
+	}
+	elapsed := currentTick - b.last
+	b.last = currentTick
+	t := uint64(b.tokens) + uint64(elapsed)*uint64(b.rate)
+	if t > uint64(b.burst) {
+		t = uint64(b.burst)
+	}
+	b.tokens = uint32(t)
+}
+
+// rateCheck - 令牌是否足够发送 n 字节，不足时记入拒绝计数
+func rateCheck(id int32, n uint32) bool {
+	b := &sessionRate[id]
+	if b.rate == 0 {
+		return true
+	}
+	rateRefill(b)
+	if n > b.tokens {
+		b.limitedCalls++
+		b.limitedBytes += uint64(n)
+		return false
+	}
+	return true
+}
+
+// rateCharge - 扣除实际发送的 n 字节
+func rateCharge(id int32, n uint32) {
+	b := &sessionRate[id]
+	if b.rate == 0 {
+		return
+	}
+	if n > b.tokens {
+		b.tokens = 0
+	} else {
+		b.tokens -= n
+	}
+}
@@ -0,0 +1,187 @@
+package sudoku
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// testSession - 以固定密钥创建 session，测试结束时关闭
+func testSession(t *testing.T, cipherType uint8, role uint8) int32 {
+	t.Helper()
+	initWasm()
+	id := initSessionKey(bytes.Repeat([]byte{0x37}, 32), cipherType, LayoutASCII, GridProfile4x4, role, nil)
+	if id < 0 {
+		t.Fatalf("initSessionKey: %d", id)
+	}
+	t.Cleanup(func() { closeSession(id) })
+	return id
+}
+
+// testPair - 同一密钥的 client/server session
+func testPair(t *testing.T, cipherType uint8) (int32, int32) {
+	t.Helper()
+	return testSession(t, cipherType, RoleClient), testSession(t, cipherType, RoleServer)
+}
+
+// testCall - 将 in 拷贝到 workBuf 后调用 fn，返回输出副本与 lastError
+func testCall(id int32, in []byte, fn func(int32, uint32, uint32) uint32) ([]byte, int32) {
+	copy(arena[workBufBase:], in)
+	p := fn(id, workBufBase, uint32(len(in)))
+	if p == 0 {
+		return nil, lastError
+	}
+	return append([]byte(nil), arena[p:p+sessionOutLen[id]]...), errOK
+}
+
+// testRecord - 以 session 的发送状态封装一条 [长度][AEAD 帧] 记录 (不 mask)
+func testRecord(t *testing.T, id int32, plain []byte) []byte {
+	t.Helper()
+	session := getSession(id)
+	copy(arena[workBufBase:], plain)
+	out := uint32(workBufBase + 4096)
+	n := recordSeal(session, workBufBase, uint32(len(plain)), out+frameHeaderSize)
+	if n == 0 {
+		t.Fatal("recordSeal failed")
+	}
+	binary.BigEndian.PutUint16(arena[out:out+frameHeaderSize], uint16(n))
+	return append([]byte(nil), arena[out:out+frameHeaderSize+n]...)
+}
+
+// 同一输入中首条之后的记录失败时，此前记录的明文仍然返回，失败的记录重传后可以接受
+func TestUnmaskFramePartialFailure(t *testing.T) {
+	tx, rx := testPair(t, CipherChaCha20Poly)
+	rec1 := testRecord(t, tx, []byte("record one"))
+	rec2 := testRecord(t, tx, []byte("record two"))
+
+	tests := []struct {
+		name    string
+		corrupt func(b []byte) []byte
+		status  int32
+	}{
+		{"auth", func(b []byte) []byte { b[len(b)-1] ^= 1; return b }, errAuth},
+		{"replay", func(b []byte) []byte { return append([]byte(nil), rec1...) }, errReplay},
+		{"truncated", func(b []byte) []byte { return b[:1] }, errBadFrame},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rx := testSession(t, CipherChaCha20Poly, RoleServer)
+			bad := tt.corrupt(append([]byte(nil), rec2...))
+			masked, st := testCall(tx, append(append([]byte(nil), rec1...), bad...), mask)
+			if st != errOK {
+				t.Fatalf("mask: %d", st)
+			}
+			got, st := testCall(rx, masked, unmaskFrame)
+			if st != errOK || string(got) != "record one" {
+				t.Fatalf("unmaskFrame = %q (%d), want record one", got, st)
+			}
+			if s := getOpenStatus(rx); s != tt.status {
+				t.Fatalf("getOpenStatus = %d, want %d", s, tt.status)
+			}
+			if tt.status == errReplay {
+				return
+			}
+			masked, _ = testCall(tx, rec2, mask)
+			if got, st := testCall(rx, masked, unmaskFrame); st != errOK || string(got) != "record two" {
+				t.Fatalf("retransmitted record two = %q (%d)", got, st)
+			}
+		})
+	}
+
+	// 首条记录失败时整体失败
+	bad := append([]byte(nil), rec1...)
+	bad[len(bad)-1] ^= 1
+	masked, _ := testCall(tx, append(bad, rec2...), mask)
+	if _, st := testCall(rx, masked, unmaskFrame); st != errAuth {
+		t.Fatalf("unmaskFrame(bad first record) = %d, want %d", st, errAuth)
+	}
+	if s := getOpenStatus(rx); s != errAuth {
+		t.Fatalf("getOpenStatus = %d, want %d", s, errAuth)
+	}
+}
+
+// testSealNonces - 以 session 封装 n 条记录，返回各记录的 (发送密钥 || nonce)
+func testSealNonces(t *testing.T, id int32, n int) []string {
+	t.Helper()
+	session := getSession(id)
+	nonceLen := int(session.nonceSize)
+	var out []string
+	for i := 0; i < n; i++ {
+		rec := testRecord(t, id, []byte{byte(i)})
+		out = append(out, string(session.key[:])+string(rec[frameHeaderSize:frameHeaderSize+nonceLen]))
+	}
+	return out
+}
+
+// client/server 两个方向的 (密钥, nonce) 永不重复；RoleShared 只有设置不同 nonce salt 时才不重复
+func TestDirectionalNonceSeparation(t *testing.T) {
+	tests := []struct {
+		name       string
+		cipherType uint8
+		roleA      uint8
+		roleB      uint8
+		saltB      byte // B 端 nonce salt 末字节
+		distinct   bool
+	}{
+		{"chacha client/server", CipherChaCha20Poly, RoleClient, RoleServer, 0, true},
+		{"aes128 client/server", CipherAES128GCM, RoleClient, RoleServer, 0, true},
+		{"xchacha client/server", CipherXChaCha20Poly, RoleClient, RoleServer, 0, true},
+		{"shared same salt", CipherChaCha20Poly, RoleShared, RoleShared, 0, false},
+		{"shared distinct salt", CipherChaCha20Poly, RoleShared, RoleShared, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := testSession(t, tt.cipherType, tt.roleA)
+			b := testSession(t, tt.cipherType, tt.roleB)
+			getSession(b).sudokuState[stateNonceSalt+nonceSaltLen-1] = tt.saltB
+			seen := make(map[string]bool)
+			for _, kn := range testSealNonces(t, a, 100) {
+				seen[kn] = true
+			}
+			reused := 0
+			for _, kn := range testSealNonces(t, b, 100) {
+				if seen[kn] {
+					reused++
+				}
+			}
+			if tt.distinct && reused != 0 {
+				t.Fatalf("%d (key, nonce) pairs reused across directions", reused)
+			}
+			if !tt.distinct && reused == 0 {
+				t.Fatal("expected RoleShared with equal salts to reuse nonces")
+			}
+		})
+	}
+}
+
+// XChaCha20 记录帧 nonce: [salt][随机 12 字节][counter]，counter 重复 (回退 txCounter) 时 nonce 仍不同，接收端照常解开
+func TestXChaChaRandomNonce(t *testing.T) {
+	tx, rx := testPair(t, CipherXChaCha20Poly)
+	session := getSession(tx)
+	var frames [][]byte
+	for i := 0; i < 3; i++ {
+		if i == 2 {
+			session.txCounter-- // 第三条与第二条使用相同 counter
+		}
+		frames = append(frames, testRecord(t, tx, []byte{byte('a' + i)}))
+	}
+	nonce := func(rec []byte) []byte { return rec[frameHeaderSize : frameHeaderSize+xchachaNonceSize] }
+	for i, rec := range frames {
+		n := nonce(rec)
+		if binary.BigEndian.Uint64(n[16:]) != uint64([]int{1, 2, 2}[i]) {
+			t.Fatalf("frame %d counter = %x", i, n[16:])
+		}
+		if bytes.Equal(n[4:16], make([]byte, 12)) {
+			t.Fatalf("frame %d nonce has no random part", i)
+		}
+	}
+	if bytes.Equal(nonce(frames[1]), nonce(frames[2])) {
+		t.Fatal("repeated counter produced the same nonce")
+	}
+	for i, rec := range frames[:2] {
+		masked, _ := testCall(tx, rec, mask)
+		if got, st := testCall(rx, masked, unmaskFrame); st != errOK || string(got) != string(rune('a'+i)) {
+			t.Fatalf("unmaskFrame(frame %d) = %q (%d)", i, got, st)
+		}
+	}
+}
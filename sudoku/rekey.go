@@ -0,0 +1,150 @@
+// 密钥轮换 - 手动 rekeySession 与按帧数/字节数阈值自动轮换
+// 自动轮换: 发送方在封装第 N 帧 (或累计 M 字节明文) 后轮换发送密钥，
+// 接收方在打开同一帧后轮换接收密钥；两端计数一致，因此无需额外信令即可同步
+// 下一代密钥 = HKDF-Expand(HKDF-Extract(nil, 当前密钥), "sudoku rekey", keyLen)，对应方向的 counter 归零
+
+package sudoku
+
+// 每个 session 的轮换阈值与自上次轮换以来的计数
+type rekeyState struct {
+	maxFrames uint32 // 0 表示不按帧数轮换
+	maxBytes  uint32 // 0 表示不按字节数轮换
+	txFrames  uint32
+	rxFrames  uint32
+	txBytes   uint64
+	rxBytes   uint64
+}
+
+var sessionRekey [maxSessions]rekeyState
+
+// getSessionStats 中 rekeyFlags 的位定义 (读取后清零)
+const (
+	rekeyFlagTx = 1 << 0 // 发送密钥已轮换
+	rekeyFlagRx = 1 << 1 // 接收密钥已轮换
+)
+
+var rekeyLabel = []byte("sudoku rekey")
+
+// cipherKeyLen - cipherType 实际使用的密钥长度，CipherNone 为 0
+func cipherKeyLen(cipherType uint8) uint32 {
+	if spec := cipherLookup(cipherType); spec != nil {
+		return uint32(spec.keyLen)
+	}
+	return 0
+}
+
+// nextKey - 原地派生下一代密钥
+func nextKey(key *[32]byte, keyLen uint32) {
+	var prk [sha256Size]byte
+	hkdfExtract(&prk, nil, key[:keyLen])
+	*key = [32]byte{}
+	hkdfExpand(&prk, rekeyLabel, key[:keyLen])
+	for i := range prk {
+		prk[i] = 0
+	}
+}
+
+// rekeyAfterSeal - 封装一帧后累计计数，达到阈值时轮换发送密钥
+func rekeyAfterSeal(id int32, plainLen uint32) {
+	rk := &sessionRekey[id]
+	if rk.maxFrames == 0 && rk.maxBytes == 0 {
+		return
+	}
+	rk.txFrames++
+	rk.txBytes += uint64(plainLen)
+	if !rekeyDue(rk, rk.txFrames, rk.txBytes) {
+		return
+	}
+	session := getSession(id)
+	nextKey(&session.key, cipherKeyLen(session.cipherType))
+	session.txCounter = 0
+	rk.txFrames = 0
+	rk.txBytes = 0
+	st := &sessionStats[id]
+	st.rekeyFlags |= rekeyFlagTx
+	st.rekeyCount++
+	traceEvent(id, traceRekeyTx, errOK, 0, uint64(st.rekeyCount))
+}
+
+// rekeyAfterOpen - 打开一帧后累计计数，达到阈值时轮换接收密钥并重置抗重放窗口
+func rekeyAfterOpen(id int32, plainLen uint32) {
+	rk := &sessionRekey[id]
+	if rk.maxFrames == 0 && rk.maxBytes == 0 {
+		return
+	}
+	rk.rxFrames++
+	rk.rxBytes += uint64(plainLen)
+	if !rekeyDue(rk, rk.rxFrames, rk.rxBytes) {
+		return
+	}
+	session := getSession(id)
+	nextKey(&sessionRxKey[id], cipherKeyLen(session.cipherType))
+	session.rxCounter = 0
+	session.replayBitmap = 0
+	rk.rxFrames = 0
+	rk.rxBytes = 0
+	st := &sessionStats[id]
+	st.rekeyFlags |= rekeyFlagRx
+	st.rekeyCount++
+	traceEvent(id, traceRekeyRx, errOK, 0, uint64(st.rekeyCount))
+}
+
+func rekeyDue(rk *rekeyState, frames uint32, bytes uint64) bool {
+	return (rk.maxFrames != 0 && frames >= rk.maxFrames) ||
+		(rk.maxBytes != 0 && bytes >= uint64(rk.maxBytes))
+}
+
+// setRekeyPolicy - 设置自动轮换阈值 (两端必须一致)，0 表示关闭对应条件
+// 设置时清空已累计的计数
+// 返回值: 0 成功, -1 session 无效, -2 CipherNone 无密钥可轮换
+//
+//export setRekeyPolicy
+func setRekeyPolicy(id int32, maxFrames uint32, maxBytes uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if getSession(id).cipherType == CipherNone {
+		return -2
+	}
+	sessionRekey[id] = rekeyState{maxFrames: maxFrames, maxBytes: maxBytes}
+	return 0
+}
+
+// rekeySession - 立即替换 session 密钥 (按 initSession 时的 role 派生方向密钥)
+// 两个方向的 counter、抗重放窗口与自动轮换计数全部归零，两端需在同一帧边界调用
+// 返回值: 0 成功, -1 session 无效, -2 指针越界, -3 密钥长度不符合 cipherType, -4 CipherNone 无密钥可轮换
+//
+//export rekeySession
+func rekeySession(id int32, newKeyPtr uint32, newKeyLen uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(newKeyPtr, newKeyLen) {
+		return -2
+	}
+	session := getSession(id)
+	keyLen := cipherKeyLen(session.cipherType)
+	if keyLen == 0 {
+		return -4
+	}
+	// 与 initSession 一致: AES-128-GCM 截断为 16 字节，ChaCha20 系列要求正好 32 字节
+	if newKeyLen < keyLen || (keyLen == 32 && newKeyLen != 32) || newKeyLen > 32 {
+		return -3
+	}
+	key := arena[newKeyPtr : newKeyPtr+newKeyLen]
+
+	role := session.sudokuState[stateRole]
+	if role != RoleShared {
+		deriveDirectionalKeys(key, role, keyLen, &session.key, &sessionRxKey[id])
+	} else {
+		session.key = [32]byte{}
+		copy(session.key[:keyLen], key)
+		sessionRxKey[id] = session.key
+	}
+	session.txCounter = 0
+	session.rxCounter = 0
+	session.replayBitmap = 0
+	rk := &sessionRekey[id]
+	rk.txFrames, rk.rxFrames, rk.txBytes, rk.rxBytes = 0, 0, 0, 0
+	return 0
+}
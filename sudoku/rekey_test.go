@@ -0,0 +1,100 @@
+package sudoku
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+// rekeyTestStats - 经 getSessionStats 读取 (rekeyFlags, rekeyCount)，读取后 rekeyFlags 清零
+func rekeyTestStats(t *testing.T, id int32) (uint32, uint32) {
+	t.Helper()
+	out := uint32(workBufBase + 0x3000)
+	if r := getSessionStats(id, out); r != 0 {
+		t.Fatalf("getSessionStats: %d", r)
+	}
+	return binary.LittleEndian.Uint32(arena[out+48 : out+52]), binary.LittleEndian.Uint32(arena[out+52 : out+56])
+}
+
+// 自动轮换: 发送方与接收方在同一帧边界轮换，跨越多代密钥的帧流全部可以解开
+func TestAutoRekeyCrossover(t *testing.T) {
+	tests := []struct {
+		name       string
+		cipherType uint8
+		maxFrames  uint32
+		maxBytes   uint32
+		sizes      []int // 各帧明文长度
+		wantRekeys uint32
+	}{
+		{"frames", CipherChaCha20Poly, 3, 0, []int{1, 1, 1, 1, 1, 1, 1}, 2},
+		{"bytes", CipherChaCha20Poly, 0, 100, []int{60, 60, 60, 60}, 2},
+		{"frames or bytes", CipherChaCha20Poly, 4, 50, []int{10, 10, 40, 10, 10, 10, 10}, 2},
+		{"aes128", CipherAES128GCM, 2, 0, []int{5, 5, 5, 5, 5}, 2},
+		{"xchacha every frame", CipherXChaCha20Poly, 1, 0, []int{8, 8, 8}, 3},
+		{"below threshold", CipherChaCha20Poly, 10, 0, []int{1, 1, 1}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, rx := testPair(t, tt.cipherType)
+			for _, id := range []int32{tx, rx} {
+				if r := setRekeyPolicy(id, tt.maxFrames, tt.maxBytes); r != 0 {
+					t.Fatalf("setRekeyPolicy: %d", r)
+				}
+			}
+			initial := getSession(tx).key
+			for i, n := range tt.sizes {
+				plain := make([]byte, n)
+				plain[0] = byte(i)
+				f, st := testCall(tx, plain, sealAndMask)
+				if st != errOK {
+					t.Fatalf("frame %d: sealAndMask: %d", i, st)
+				}
+				if got, st := testCall(rx, f, unmaskAndOpen); st != errOK || len(got) != n || got[0] != byte(i) {
+					t.Fatalf("frame %d: unmaskAndOpen = %d bytes (%d)", i, len(got), st)
+				}
+			}
+			txFlags, txCount := rekeyTestStats(t, tx)
+			rxFlags, rxCount := rekeyTestStats(t, rx)
+			if txCount != tt.wantRekeys || rxCount != tt.wantRekeys {
+				t.Fatalf("rekeyCount = %d/%d, want %d", txCount, rxCount, tt.wantRekeys)
+			}
+			if getSession(tx).key != sessionRxKey[rx] {
+				t.Fatal("tx and rx keys out of lockstep")
+			}
+			if rotated := getSession(tx).key != initial; rotated != (tt.wantRekeys != 0) {
+				t.Fatalf("key rotated = %v, want %v", rotated, tt.wantRekeys != 0)
+			}
+			wantTx, wantRx := uint32(0), uint32(0)
+			if tt.wantRekeys != 0 {
+				wantTx, wantRx = rekeyFlagTx, rekeyFlagRx
+			}
+			if txFlags != wantTx || rxFlags != wantRx {
+				t.Fatalf("rekeyFlags = %d/%d, want %d/%d", txFlags, rxFlags, wantTx, wantRx)
+			}
+			if txFlags, _ = rekeyTestStats(t, tx); txFlags != 0 {
+				t.Fatalf("rekeyFlags not cleared after read: %d", txFlags)
+			}
+		})
+	}
+}
+
+// 跨越轮换边界乱序到达的帧在接收方轮换前被拒绝 (新一代 counter 从 1 开始，落入旧窗口被当作重放)，
+// 拒绝不推进轮换计数，按序补齐后恢复
+func TestAutoRekeyReorderAcrossBoundary(t *testing.T) {
+	tx, rx := testPair(t, CipherChaCha20Poly)
+	for _, id := range []int32{tx, rx} {
+		setRekeyPolicy(id, 2, 0)
+	}
+	var frames [][]byte
+	for i := 0; i < 3; i++ {
+		f, _ := testCall(tx, []byte{byte(i)}, sealAndMask)
+		frames = append(frames, f)
+	}
+	for i, step := range []struct {
+		frame int
+		want  int32
+	}{{0, errOK}, {2, errReplay}, {1, errOK}, {2, errOK}} {
+		if _, st := testCall(rx, frames[step.frame], unmaskAndOpen); st != step.want {
+			t.Fatalf("step %d (frame %d): status %d, want %d", i, step.frame, st, step.want)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package sudoku
+
+import "testing"
+
+// 抗重放窗口: 窗口内乱序到达的帧被接受，重复帧与距最大 counter 达到 64 的帧被拒绝
+func TestReplayWindow(t *testing.T) {
+	tx := testSession(t, CipherChaCha20Poly, RoleClient)
+	var frames [][]byte // frames[c-1] 的 counter 为 c
+	for i := 0; i < 70; i++ {
+		f, st := testCall(tx, []byte{byte(i)}, sealAndMask)
+		if st != errOK {
+			t.Fatalf("sealAndMask: %d", st)
+		}
+		frames = append(frames, f)
+	}
+
+	tests := []struct {
+		name  string
+		order []int   // 依次送入的帧 counter
+		want  []int32 // 各帧的结果
+	}{
+		{"in order", []int{1, 2, 3}, []int32{errOK, errOK, errOK}},
+		{"out of order", []int{3, 1, 2}, []int32{errOK, errOK, errOK}},
+		{"duplicate", []int{1, 1}, []int32{errOK, errReplay}},
+		{"duplicate out of order", []int{5, 3, 3}, []int32{errOK, errOK, errReplay}},
+		{"oldest in window", []int{65, 2}, []int32{errOK, errOK}},
+		{"one past window", []int{65, 1}, []int32{errOK, errReplay}},
+		{"window slides", []int{2, 66, 2, 3}, []int32{errOK, errOK, errReplay, errOK}},
+		{"jump beyond window", []int{1, 70, 6, 7}, []int32{errOK, errOK, errReplay, errOK}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rx := testSession(t, CipherChaCha20Poly, RoleServer)
+			for i, c := range tt.order {
+				got, st := testCall(rx, frames[c-1], unmaskAndOpen)
+				if st != tt.want[i] {
+					t.Fatalf("frame %d (step %d): status %d, want %d", c, i, st, tt.want[i])
+				}
+				if st == errOK && (len(got) != 1 || got[0] != byte(c-1)) {
+					t.Fatalf("frame %d: plaintext %x", c, got)
+				}
+				if s := getOpenStatus(rx); s != tt.want[i] {
+					t.Fatalf("frame %d: getOpenStatus = %d, want %d", c, s, tt.want[i])
+				}
+			}
+		})
+	}
+}
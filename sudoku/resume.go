@@ -0,0 +1,113 @@
+// 会话恢复令牌 - 负载均衡后的多个 Worker 实例之间迁移 session，不依赖共享的导出密钥
+// 令牌格式与 exportSession 的 blob 相同 (magic "SDKR")，但以 session 的恢复密钥加密:
+//   恢复密钥 = HKDF-Expand(HKDF-Extract(nil, 初始密钥), "sudoku resume", 32)
+// 初始密钥即 initSession 的 key (initSessionDerived 为 master)，各实例的部署配置中本就持有，
+// 客户端重连落到另一实例时，宿主以令牌与同一初始密钥调用 resumeSession 即可恢复发送/接收密钥、counter 与全部选项
+// nonce 为 HMAC-SHA256(恢复密钥, 头部 || 明文) 前 24 字节，兼作 session 状态摘要: 状态相同则令牌相同
+// 与 importSession 相同，恢复时以新生成的迁移盐替换两个方向的密钥并将 counter 归零 (见 migrationRekey)，
+// 令牌被多个实例恢复或签发后原 session 继续发送都不会重复 (密钥, nonce)；对端以 applyMigrationSalt 同步密钥
+
+package sudoku
+
+var resumeMagic = [4]byte{0x53, 0x44, 0x4B, 0x52} // "SDKR"
+
+var resumeLabel = []byte("sudoku resume")
+
+// 每个 session 的恢复密钥，全 0 表示不可签发令牌 (初始密钥为空，或由 importSession 恢复)
+var sessionResumeKey [maxSessions][32]byte
+
+// deriveResumeKey - 由初始密钥派生恢复密钥
+func deriveResumeKey(key []byte, out *[32]byte) {
+	var prk [sha256Size]byte
+	hkdfExtract(&prk, nil, key)
+	hkdfExpand(&prk, resumeLabel, out[:])
+	for i := range prk {
+		prk[i] = 0
+	}
+}
+
+// issueResumptionToken - 将 session 状态封存为恢复令牌写入 arena[outPtr:outPtr+292]，不改变 session 状态
+// 返回值: 令牌长度 (292)
+//
+//	-1 session 无效
+//	-2 输出越界
+//	-3 握手进行中
+//	-4 session 没有恢复密钥 (初始密钥为空，或由 importSession 恢复)
+//	-5 session 已发送或收到关闭记录
+//
+//export issueResumptionToken
+func issueResumptionToken(id int32, outPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(outPtr, exportBlobSize) {
+		return -2
+	}
+	if sessionHandshake[id].phase == handshakeStarted {
+		return -3
+	}
+	if sessionResumeKey[id] == [32]byte{} {
+		return -4
+	}
+	if txClosed(id) {
+		return -5
+	}
+
+	var plain [exportPlainSize]byte
+	exportPlain(id, &plain)
+	exportSeal(&sessionResumeKey[id], &resumeMagic, &plain, outPtr)
+	for i := range plain {
+		plain[i] = 0
+	}
+	return exportBlobSize
+}
+
+// resumeSession - 以初始密钥验证并解密恢复令牌，恢复为新 session
+// 恢复的 session 保留同一恢复密钥，可以再次签发令牌；迁移盐写入 arena[saltOutPtr:saltOutPtr+16]，宿主需将其交给对端
+// 返回值: 新 session ID
+//
+//	-1 无可用 session
+//	-2 输入或迁移盐输出越界，或长度不符
+//	-3 magic/版本不符
+//	-4 初始密钥为空
+//	-5 认证失败 (初始密钥不符或令牌被篡改)
+//	-6 keyed codec 表槽位已满
+//	-7 令牌内容无效
+//	-20 registerIdentity 登记的 identity 已达配额 (errQuota)
+//
+//export resumeSession
+func resumeSession(tokenPtr uint32, tokenLen uint32, keyPtr uint32, keyLen uint32, saltOutPtr uint32) int32 {
+	if !wasmInitialized {
+		initWasm()
+	}
+	if tokenLen != exportBlobSize || !inArenaRange(tokenPtr, tokenLen) || !inArenaRange(keyPtr, keyLen) || !inArenaRange(saltOutPtr, migrationSaltSize) {
+		return -2
+	}
+	if !exportHeaderValid(tokenPtr, &resumeMagic) {
+		return -3
+	}
+	if keyLen == 0 {
+		return -4
+	}
+	if sessionFreeCount == 0 {
+		logEvent(LogLevelError, logSessionExhausted, logNoArg)
+		return -1
+	}
+
+	var rk [32]byte
+	deriveResumeKey(arena[keyPtr:keyPtr+keyLen], &rk)
+	var plain [exportPlainSize]byte
+	id := int32(-5)
+	if exportOpen(&rk, tokenPtr, &plain) {
+		id = importPlain(&plain)
+	}
+	if id >= 0 {
+		sessionResumeKey[id] = rk
+		migrationRekeyNew(id, saltOutPtr)
+	}
+	for i := range plain {
+		plain[i] = 0
+	}
+	rk = [32]byte{}
+	return id
+}
@@ -0,0 +1,93 @@
+// mask 随机源消耗计数 - 与官方 Go 客户端逐字节比对时，需要确认双方对每个输入字节消耗的随机字序列一致
+// 每个 session 累计 mask 系列 (含布局头、末尾 padding、伪装模板与控制记录) 已提交的 rngAdvance 次数，
+// getLastRNGDraws 读取后清零，宿主在每次 mask 调用后读取即得该次调用的消耗；因输出区不足而回滚的字节不计入
+// setRNGDrawLog 指定记录区后，每次消耗的随机字 (推进前的值，uint32 小端序) 按顺序写入，第 i 条对应清零以来的第 i 次消耗，
+// 记录区写满后只计数；记录区由宿主划定 (建议取 workBuf 中不与输入重叠的部分)，与计数一样以 getLastRNGDraws 重新开始
+// codec 表种子展开与流量整形的 LCG 不经过 mask 随机源，不计入
+
+package sudoku
+
+import "encoding/binary"
+
+var (
+	sessionRngDraws  [maxSessions]uint32 // 上次 getLastRNGDraws 以来已提交的消耗数
+	sessionRngLogPtr [maxSessions]uint32 // 消耗记录区，0 表示不记录
+	sessionRngLogCap [maxSessions]uint32 // 记录区字节数
+)
+
+// rngLogStart - maskRngLoad 时定位下一条消耗记录
+func rngLogStart(r *maskRng) {
+	ptr := sessionRngLogPtr[r.id]
+	if ptr == 0 {
+		return
+	}
+	r.end = ptr + sessionRngLogCap[r.id]&^3
+	off := uint64(sessionRngDraws[r.id]) * 4
+	if off >= uint64(sessionRngLogCap[r.id]) {
+		return
+	}
+	r.log = ptr + uint32(off)
+}
+
+// rngLogDraw - rngAdvance 前记录即将被消耗的随机字
+func rngLogDraw(r *maskRng) {
+	r.draws++
+	if r.log != 0 && r.log < r.end {
+		binary.LittleEndian.PutUint32(arena[r.log:r.log+4], r.x)
+		r.log += 4
+	}
+}
+
+// rngDrawsCommit - 将 r 载入以来的消耗计入 session
+func rngDrawsCommit(r *maskRng) {
+	sessionRngDraws[r.id] += r.draws
+	r.draws = 0
+}
+
+// rngDrawsRelease - closeSession 时清除计数与记录区
+func rngDrawsRelease(id int32) {
+	sessionRngDraws[id] = 0
+	sessionRngLogPtr[id] = 0
+	sessionRngLogCap[id] = 0
+}
+
+// getLastRNGDraws - 读取并清零 session 的 mask 随机源消耗数，消耗记录从记录区起点重新写入
+// 返回值: 上次读取以来已提交的消耗数 (记录区中有效的条数为 min(返回值, logCap/4))
+//
+//	-1 session 无效
+//
+//export getLastRNGDraws
+func getLastRNGDraws(id int32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	n := sessionRngDraws[id]
+	sessionRngDraws[id] = 0
+	return int32(n)
+}
+
+// setRNGDrawLog - 以 arena[logPtr:logPtr+logCap] 记录之后每次消耗的随机字 (每条 4 字节)，logCap 为 0 时关闭
+// 设置时消耗计数清零
+// 返回值: 0 成功
+//
+//	-1 session 无效
+//	-2 记录区越界
+//
+//export setRNGDrawLog
+func setRNGDrawLog(id int32, logPtr uint32, logCap uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if logCap == 0 {
+		sessionRngLogPtr[id] = 0
+		sessionRngLogCap[id] = 0
+		return 0
+	}
+	if logPtr == 0 || !inArenaRange(logPtr, logCap) {
+		return -2
+	}
+	sessionRngLogPtr[id] = logPtr
+	sessionRngLogCap[id] = logCap
+	sessionRngDraws[id] = 0
+	return 0
+}
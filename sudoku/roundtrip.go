@@ -0,0 +1,75 @@
+// 往返自检 - 以 session 当前的 layout、codec 表、padding 策略与伪装 profile 对伪随机数据做 mask → unmask 并逐字节比较，
+// 用于部署后确认 TinyGo 版本与生成的表在该配置下仍然可逆 (runSelfTest 只覆盖固定配置)
+// 只涉及 mask/unmask，不加密；进行前后 session 的 sudokuState、统计与随机源消耗计数保持不变，可在收发数据之间随时调用
+
+package sudoku
+
+// selfRoundTrip - 生成 n 字节伪随机输入 (LCG 以 n 为种子，每步取高 8 位)，mask 后以同一 session 的接收方向 unmask 并比较
+// 输入与解码结果暂存于 workBuf [0, 2n+8)，mask 输出写入 session 的输出区 (会覆盖其中的内容)
+// 返回值: 首个不一致的偏移 (解码结果过短时为其长度)，等于 n 表示往返一致
+//
+//	-1 session 无效
+//	-2 n 超过 maskMaxChunk 或 workBuf 容量的一半
+//	-3 mask 失败 (输出区不足或存在无法输出的字节，见 setForbiddenBytes)
+//
+//export selfRoundTrip
+func selfRoundTrip(id int32, n uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if n > maskMaxChunk || n > (workBufSize-layoutHeaderSize)/2 {
+		return -2
+	}
+	state := &getSession(id).sudokuState
+	savedState := *state
+	savedStats := sessionStats[id]
+	savedErrOffset := sessionDecodeErrOffset[id]
+	savedDraws := sessionRngDraws[id]
+	r := roundTripRun(id, n)
+	*state = savedState
+	sessionStats[id] = savedStats
+	sessionDecodeErrOffset[id] = savedErrOffset
+	sessionRngDraws[id] = savedDraws
+	return r
+}
+
+// roundTripRun - selfRoundTrip 的主体，调用方负责恢复 session 状态
+func roundTripRun(id int32, n uint32) int32 {
+	session := getSession(id)
+	state := &session.sudokuState
+
+	inPtr := uint32(workBufBase)
+	decPtr := inPtr + n
+	x := n
+	for i := uint32(0); i < n; i++ {
+		x = lcgNext(x)
+		arena[inPtr+i] = uint8(x >> 24)
+	}
+
+	// 接收方向从发送方向当前的位置开始: 无未完成的 hint 组，模板游标与布局头进度同步
+	if session.flags&sessionFlagDatagram != 0 {
+		state[stateCoverTx] = 0
+	}
+	state[stateHintCount] = 0
+	state[stateEscapeRx] = 0
+	state[stateCoverRx] = state[stateCoverTx]
+	state[stateLayoutRx] = state[stateLayoutTx]
+
+	out, outCap := sessionOut(id)
+	maxOut := maskMaxOut(id, n, outCap)
+	outPos, consumed := maskBody(id, inPtr, n, out, maxOut-1)
+	if consumed < n {
+		maskUnrepresentable = false
+		return -3
+	}
+	outPos = maskTail(id, out, outPos, maxOut)
+
+	// 解码输出在剥离布局头之前包含头部
+	got, _ := unmaskBody(id, out, outPos, decPtr, n+layoutHeaderSize)
+	for i := uint32(0); i < got; i++ {
+		if arena[decPtr+i] != arena[inPtr+i] {
+			return int32(i)
+		}
+	}
+	return int32(got)
+}
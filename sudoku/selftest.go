@@ -0,0 +1,290 @@
+// 自检 - 宿主冷启动时调用 runSelfTest，以内置的已知答案向量检测编译错误或损坏的构建
+// ChaCha20 / Poly1305 / ChaCha20-Poly1305 向量取自 RFC 8439 (2.4.2 / 2.5.2 / 2.8.2)；
+// codec 表与 mask 输出的摘要由当前生成器与实现得出，任何改变线路格式的修改都需同步更新
+
+package sudoku
+
+import "unsafe"
+
+// runSelfTest 返回值的失败位
+const (
+	selfTestChaCha20 = 1 << 0 // RFC 8439 2.4.2，另比对 4 块并行路径与单块路径的 keystream
+	selfTestPoly1305 = 1 << 1 // RFC 8439 2.5.2
+	selfTestAEAD     = 1 << 2 // RFC 8439 2.8.2 加密、解密及篡改拒绝
+	selfTestCodec    = 1 << 3 // 网格/hint 位置/9x9 表摘要，编码表逐组可解码
+	selfTestMask     = 1 << 4 // sealAndMask → unmaskAndOpen 往返，输出摘要
+)
+
+const (
+	katSunscreen = "Ladies and Gentlemen of the class of '99: If I could offer you only one tip for the future, sunscreen would be it."
+	katPolyMsg   = "Cryptographic Forum Research Group"
+)
+
+var (
+	katChaCha20Nonce = [12]byte{0, 0, 0, 0, 0, 0, 0, 0x4a, 0, 0, 0, 0}
+	katChaCha20Out   = [114]byte{
+		0x6e, 0x2e, 0x35, 0x9a, 0x25, 0x68, 0xf9, 0x80, 0x41, 0xba, 0x07, 0x28,
+		0xdd, 0x0d, 0x69, 0x81, 0xe9, 0x7e, 0x7a, 0xec, 0x1d, 0x43, 0x60, 0xc2,
+		0x0a, 0x27, 0xaf, 0xcc, 0xfd, 0x9f, 0xae, 0x0b, 0xf9, 0x1b, 0x65, 0xc5,
+		0x52, 0x47, 0x33, 0xab, 0x8f, 0x59, 0x3d, 0xab, 0xcd, 0x62, 0xb3, 0x57,
+		0x16, 0x39, 0xd6, 0x24, 0xe6, 0x51, 0x52, 0xab, 0x8f, 0x53, 0x0c, 0x35,
+		0x9f, 0x08, 0x61, 0xd8, 0x07, 0xca, 0x0d, 0xbf, 0x50, 0x0d, 0x6a, 0x61,
+		0x56, 0xa3, 0x8e, 0x08, 0x8a, 0x22, 0xb6, 0x5e, 0x52, 0xbc, 0x51, 0x4d,
+		0x16, 0xcc, 0xf8, 0x06, 0x81, 0x8c, 0xe9, 0x1a, 0xb7, 0x79, 0x37, 0x36,
+		0x5a, 0xf9, 0x0b, 0xbf, 0x74, 0xa3, 0x5b, 0xe6, 0xb4, 0x0b, 0x8e, 0xed,
+		0xf2, 0x78, 0x5e, 0x42, 0x87, 0x4d,
+	}
+
+	katPolyKey = [32]byte{
+		0x85, 0xd6, 0xbe, 0x78, 0x57, 0x55, 0x6d, 0x33, 0x7f, 0x44, 0x52, 0xfe,
+		0x42, 0xd5, 0x06, 0xa8, 0x01, 0x03, 0x80, 0x8a, 0xfb, 0x0d, 0xb2, 0xfd,
+		0x4a, 0xbf, 0xf6, 0xaf, 0x41, 0x49, 0xf5, 0x1b,
+	}
+	katPolyTag = [16]byte{
+		0xa8, 0x06, 0x1d, 0xc1, 0x30, 0x51, 0x36, 0xc6, 0xc2, 0x2b, 0x8b, 0xaf,
+		0x0c, 0x01, 0x27, 0xa9,
+	}
+
+	katAEADNonce = [12]byte{0x07, 0x00, 0x00, 0x00, 0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47}
+	katAEADAD    = [12]byte{0x50, 0x51, 0x52, 0x53, 0xc0, 0xc1, 0xc2, 0xc3, 0xc4, 0xc5, 0xc6, 0xc7}
+	katAEADOut   = [130]byte{
+		0xd3, 0x1a, 0x8d, 0x34, 0x64, 0x8e, 0x60, 0xdb, 0x7b, 0x86, 0xaf, 0xbc,
+		0x53, 0xef, 0x7e, 0xc2, 0xa4, 0xad, 0xed, 0x51, 0x29, 0x6e, 0x08, 0xfe,
+		0xa9, 0xe2, 0xb5, 0xa7, 0x36, 0xee, 0x62, 0xd6, 0x3d, 0xbe, 0xa4, 0x5e,
+		0x8c, 0xa9, 0x67, 0x12, 0x82, 0xfa, 0xfb, 0x69, 0xda, 0x92, 0x72, 0x8b,
+		0x1a, 0x71, 0xde, 0x0a, 0x9e, 0x06, 0x0b, 0x29, 0x05, 0xd6, 0xa5, 0xb6,
+		0x7e, 0xcd, 0x3b, 0x36, 0x92, 0xdd, 0xbd, 0x7f, 0x2d, 0x77, 0x8b, 0x8c,
+		0x98, 0x03, 0xae, 0xe3, 0x28, 0x09, 0x1b, 0x58, 0xfa, 0xb3, 0x24, 0xe4,
+		0xfa, 0xd6, 0x75, 0x94, 0x55, 0x85, 0x80, 0x8b, 0x48, 0x31, 0xd7, 0xbc,
+		0x3f, 0xf4, 0xde, 0xf0, 0x8e, 0x4b, 0x7a, 0x9d, 0xe5, 0x76, 0xd2, 0x65,
+		0x86, 0xce, 0xc6, 0x4b, 0x61, 0x16, 0x1a, 0xe1, 0x0b, 0x59, 0x4f, 0x09,
+		0xe2, 0x6a, 0x7e, 0x90, 0x2e, 0xcb, 0xd0, 0x60, 0x06, 0x91,
+	}
+
+	// SHA-256(allGridsData || hintPositionsData)
+	katGridsDigest = [32]byte{
+		0xb3, 0xb3, 0x88, 0x19, 0xff, 0xc5, 0xeb, 0xc7, 0xf3, 0xbe, 0x78, 0x03,
+		0x7f, 0x12, 0xb4, 0xa8, 0xf2, 0x79, 0x4b, 0xb5, 0x8b, 0xb8, 0x1f, 0xfa,
+		0xf0, 0x4a, 0x85, 0x45, 0x05, 0x12, 0x87, 0x88,
+	}
+	// SHA-256(grid9EncodeTable (小端序) || grid9EncodeCount)
+	katGrid9Digest = [32]byte{
+		0x08, 0x4c, 0x4b, 0x66, 0xe3, 0x3e, 0xdc, 0xc0, 0x40, 0xfd, 0x07, 0x16,
+		0xb1, 0x18, 0x03, 0x42, 0x2c, 0x0d, 0xda, 0xbc, 0x13, 0xac, 0x6c, 0x57,
+		0x7c, 0xf8, 0xb3, 0x72, 0xfc, 0x44, 0x92, 0x98,
+	}
+	// SHA-256(默认种子 codec 表的 encode || count)
+	katCodecDigest = [32]byte{
+		0xa4, 0x3a, 0x07, 0x43, 0x25, 0x8a, 0x20, 0xcc, 0xbe, 0xfd, 0xa1, 0xea,
+		0xc7, 0x82, 0xde, 0x92, 0x23, 0xeb, 0xd5, 0x0f, 0xf6, 0x3d, 0x66, 0xb0,
+		0xfc, 0x11, 0x4f, 0x1a, 0x30, 0xed, 0x75, 0x51,
+	}
+	// SHA-256(katMaskRoundTrip 的 sealAndMask 输出)
+	katMaskDigest = [32]byte{
+		0xfc, 0xf7, 0xeb, 0x42, 0xf5, 0xcb, 0x7d, 0x6e, 0x23, 0x9c, 0x47, 0x29,
+		0xfa, 0x8f, 0xfb, 0x86, 0x8e, 0xa2, 0x6e, 0xd8, 0xf8, 0x2c, 0x39, 0x23,
+		0xb3, 0x84, 0xcf, 0x38, 0x8b, 0x6c, 0xca, 0x05,
+	}
+)
+
+// katMaskLen - mask 往返测试的输入长度 (输入字节 i*37+11，密钥 0x00..0x1f，ChaCha20-Poly1305，ASCII 4x4)
+const katMaskLen = 64
+
+// runSelfTest - 执行全部已知答案测试
+// mask 往返使用一个临时 session 与共享 workBuf/outBuf，应在尚未处理数据时调用
+// 返回值: 失败位 (selfTest*)，0 表示全部通过
+//
+//export runSelfTest
+func runSelfTest() uint32 {
+	if !wasmInitialized {
+		initWasm()
+	}
+	failed := uint32(0)
+	if !katChaCha20() {
+		failed |= selfTestChaCha20
+	}
+	if !katPoly1305() {
+		failed |= selfTestPoly1305
+	}
+	if !katAEAD() {
+		failed |= selfTestAEAD
+	}
+	if !katCodec() {
+		failed |= selfTestCodec
+	}
+	if !katMaskRoundTrip() {
+		failed |= selfTestMask
+	}
+	return failed
+}
+
+// katKey - 密钥 base, base+1, ..., base+31
+func katKey(base uint8) [32]byte {
+	var k [32]byte
+	for i := range k {
+		k[i] = base + uint8(i)
+	}
+	return k
+}
+
+func katChaCha20() bool {
+	key := katKey(0)
+	var pt, ct [len(katSunscreen)]byte
+	copy(pt[:], katSunscreen)
+
+	var c chacha20Cipher
+	if !chacha20Init(&c, key[:], katChaCha20Nonce[:]) {
+		return false
+	}
+	chacha20SetCounter(&c, 1)
+	chacha20Xor(&c, ct[:], pt[:], len(pt))
+	if ct != katChaCha20Out {
+		return false
+	}
+
+	// 4 块路径 (chachasimd 构建下为 SIMD 实现) 须与逐块生成一致
+	var c4, c1 chacha20Cipher
+	chacha20Init(&c4, key[:], katChaCha20Nonce[:])
+	chacha20Init(&c1, key[:], katChaCha20Nonce[:])
+	var zero, ks [4 * chachaBlockSize]byte
+	chacha20Xor(&c4, ks[:], zero[:], len(zero))
+	for i := 0; i < 4; i++ {
+		var block [chachaBlockSize]byte
+		chacha20GenerateBlock(&c1, &block)
+		for j := 0; j < chachaBlockSize; j++ {
+			if ks[i*chachaBlockSize+j] != block[j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func katPoly1305() bool {
+	var msg [len(katPolyMsg)]byte
+	copy(msg[:], katPolyMsg)
+	var tag [poly1305TagSize]byte
+	poly1305Sum(&tag, msg[:], &katPolyKey)
+	return tag == katPolyTag
+}
+
+func katAEAD() bool {
+	key := katKey(0x80)
+	var pt [len(katSunscreen)]byte
+	copy(pt[:], katSunscreen)
+
+	var out [len(katAEADOut)]byte
+	n := chacha20poly1305Seal(&key, &katAEADNonce, pt[:], len(pt), katAEADAD[:], len(katAEADAD), out[:])
+	if n != len(out) || out != katAEADOut {
+		return false
+	}
+
+	var back [len(katSunscreen)]byte
+	if chacha20poly1305Open(&key, &katAEADNonce, out[:], len(out), katAEADAD[:], len(katAEADAD), back[:]) != len(back) || back != pt {
+		return false
+	}
+	out[0] ^= 1
+	return chacha20poly1305Open(&key, &katAEADNonce, out[:], len(out), katAEADAD[:], len(katAEADAD), back[:]) < 0
+}
+
+func katCodec() bool {
+	var ctx sha256Context
+	var sum [sha256Size]byte
+
+	sha256Init(&ctx)
+	sha256Update(&ctx, (*[numGrids * 16]byte)(unsafe.Pointer(&allGridsData[0]))[:], numGrids*16)
+	sha256Update(&ctx, (*[numHintPositions * 4]byte)(unsafe.Pointer(&hintPositionsData[0]))[:], numHintPositions*4)
+	sha256Finalize(&ctx, &sum)
+	if sum != katGridsDigest {
+		return false
+	}
+
+	sha256Init(&ctx)
+	for b := 0; b < 256; b++ {
+		for g := 0; g < grid9MaxGroups; g++ {
+			for k := 0; k < 2; k++ {
+				v := grid9EncodeTable[b][g][k]
+				pair := [2]byte{uint8(v), uint8(v >> 8)}
+				sha256Update(&ctx, pair[:], 2)
+			}
+		}
+	}
+	sha256Update(&ctx, grid9EncodeCount[:], 256)
+	sha256Finalize(&ctx, &sum)
+	if sum != katGrid9Digest {
+		return false
+	}
+
+	// 每个编码组都须解码回原字节
+	t := &codecTables[defaultCodecTable]
+	if !ensureCodecDecode(t) {
+		return false
+	}
+	for b := 0; b < 256; b++ {
+		if t.count[b] == 0 || grid9EncodeCount[b] == 0 {
+			return false
+		}
+		for g := uint8(0); g < t.count[b]; g++ {
+			v, ok := decodeTableLookupIn(t, packHintsToKey(t.encode[b][g]))
+			if !ok || v != uint8(b) {
+				return false
+			}
+		}
+		for g := uint8(0); g < grid9EncodeCount[b]; g++ {
+			grp := grid9EncodeTable[b][g]
+			v, ok := grid9DecodeLookup(grid9Key(grp[0], grp[1]))
+			if !ok || v != uint8(b) {
+				return false
+			}
+		}
+	}
+
+	// initCodecTablesWithKey 替换默认表后只做可解码检查
+	if t.seed != defaultCodecSeed {
+		return true
+	}
+	sha256Init(&ctx)
+	sha256Update(&ctx, (*[256 * maxHintsPerByte * 4]byte)(unsafe.Pointer(&t.encode[0]))[:], 256*maxHintsPerByte*4)
+	sha256Update(&ctx, t.count[:], 256)
+	sha256Finalize(&ctx, &sum)
+	return sum == katCodecDigest
+}
+
+func katMaskRoundTrip() bool {
+	key := katKey(0)
+	id := initSessionKey(key[:], CipherChaCha20Poly, LayoutASCII, GridProfile4x4, RoleShared, nil)
+	if id < 0 {
+		return false
+	}
+	defer closeSession(id)
+
+	inPtr := uint32(workBufBase)
+	for i := uint32(0); i < katMaskLen; i++ {
+		arena[inPtr+i] = uint8(i*37 + 11)
+	}
+	p := sealAndMask(id, inPtr, katMaskLen)
+	if p == 0 {
+		return false
+	}
+	n := sessionOutLen[id]
+	if codecTables[defaultCodecTable].seed == defaultCodecSeed {
+		var sum [sha256Size]byte
+		sha256Sum(&sum, arena[p:p+n])
+		if sum != katMaskDigest {
+			return false
+		}
+	}
+
+	maskedPtr := inPtr + katMaskLen
+	copy(arena[maskedPtr:maskedPtr+n], arena[p:p+n])
+	q := unmaskAndOpen(id, maskedPtr, n)
+	if q == 0 || sessionOutLen[id] != katMaskLen {
+		return false
+	}
+	for i := uint32(0); i < katMaskLen; i++ {
+		if arena[q+i] != uint8(i*37+11) {
+			return false
+		}
+	}
+	return true
+}
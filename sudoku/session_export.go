@@ -0,0 +1,324 @@
+// session 导出/导入 - 宿主可将 session 状态封存后保存 (如 Durable Objects)，之后在其他实例中恢复
+// blob 格式 (exportBlobSize 字节):
+//   [magic "SDKX" (4)][版本 (1)][保留 (3)][nonce (24)][密文 (exportPlainSize)][tag (16)]
+// 以 XChaCha20-Poly1305 在宿主设置的导出密钥下加密，头部 8 字节作为附加数据
+// nonce = HMAC-SHA256(导出密钥, 头部 || 明文) 前 24 字节，相同状态得到相同 blob，不依赖随机数来源
+//
+// 明文布局:
+//   [0:128]   SudokuInstance (发送密钥、counter、抗重放窗口、选项位、sudokuState)
+//   [128:160] 接收方向密钥
+//   [160:168] codec 表种子 (大端序，导入时按种子重新取得槽位)
+//   [168:200] padding 池
+//   [200:232] 密钥轮换策略与计数 (小端序)
+//   [232:242] 整形策略 (小端序)
+//   [242]     握手阶段
+//   [243]     数据报模式乱序窗口
+// 统计计数、限速、MTU、多路复用 stream、FEC 分组状态、绑定的输出区、禁用输出字节、空闲计时与解码错误位置不导出，导入后按新 session 初始化
+//
+// blob 含发送 nonce counter；为避免同一 blob 被导入多次或原 session 继续发送时 (密钥, nonce) 重复，
+// 导入时以模块生成的 16 字节迁移盐替换两个方向的密钥并将 counter 归零 (见 migrationRekey)，
+// 宿主将迁移盐交给对端，对端以 applyMigrationSalt 做同样的替换后才能与导入的 session 互通
+
+package sudoku
+
+import (
+	"encoding/binary"
+	"unsafe"
+)
+
+const (
+	exportVersion    = 1
+	exportHeaderSize = 8
+	exportPlainSize  = 244
+	exportBlobSize   = exportHeaderSize + xchachaNonceSize + exportPlainSize + 16
+)
+
+var exportMagic = [4]byte{0x53, 0x44, 0x4B, 0x58} // "SDKX"
+
+var exportKey [32]byte
+var exportKeySet bool
+
+// setExportKey - 设置 session 导出/导入使用的 32 字节密钥 (模块级，所有 session 共用)
+// 返回值: 0 成功, -1 长度不是 32, -2 指针越界
+//
+//export setExportKey
+func setExportKey(keyPtr uint32, keyLen uint32) int32 {
+	if keyLen != 32 {
+		return -1
+	}
+	if !inArenaRange(keyPtr, keyLen) {
+		return -2
+	}
+	copy(exportKey[:], arena[keyPtr:keyPtr+32])
+	exportKeySet = true
+	return 0
+}
+
+// exportHeader - 写入 blob 头部 (导出 blob 与会话恢复令牌仅 magic 不同)
+func exportHeader(hdr []byte, magic *[4]byte) {
+	copy(hdr[0:4], magic[:])
+	hdr[4] = exportVersion
+	hdr[5] = 0
+	hdr[6] = 0
+	hdr[7] = 0
+}
+
+// exportSession - 将 session 状态封存为 blob 写入 arena[outPtr:outPtr+exportBlobSize]
+// 导出不改变 session 状态
+// 返回值: blob 长度 (exportBlobSize)
+//
+//	-1 session 无效
+//	-2 输出越界
+//	-3 握手进行中 (临时私钥不导出)
+//	-4 未设置导出密钥
+//	-5 session 已发送或收到关闭记录
+//
+//export exportSession
+func exportSession(id int32, outPtr uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(outPtr, exportBlobSize) {
+		return -2
+	}
+	if sessionHandshake[id].phase == handshakeStarted {
+		return -3
+	}
+	if !exportKeySet {
+		return -4
+	}
+	if txClosed(id) {
+		return -5
+	}
+
+	var plain [exportPlainSize]byte
+	exportPlain(id, &plain)
+	exportSeal(&exportKey, &exportMagic, &plain, outPtr)
+	for i := range plain {
+		plain[i] = 0
+	}
+	return exportBlobSize
+}
+
+// exportPlain - 按明文布局收集 session 状态
+func exportPlain(id int32, plain *[exportPlainSize]byte) {
+	session := getSession(id)
+	sessionAddr := sessionBase + uint32(id)*sessionSize
+	copy(plain[0:128], arena[sessionAddr:sessionAddr+sessionSize])
+	copy(plain[128:160], sessionRxKey[id][:])
+	binary.BigEndian.PutUint64(plain[160:168], sessionCodecTable(&session.sudokuState).seed)
+	copy(plain[168:200], sessionPadPool[id][:])
+	rk := &sessionRekey[id]
+	binary.LittleEndian.PutUint32(plain[200:204], rk.maxFrames)
+	binary.LittleEndian.PutUint32(plain[204:208], rk.maxBytes)
+	binary.LittleEndian.PutUint32(plain[208:212], rk.txFrames)
+	binary.LittleEndian.PutUint32(plain[212:216], rk.rxFrames)
+	binary.LittleEndian.PutUint64(plain[216:224], rk.txBytes)
+	binary.LittleEndian.PutUint64(plain[224:232], rk.rxBytes)
+	sp := &sessionShaping[id]
+	binary.LittleEndian.PutUint16(plain[232:234], sp.target)
+	binary.LittleEndian.PutUint16(plain[234:236], sp.jitter)
+	binary.LittleEndian.PutUint16(plain[236:238], sp.maxDelay)
+	binary.LittleEndian.PutUint32(plain[238:242], sp.rng)
+	plain[242] = sessionHandshake[id].phase
+	plain[243] = sessionDatagramWindow[id]
+}
+
+// exportSeal - 以 key 封存明文，blob 写入 arena[outPtr:outPtr+exportBlobSize]
+func exportSeal(key *[32]byte, magic *[4]byte, plain *[exportPlainSize]byte, outPtr uint32) {
+	var hdr [exportHeaderSize]byte
+	exportHeader(hdr[:], magic)
+
+	var mac hmacContext
+	hmacInit(&mac, key[:])
+	hmacUpdate(&mac, hdr[:], exportHeaderSize)
+	hmacUpdate(&mac, plain[:], exportPlainSize)
+	var sum [sha256Size]byte
+	hmacFinalize(&mac, &sum)
+	var nonce [xchachaNonceSize]byte
+	copy(nonce[:], sum[:xchachaNonceSize])
+
+	copy(arena[outPtr:outPtr+exportHeaderSize], hdr[:])
+	copy(arena[outPtr+exportHeaderSize:outPtr+exportHeaderSize+xchachaNonceSize], nonce[:])
+	ctPtr := outPtr + exportHeaderSize + xchachaNonceSize
+	xchacha20poly1305Seal(key, &nonce, plain[:], exportPlainSize, hdr[:], exportHeaderSize, arena[ctPtr:ctPtr+exportPlainSize+16])
+}
+
+// exportHeaderValid - 检查 blob 头部的 magic 与版本
+func exportHeaderValid(blobPtr uint32, magic *[4]byte) bool {
+	hdr := arena[blobPtr : blobPtr+exportHeaderSize]
+	return hdr[0] == magic[0] && hdr[1] == magic[1] && hdr[2] == magic[2] && hdr[3] == magic[3] && hdr[4] == exportVersion
+}
+
+// exportOpen - 以 key 验证并解密 blob (调用方已检查长度与头部)；认证失败返回 false
+func exportOpen(key *[32]byte, blobPtr uint32, plain *[exportPlainSize]byte) bool {
+	hdr := arena[blobPtr : blobPtr+exportHeaderSize]
+	var nonce [xchachaNonceSize]byte
+	copy(nonce[:], arena[blobPtr+exportHeaderSize:blobPtr+exportHeaderSize+xchachaNonceSize])
+	ctPtr := blobPtr + exportHeaderSize + xchachaNonceSize
+	return xchacha20poly1305Open(key, &nonce, arena[ctPtr:ctPtr+exportPlainSize+16], exportPlainSize+16, hdr, exportHeaderSize, plain[:]) >= 0
+}
+
+// importSession - 验证并解密 exportSession 生成的 blob，恢复为新的 session
+// 恢复后以新生成的迁移盐替换密钥，迁移盐写入 arena[saltOutPtr:saltOutPtr+16]，宿主需将其交给对端
+// 返回值: 新 session ID (不一定与导出时相同)
+//
+//	-1 无可用 session
+//	-2 输入或迁移盐输出越界，或长度不符
+//	-3 magic/版本不符
+//	-4 未设置导出密钥
+//	-5 认证失败
+//	-6 keyed codec 表槽位已满
+//	-7 blob 内容无效 (加密类型或网格规格未知)
+//	-20 registerIdentity 登记的 identity 已达配额 (errQuota)
+//
+//export importSession
+func importSession(blobPtr uint32, blobLen uint32, saltOutPtr uint32) int32 {
+	if !wasmInitialized {
+		initWasm()
+	}
+	if blobLen != exportBlobSize || !inArenaRange(blobPtr, blobLen) || !inArenaRange(saltOutPtr, migrationSaltSize) {
+		return -2
+	}
+	if !exportHeaderValid(blobPtr, &exportMagic) {
+		return -3
+	}
+	if !exportKeySet {
+		return -4
+	}
+	if sessionFreeCount == 0 {
+		logEvent(LogLevelError, logSessionExhausted, logNoArg)
+		return -1
+	}
+
+	var plain [exportPlainSize]byte
+	if !exportOpen(&exportKey, blobPtr, &plain) {
+		return -5
+	}
+	id := importPlain(&plain)
+	if id >= 0 {
+		migrationRekeyNew(id, saltOutPtr)
+	}
+	for i := range plain {
+		plain[i] = 0
+	}
+	return id
+}
+
+// importPlain - 以解密后的明文恢复为新 session (调用方已确认有空闲槽位，明文由调用方清零)
+// 返回值: 新 session ID, -6 keyed codec 表槽位已满, -7 内容无效
+func importPlain(plain *[exportPlainSize]byte) int32 {
+	inst := (*SudokuInstance)(unsafe.Pointer(&plain[0]))
+	state := &inst.sudokuState
+	if cipherLookup(inst.cipherType) == nil || state[stateGridProfile] > GridProfile9x9 {
+		return -7
+	}
+
+	tableSlot := int32(defaultCodecTable)
+	if state[stateLayoutType]&LayoutKeyed != 0 {
+		tableSlot = acquireCodecTable(binary.BigEndian.Uint64(plain[160:168]))
+		if tableSlot < 0 {
+			return -6
+		}
+	}
+	var identity [32]byte
+	if !identityAdmit(&identity) {
+		releaseCodecTable(uint8(tableSlot))
+		return errQuota
+	}
+	state[stateCodecTable] = uint8(tableSlot)
+
+	sessionFreeCount--
+	id := int32(sessionFreeList[sessionFreeCount])
+	noteSessionPeak()
+
+	sessionUsed[id] = 1
+	sessionIdentity[id] = identity
+	sessionAddr := sessionBase + uint32(id)*sessionSize
+	copy(arena[sessionAddr:sessionAddr+sessionSize], plain[0:128])
+	copy(sessionRxKey[id][:], plain[128:160])
+	sessionResumeKey[id] = [32]byte{}
+	copy(sessionPadPool[id][:], plain[168:200])
+	sessionRekey[id] = rekeyState{
+		maxFrames: binary.LittleEndian.Uint32(plain[200:204]),
+		maxBytes:  binary.LittleEndian.Uint32(plain[204:208]),
+		txFrames:  binary.LittleEndian.Uint32(plain[208:212]),
+		rxFrames:  binary.LittleEndian.Uint32(plain[212:216]),
+		txBytes:   binary.LittleEndian.Uint64(plain[216:224]),
+		rxBytes:   binary.LittleEndian.Uint64(plain[224:232]),
+	}
+	sessionShaping[id] = shapingPolicy{
+		target:   binary.LittleEndian.Uint16(plain[232:234]),
+		jitter:   binary.LittleEndian.Uint16(plain[234:236]),
+		maxDelay: binary.LittleEndian.Uint16(plain[236:238]),
+		rng:      binary.LittleEndian.Uint32(plain[238:242]),
+	}
+	sessionHandshake[id] = handshakeState{phase: plain[242]}
+	sessionDatagramWindow[id] = plain[243]
+	sessionOpenStatus[id] = errOK
+	sessionStats[id] = sessionStatsCounters{}
+	sessionMaxFrame[id] = 0
+	sessionCloseState[id] = 0
+	sessionRate[id] = rateBucket{}
+	sessionLastUsed[id] = currentTick
+	sessionDecodeErrOffset[id] = -1
+	logEvent(LogLevelInfo, logSessionOpen, uint32(id))
+	return id
+}
+
+// migrationSaltSize - importSession/resumeSession 生成的迁移盐长度
+const migrationSaltSize = 16
+
+var migrationLabel = []byte("sudoku migrate")
+
+// migrationRekeyNew - 生成迁移盐写入 arena[saltOutPtr:saltOutPtr+16] 并以其替换 session 密钥
+func migrationRekeyNew(id int32, saltOutPtr uint32) {
+	salt := arena[saltOutPtr : saltOutPtr+migrationSaltSize]
+	fillRandom(salt)
+	migrationRekey(id, salt)
+}
+
+// migrationRekey - 两个方向的密钥各自替换为 HKDF-Expand(HKDF-Extract(迁移盐, 密钥), "sudoku migrate", keyLen)
+// 本端接收密钥即对端发送密钥，两端以同一迁移盐派生后仍一一对应；两个方向的 counter、抗重放窗口与自动轮换计数归零
+func migrationRekey(id int32, salt []byte) {
+	session := getSession(id)
+	if keyLen := cipherKeyLen(session.cipherType); keyLen > 0 {
+		migrationDerive(&session.key, salt, keyLen)
+		migrationDerive(&sessionRxKey[id], salt, keyLen)
+	}
+	session.txCounter = 0
+	session.rxCounter = 0
+	session.replayBitmap = 0
+	rk := &sessionRekey[id]
+	rk.txFrames, rk.rxFrames, rk.txBytes, rk.rxBytes = 0, 0, 0, 0
+}
+
+// migrationDerive - 以迁移盐原地派生新密钥
+func migrationDerive(key *[32]byte, salt []byte, keyLen uint32) {
+	var prk [sha256Size]byte
+	hkdfExtract(&prk, salt, key[:keyLen])
+	*key = [32]byte{}
+	hkdfExpand(&prk, migrationLabel, key[:keyLen])
+	for i := range prk {
+		prk[i] = 0
+	}
+}
+
+// applyMigrationSalt - 对端收到 importSession/resumeSession 生成的迁移盐后调用，做与导入端相同的密钥替换
+// 须在向导入的 session 发送下一帧之前、且不再接收原 session 的帧时调用
+// 返回值: 0 成功, -1 session 无效, -2 输入越界或长度不是 16, -3 握手进行中
+//
+//export applyMigrationSalt
+func applyMigrationSalt(id int32, saltPtr uint32, saltLen uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if saltLen != migrationSaltSize || !inArenaRange(saltPtr, saltLen) {
+		return -2
+	}
+	if sessionHandshake[id].phase == handshakeStarted {
+		return -3
+	}
+	migrationRekey(id, arena[saltPtr:saltPtr+saltLen])
+	return 0
+}
@@ -0,0 +1,154 @@
+package sudoku
+
+import (
+	"bytes"
+	"testing"
+)
+
+// exportTestKey - 设置导出密钥，测试结束时恢复
+func exportTestKey(t *testing.T) {
+	t.Helper()
+	initWasm()
+	savedKey, savedSet := exportKey, exportKeySet
+	t.Cleanup(func() { exportKey, exportKeySet = savedKey, savedSet })
+	copy(arena[workBufBase:], bytes.Repeat([]byte{0x99}, 32))
+	if r := setExportKey(workBufBase, 32); r != 0 {
+		t.Fatalf("setExportKey: %d", r)
+	}
+}
+
+// exportTestSession - 以指定 cipherType/layoutType 创建 session
+func exportTestSession(t *testing.T, cipherType uint8, layoutType uint8, role uint8) int32 {
+	t.Helper()
+	id := initSessionKey(bytes.Repeat([]byte{0x37}, 32), cipherType, layoutType, GridProfile4x4, role, nil)
+	if id < 0 {
+		t.Fatalf("initSessionKey: %d", id)
+	}
+	t.Cleanup(func() { closeSession(id) })
+	return id
+}
+
+// exportTestExchange - a 与 b 双向各发送一帧并检查明文
+func exportTestExchange(t *testing.T, a, b int32, tag byte) {
+	t.Helper()
+	for _, dir := range [][2]int32{{a, b}, {b, a}} {
+		f, st := testCall(dir[0], []byte{tag, 1, 2, 3}, sealAndMask)
+		if st != errOK {
+			t.Fatalf("sealAndMask: %d", st)
+		}
+		if got, st := testCall(dir[1], f, unmaskAndOpen); st != errOK || !bytes.Equal(got, []byte{tag, 1, 2, 3}) {
+			t.Fatalf("unmaskAndOpen %d -> %d: %x (%d)", dir[0], dir[1], got, st)
+		}
+	}
+}
+
+// 导出 → 导入 → 对端应用迁移盐后继续双向通信，选项与轮换策略随 blob 迁移，原 session 的帧不再被接受
+func TestExportImportRoundTrip(t *testing.T) {
+	exportTestKey(t)
+	tests := []struct {
+		name       string
+		cipherType uint8
+		layoutType uint8
+		padPercent uint32
+		rekey      uint32
+	}{
+		{"chacha", CipherChaCha20Poly, LayoutASCII, 0, 0},
+		{"aes128 padding", CipherAES128GCM, LayoutASCII, 40, 0},
+		{"xchacha rekey", CipherXChaCha20Poly, LayoutASCII, 0, 2},
+		{"keyed layout", CipherChaCha20Poly, LayoutASCII | LayoutKeyed, 10, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.layoutType&LayoutKeyed != 0 && maxCodecTables == 1 {
+				t.Skip("LayoutKeyed unavailable in this memory layout")
+			}
+			cl := exportTestSession(t, tt.cipherType, tt.layoutType, RoleClient)
+			sv := exportTestSession(t, tt.cipherType, tt.layoutType, RoleServer)
+			for _, id := range []int32{cl, sv} {
+				setPaddingPolicy(id, tt.padPercent, 0, 0)
+				if tt.rekey != 0 {
+					setRekeyPolicy(id, tt.rekey, 0)
+				}
+			}
+			exportTestExchange(t, cl, sv, 1)
+			exportTestExchange(t, cl, sv, 2)
+
+			blob, salt := uint32(workBufBase+0x100), uint32(workBufBase+0x400)
+			if r := exportSession(cl, blob); r != exportBlobSize {
+				t.Fatalf("exportSession: %d", r)
+			}
+			exported := getSession(cl).sudokuState
+			old, _ := testCall(cl, []byte("stale"), sealAndMask)
+			moved := importSession(blob, exportBlobSize, salt)
+			if moved < 0 {
+				t.Fatalf("importSession: %d", moved)
+			}
+			t.Cleanup(func() { closeSession(moved) })
+			if getSession(moved).sudokuState != exported || sessionRekey[moved].maxFrames != tt.rekey {
+				t.Fatal("imported session state differs from the exported one")
+			}
+			if r := applyMigrationSalt(sv, salt, migrationSaltSize); r != 0 {
+				t.Fatalf("applyMigrationSalt: %d", r)
+			}
+			exportTestExchange(t, moved, sv, 3)
+			exportTestExchange(t, moved, sv, 4)
+			if _, st := testCall(sv, old, unmaskAndOpen); st != errAuth {
+				t.Fatalf("frame from the original session: %d, want %d", st, errAuth)
+			}
+		})
+	}
+}
+
+// 同一 blob 导入两次得到不同的迁移盐与密钥；非法 blob 与前置条件不满足时返回对应的错误码
+func TestExportImportErrors(t *testing.T) {
+	exportTestKey(t)
+	id := testSession(t, CipherChaCha20Poly, RoleClient)
+	blob, salt := uint32(workBufBase+0x100), uint32(workBufBase+0x400)
+	if r := exportSession(id, blob); r != exportBlobSize {
+		t.Fatalf("exportSession: %d", r)
+	}
+	saved := append([]byte(nil), arena[blob:blob+exportBlobSize]...)
+
+	a := importSession(blob, exportBlobSize, salt)
+	saltA := append([]byte(nil), arena[salt:salt+migrationSaltSize]...)
+	b := importSession(blob, exportBlobSize, salt)
+	if a < 0 || b < 0 {
+		t.Fatalf("importSession: %d, %d", a, b)
+	}
+	t.Cleanup(func() { closeSession(a); closeSession(b) })
+	if bytes.Equal(saltA, arena[salt:salt+migrationSaltSize]) || getSession(a).key == getSession(b).key {
+		t.Fatal("importing the same blob twice reused the migration salt")
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(b []byte) []byte
+		blobLen uint32
+		want    int32
+	}{
+		{"short", func(b []byte) []byte { return b }, exportBlobSize - 1, -2},
+		{"bad magic", func(b []byte) []byte { b[0] ^= 1; return b }, exportBlobSize, -3},
+		{"bad version", func(b []byte) []byte { b[4]++; return b }, exportBlobSize, -3},
+		{"reserved byte", func(b []byte) []byte { b[6] ^= 1; return b }, exportBlobSize, -5},
+		{"ciphertext", func(b []byte) []byte { b[exportHeaderSize+xchachaNonceSize] ^= 1; return b }, exportBlobSize, -5},
+		{"tag", func(b []byte) []byte { b[len(b)-1] ^= 1; return b }, exportBlobSize, -5},
+	}
+	for _, tt := range tests {
+		copy(arena[blob:], tt.modify(append([]byte(nil), saved...)))
+		if r := importSession(blob, tt.blobLen, salt); r != tt.want {
+			t.Fatalf("importSession(%s) = %d, want %d", tt.name, r, tt.want)
+		}
+	}
+
+	if r := exportSession(maxSessions, blob); r != -1 {
+		t.Fatalf("exportSession(bad id) = %d, want -1", r)
+	}
+	exportKeySet = false
+	if r := exportSession(id, blob); r != -4 {
+		t.Fatalf("exportSession(no key) = %d, want -4", r)
+	}
+	copy(arena[blob:], saved)
+	if r := importSession(blob, exportBlobSize, salt); r != -4 {
+		t.Fatalf("importSession(no key) = %d, want -4", r)
+	}
+}
@@ -0,0 +1,122 @@
+// 流量整形 - maskFrame 将输出切分为接近目标长度分布的块，并为每块给出建议的发送延迟
+// 模块不计时，延迟由宿主执行；mask 字节流本身不变 (按任意位置切分，接收端拼接后照常 unmask)
+// 开启后 maskFrame 的输出布局 (小端序):
+//   [块数 n (4)][n 个块描述符 {offset (4), length (4), delayMs (4)}][mask 字节流]
+//   offset 相对返回指针；块按顺序覆盖整个字节流
+// 块长与延迟取自独立的 LCG (种子由 session 发送密钥派生)，不影响 mask 的 RNG，对端无需同步
+
+package sudoku
+
+import "encoding/binary"
+
+const (
+	shapingDescSize  = 12
+	shapingMinChunk  = 64 // 块长下限，限制描述符开销 (最多约为字节流的 1/5)
+	shapingMaxTarget = 0xFFFF
+)
+
+var shapingSeedLabel = []byte("sudoku shaping")
+
+// 每个 session 的整形策略 (target 为 0 表示关闭)
+type shapingPolicy struct {
+	target   uint16
+	jitter   uint16
+	maxDelay uint16
+	rng      uint32
+}
+
+var sessionShaping [maxSessions]shapingPolicy
+
+// setShapingPolicy - 设置 session 的整形策略
+// 块长在 [targetSize-jitter, targetSize+jitter] 内均匀分布，每块延迟在 [0, maxDelayMs] 内均匀分布；
+// targetSize 为 0 时关闭 (maskFrame 恢复原输出格式)
+// 返回值: 0 成功, -1 session 无效, -2 参数无效 (targetSize-jitter < 64 或 targetSize+jitter > 65535 或 maxDelayMs > 65535)
+//
+//export setShapingPolicy
+func setShapingPolicy(id int32, targetSize uint32, jitter uint32, maxDelayMs uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if targetSize == 0 {
+		sessionShaping[id] = shapingPolicy{}
+		return 0
+	}
+	if jitter > targetSize || targetSize-jitter < shapingMinChunk || targetSize+jitter > shapingMaxTarget || maxDelayMs > 0xFFFF {
+		return -2
+	}
+
+	var ctx hmacContext
+	var sum [sha256Size]byte
+	hmacInit(&ctx, getSession(id).key[:])
+	hmacUpdate(&ctx, shapingSeedLabel, len(shapingSeedLabel))
+	hmacFinalize(&ctx, &sum)
+
+	sessionShaping[id] = shapingPolicy{
+		target:   uint16(targetSize),
+		jitter:   uint16(jitter),
+		maxDelay: uint16(maxDelayMs),
+		rng:      binary.BigEndian.Uint32(sum[0:4]),
+	}
+	return 0
+}
+
+// shapingReserve - 为最长 maxOut 字节的字节流预留的描述符区长度，未开启整形时为 0
+func shapingReserve(id int32, maxOut uint32) uint32 {
+	p := &sessionShaping[id]
+	if p.target == 0 {
+		return 0
+	}
+	minChunk := uint32(p.target - p.jitter)
+	if mtu := sessionMaxFrame[id]; mtu != 0 && mtu < minChunk {
+		minChunk = mtu
+	}
+	return 4 + (maxOut+minChunk-1)/minChunk*shapingDescSize
+}
+
+// shapeChunks - 为 [out+reserved, +streamLen) 的字节流生成块描述符，并把字节流移到描述符之后
+// 设置了 MTU (setMaxFrameSize) 时块长截断到 MTU
+// 返回值: 总输出长度 (描述符区 + 字节流)
+func shapeChunks(id int32, out uint32, reserved uint32, streamLen uint32) uint32 {
+	p := &sessionShaping[id]
+	rng := p.rng
+	span := uint32(p.jitter)*2 + 1
+
+	// 先统计块数以确定字节流的最终位置
+	mtu := sessionMaxFrame[id]
+	n := uint32(0)
+	sizes := rng
+	for pos := uint32(0); pos < streamLen; n++ {
+		sizes = lcgNext(sizes)
+		size := uint32(p.target-p.jitter) + sizes%span
+		if mtu != 0 && size > mtu {
+			size = mtu
+		}
+		pos += size
+		sizes = lcgNext(sizes)
+	}
+	hdr := 4 + n*shapingDescSize
+
+	binary.LittleEndian.PutUint32(arena[out:out+4], n)
+	pos := uint32(0)
+	for i := uint32(0); i < n; i++ {
+		rng = lcgNext(rng)
+		size := uint32(p.target-p.jitter) + rng%span
+		rng = lcgNext(rng)
+		delay := rng % (uint32(p.maxDelay) + 1)
+		if mtu != 0 && size > mtu {
+			size = mtu
+		}
+		if size > streamLen-pos {
+			size = streamLen - pos
+		}
+		d := out + 4 + i*shapingDescSize
+		binary.LittleEndian.PutUint32(arena[d:d+4], hdr+pos)
+		binary.LittleEndian.PutUint32(arena[d+4:d+8], size)
+		binary.LittleEndian.PutUint32(arena[d+8:d+12], delay)
+		pos += size
+	}
+	p.rng = rng
+
+	copy(arena[out+hdr:out+hdr+streamLen], arena[out+reserved:out+reserved+streamLen])
+	return hdr + streamLen
+}
@@ -0,0 +1,95 @@
+// session 快照/回滚 - 宿主先 mask/seal 再发送，发送失败时撤销该次操作对 session 状态的修改
+// 快照包含 SudokuInstance (密钥、nonce counter、抗重放窗口、RNG 与游标等 sudokuState)、接收方向密钥、
+// 密钥轮换、整形、关闭、限速令牌、早期数据计数与 trace 游标；统计计数与输出区内容不回滚
+// FEC 发送分组 (异或累加区) 与多路复用 stream 窗口不在快照内，开启 FEC 或有 stream 的 session 不能回滚
+// 每个 session 一个快照槽位，再次 snapshotSession 覆盖旧快照
+//
+// 注意: 回滚会使发送 nonce counter 回退，只能在确认输出未被发送时使用，否则下一帧会重复 nonce
+
+package sudoku
+
+type sessionSnapshotState struct {
+	inst    [sessionSize]byte
+	rxKey   [32]byte
+	rekey   rekeyState
+	shaping shapingPolicy
+	closed  uint8 // sessionCloseState
+	phase   uint8 // 快照时的握手阶段
+	valid   uint8
+
+	rateTokens uint32 // sessionRate 的令牌与补充时刻 (被拒绝的调用计数属于统计，不回滚)
+	rateLast   uint32
+	early      earlyDataState
+	traceSlot  uint8 // 快照时的 trace 槽位 (+1)，槽位未变时回滚 trace 游标
+	traceCount uint32
+}
+
+var sessionSnapshot [maxSessions]sessionSnapshotState
+
+// snapshotSession - 保存 session 当前状态
+// 返回值: 0 成功, -1 session 无效
+//
+//export snapshotSession
+func snapshotSession(id int32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	snap := &sessionSnapshot[id]
+	sessionAddr := sessionBase + uint32(id)*sessionSize
+	copy(snap.inst[:], arena[sessionAddr:sessionAddr+sessionSize])
+	snap.rxKey = sessionRxKey[id]
+	snap.rekey = sessionRekey[id]
+	snap.shaping = sessionShaping[id]
+	snap.closed = sessionCloseState[id]
+	snap.phase = sessionHandshake[id].phase
+	snap.rateTokens = sessionRate[id].tokens
+	snap.rateLast = sessionRate[id].last
+	snap.early = sessionEarly[id]
+	snap.traceSlot = sessionTraceSlot[id]
+	if snap.traceSlot != 0 {
+		snap.traceCount = traceRings[snap.traceSlot-1].count
+	}
+	snap.valid = 1
+	return 0
+}
+
+// rollbackSession - 恢复到最近一次 snapshotSession 的状态，快照随之失效
+// 返回值: 0 成功
+//
+//	-1 session 无效
+//	-2 没有可用快照
+//	-3 快照后握手阶段已变化 (传输密钥已切换，不能回滚)
+//	-4 session 开启了 FEC 或有多路复用 stream (其发送状态不在快照内)
+//
+//export rollbackSession
+func rollbackSession(id int32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	snap := &sessionSnapshot[id]
+	if snap.valid == 0 {
+		return -2
+	}
+	if snap.phase != sessionHandshake[id].phase {
+		*snap = sessionSnapshotState{}
+		return -3
+	}
+	if sessionFECSlot[id] != 0 || muxHasStreams(id) {
+		*snap = sessionSnapshotState{}
+		return -4
+	}
+	sessionAddr := sessionBase + uint32(id)*sessionSize
+	copy(arena[sessionAddr:sessionAddr+sessionSize], snap.inst[:])
+	sessionRxKey[id] = snap.rxKey
+	sessionRekey[id] = snap.rekey
+	sessionShaping[id] = snap.shaping
+	sessionCloseState[id] = snap.closed
+	sessionRate[id].tokens = snap.rateTokens
+	sessionRate[id].last = snap.rateLast
+	sessionEarly[id] = snap.early
+	if snap.traceSlot != 0 && sessionTraceSlot[id] == snap.traceSlot {
+		traceRings[snap.traceSlot-1].count = snap.traceCount
+	}
+	*snap = sessionSnapshotState{}
+	return 0
+}
@@ -0,0 +1,105 @@
+package sudoku
+
+import (
+	"bytes"
+	"testing"
+)
+
+// 快照 → 操作 → 回滚 → 重做: 重做的输出与被撤销的输出逐字节一致，对端照常解开
+func TestSnapshotRollback(t *testing.T) {
+	in := []byte("speculative payload")
+	type op func(id int32) ([]byte, int32)
+	call := func(fn func(int32, uint32, uint32) uint32) op {
+		return func(id int32) ([]byte, int32) { return testCall(id, in, fn) }
+	}
+	closeOp := func(id int32) ([]byte, int32) {
+		p := buildCloseNotify(id, 7)
+		if p == 0 {
+			return nil, lastError
+		}
+		return append([]byte(nil), arena[p:p+sessionOutLen[id]]...), errOK
+	}
+	tests := []struct {
+		name   string
+		setup  func(tx, rx int32)
+		op     op
+		open   func(int32, uint32, uint32) uint32
+		want   []byte
+		status int32 // 对端 getOpenStatus
+	}{
+		{"mask", nil, call(mask), unmask, in, errOK},
+		{"mask padding", func(tx, rx int32) { setPaddingPolicy(tx, 60, 0, 0) }, call(mask), unmask, in, errOK},
+		{"sealAndMask", nil, call(sealAndMask), unmaskAndOpen, in, errOK},
+		{"maskFrame rekey", func(tx, rx int32) { setRekeyPolicy(tx, 1, 0); setRekeyPolicy(rx, 1, 0) }, call(maskFrame), unmaskFrame, in, errOK},
+		{"close notify", nil, closeOp, unmaskFrame, nil, errClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, rx := testPair(t, CipherChaCha20Poly)
+			if tt.setup != nil {
+				tt.setup(tx, rx)
+			}
+			if r := snapshotSession(tx); r != 0 {
+				t.Fatalf("snapshotSession: %d", r)
+			}
+			undone, st := tt.op(tx)
+			if st != errOK {
+				t.Fatalf("op: %d", st)
+			}
+			if r := rollbackSession(tx); r != 0 {
+				t.Fatalf("rollbackSession: %d", r)
+			}
+			redone, st := tt.op(tx)
+			if st != errOK {
+				t.Fatalf("op after rollback: %d", st)
+			}
+			if !bytes.Equal(undone, redone) {
+				t.Fatal("output after rollback differs from the undone output")
+			}
+			got, st := testCall(rx, redone, tt.open)
+			if st != errOK || !bytes.Equal(got, tt.want) || getOpenStatus(rx) != tt.status {
+				t.Fatalf("peer: %q (%d, open status %d)", got, st, getOpenStatus(rx))
+			}
+			if tt.status == errClosed {
+				return
+			}
+			// 不回滚时状态前进，同一操作的输出不同
+			next, _ := tt.op(tx)
+			if bytes.Equal(next, redone) {
+				t.Fatal("repeated op without rollback produced the same output")
+			}
+		})
+	}
+}
+
+// 快照不可用或 session 有不在快照内的发送状态时拒绝回滚，快照随之失效
+func TestSnapshotRollbackErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(id int32)
+		want  int32
+	}{
+		{"no snapshot", func(id int32) {}, -2},
+		{"used snapshot", func(id int32) { snapshotSession(id); rollbackSession(id) }, -2},
+		{"fec", func(id int32) { snapshotSession(id); setFECPolicy(id, 4, 1) }, -4},
+		{"stream", func(id int32) { snapshotSession(id); openStream(id, 1) }, -4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := testSession(t, CipherChaCha20Poly, RoleClient)
+			tt.setup(id)
+			if r := rollbackSession(id); r != tt.want {
+				t.Fatalf("rollbackSession = %d, want %d", r, tt.want)
+			}
+			if r := rollbackSession(id); r != -2 {
+				t.Fatalf("second rollbackSession = %d, want -2", r)
+			}
+		})
+	}
+	if r := snapshotSession(-1); r != -1 {
+		t.Fatalf("snapshotSession(-1) = %d", r)
+	}
+	if r := rollbackSession(maxSessions); r != -1 {
+		t.Fatalf("rollbackSession(maxSessions) = %d", r)
+	}
+}
@@ -0,0 +1,148 @@
+// 协议事件 trace - 每个 session 最近 traceEntries 条协议事件 (帧长度、错误码、nonce counter) 的环形缓冲区，
+// 以 SessionOptTrace 开启后由宿主在出现故障时以 getTrace 取回，无需在宿主侧插桩即可还原事故现场
+// 环形缓冲区为模块级 (traceSlots 个 session 可同时开启)，未开启的 session 只多一次槽位判断
+// 每条记录 traceEntrySize 字节 (小端序):
+//   [0:4]   序号 (该 session 开启 trace 以来的第几个事件，从 0 开始，可据此判断是否有事件被覆盖)
+//   [4:8]   事件发生时的 tick (见 tick)
+//   [8]     事件类型 (trace*)
+//   [9]     状态: 0 成功，否则为错误码 (errors.go，int8)
+//   [10:12] 保留 (0)
+//   [12:16] 长度: 封装/打开的明文字节数，解码失败时为输入字节数
+//   [16:24] nonce counter: 封装/打开的帧所用 counter；密钥轮换时为轮换后的 rekeyCount
+
+package sudoku
+
+import "encoding/binary"
+
+const (
+	traceSlots     = 16
+	traceEntries   = 32
+	traceEntrySize = 24
+)
+
+// 事件类型
+const (
+	traceSeal       = 1 // 封装一帧 (记录层、控制记录、aeadEncrypt 系列)
+	traceOpen       = 2 // 打开一帧
+	traceOpenFail   = 3 // 打开失败 (状态: errAuth / errReplay)
+	traceDecodeFail = 4 // unmask 系列解码失败 (状态: errUndecodable / errLayoutMismatch)
+	traceRekeyTx    = 5 // 发送密钥自动轮换
+	traceRekeyRx    = 6 // 接收密钥自动轮换
+)
+
+type traceEntry struct {
+	tick    uint32
+	kind    uint8
+	status  int8
+	length  uint32
+	counter uint64
+}
+
+type traceRing struct {
+	owner   int32  // session ID + 1，0 表示空闲
+	count   uint32 // 已记录的事件总数，下一条写入 entries[count%traceEntries]
+	entries [traceEntries]traceEntry
+}
+
+var (
+	traceRings       [traceSlots]traceRing
+	sessionTraceSlot [maxSessions]uint8 // 槽位 + 1，0 表示未开启
+)
+
+// traceEnable - 为 session 分配 trace 槽位 (已开启时保留已有记录)，槽位用完时返回 false
+func traceEnable(id int32) bool {
+	if sessionTraceSlot[id] != 0 {
+		return true
+	}
+	for i := range traceRings {
+		if traceRings[i].owner == 0 {
+			traceRings[i] = traceRing{owner: id + 1}
+			sessionTraceSlot[id] = uint8(i + 1)
+			return true
+		}
+	}
+	return false
+}
+
+// traceRelease - 释放 session 的 trace 槽位
+func traceRelease(id int32) {
+	if s := sessionTraceSlot[id]; s != 0 {
+		traceRings[s-1] = traceRing{}
+	}
+	sessionTraceSlot[id] = 0
+}
+
+// traceEvent - 未开启 trace 时直接返回
+func traceEvent(id int32, kind uint8, status int32, length uint32, counter uint64) {
+	s := sessionTraceSlot[id]
+	if s == 0 {
+		return
+	}
+	ring := &traceRings[s-1]
+	ring.entries[ring.count%traceEntries] = traceEntry{
+		tick:    currentTick,
+		kind:    kind,
+		status:  int8(status),
+		length:  length,
+		counter: counter,
+	}
+	ring.count++
+}
+
+// traceSealed - 封装成功后记录发送方向的 counter
+func traceSealed(id int32, plainLen uint32) {
+	traceEvent(id, traceSeal, errOK, plainLen, getSession(id).txCounter)
+}
+
+// traceNonceCounter - nonce 末 8 字节 (大端序) 的 counter
+func traceNonceCounter(noncePtr uint32, nonceLen uint32) uint64 {
+	if nonceLen < 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(arena[noncePtr+nonceLen-8 : noncePtr+nonceLen])
+}
+
+// getTrace - 将最近的 trace 记录按时间顺序 (旧到新) 写入 arena[outPtr:outPtr+maxLen]
+// maxLen 放不下全部记录时只写入最新的 maxLen/traceEntrySize 条
+// 返回值: 写入的字节数 (traceEntrySize 的倍数)
+//
+//	-1 session 无效
+//	-2 输出越界
+//	-3 未开启 trace (SessionOptTrace)
+//
+//export getTrace
+func getTrace(id int32, outPtr uint32, maxLen uint32) int32 {
+	if id < 0 || id >= maxSessions || sessionUsed[id] == 0 {
+		return -1
+	}
+	if !inArenaRange(outPtr, maxLen) {
+		return -2
+	}
+	s := sessionTraceSlot[id]
+	if s == 0 {
+		return -3
+	}
+	ring := &traceRings[s-1]
+	n := ring.count
+	if n > traceEntries {
+		n = traceEntries
+	}
+	if n > maxLen/traceEntrySize {
+		n = maxLen / traceEntrySize
+	}
+	first := ring.count - n
+	for i := uint32(0); i < n; i++ {
+		seq := first + i
+		e := &ring.entries[seq%traceEntries]
+		out := arena[outPtr+i*traceEntrySize : outPtr+(i+1)*traceEntrySize]
+		binary.LittleEndian.PutUint32(out[0:4], seq)
+		binary.LittleEndian.PutUint32(out[4:8], e.tick)
+		out[8] = e.kind
+		out[9] = uint8(e.status)
+		out[10] = 0
+		out[11] = 0
+		binary.LittleEndian.PutUint32(out[12:16], e.length)
+		binary.LittleEndian.PutUint64(out[16:24], e.counter)
+	}
+	return int32(n * traceEntrySize)
+}
@@ -0,0 +1,151 @@
+//go:build !tinygo
+
+// 互通测试向量 - testdata/vectors.json 由 cmd/genvectors 独立生成 (不链接模块代码)，
+// 官方 Go 客户端的测试读取同一文件；任何改变 mask 或 AEAD 帧字节的修改都会在两端同时失败
+// 重新生成: make vectors (即 go run cmd/genvectors/main.go)
+// 同时经 api.go 的 Go 接口比对，故仅在标准 Go 下构建
+
+package sudoku
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+)
+
+type testVector struct {
+	Name        string `json:"name"`
+	CipherType  uint8  `json:"cipherType"`
+	LayoutType  uint8  `json:"layoutType"`
+	GridProfile uint8  `json:"gridProfile"`
+	Key         string `json:"key"`
+	Seed        string `json:"seed"`
+	PadPercent  uint32 `json:"padPercent"`
+	PadPool     string `json:"padPool"`
+	Plaintext   string `json:"plaintext"`
+	Sealed      string `json:"sealed"`
+	Masked      string `json:"masked"`
+}
+
+func loadVectors(t *testing.T) []testVector {
+	t.Helper()
+	raw, err := os.ReadFile("testdata/vectors.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f struct {
+		Vectors []testVector `json:"vectors"`
+	}
+	if err := json.Unmarshal(raw, &f); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Vectors) == 0 {
+		t.Fatal("no vectors")
+	}
+	return f.Vectors
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// vectorSession - 按向量配置创建 RoleShared session 并设置 padding 策略
+func vectorSession(t *testing.T, v *testVector) int32 {
+	t.Helper()
+	id := initSessionKey(mustHex(t, v.Key), v.CipherType, v.LayoutType, v.GridProfile, RoleShared, nil)
+	if id < 0 {
+		t.Fatalf("initSessionKey: %d", id)
+	}
+	if pool := mustHex(t, v.PadPool); len(pool) > 0 {
+		copy(arena[workBufBase:], pool)
+		if r := setPaddingPolicy(id, v.PadPercent, workBufBase, uint32(len(pool))); r != 0 {
+			t.Fatalf("setPaddingPolicy: %d", r)
+		}
+	}
+	return id
+}
+
+// vectorCall - 将 in 拷贝到 workBuf 起始处后调用 fn，返回输出 (失败时终止测试)
+func vectorCall(t *testing.T, what string, id int32, in []byte, fn func(int32, uint32, uint32) uint32) []byte {
+	t.Helper()
+	copy(arena[workBufBase:], in)
+	p := fn(id, workBufBase, uint32(len(in)))
+	if p == 0 {
+		t.Fatalf("%s: error %d", what, getLastError())
+	}
+	return append([]byte(nil), arena[p:p+sessionOutLen[id]]...)
+}
+
+func TestConformanceVectors(t *testing.T) {
+	if !wasmInitialized {
+		initWasm()
+	}
+	seed := fmt.Sprintf("0x%X", codecTables[defaultCodecTable].seed)
+	for _, v := range loadVectors(t) {
+		v := v
+		t.Run(v.Name, func(t *testing.T) {
+			if v.Seed != seed {
+				t.Skipf("vectors generated for codec seed %s, module uses %s", v.Seed, seed)
+			}
+			plain := mustHex(t, v.Plaintext)
+			sealed := mustHex(t, v.Sealed)
+			masked := mustHex(t, v.Masked)
+
+			tx := vectorSession(t, &v)
+			defer closeSession(tx)
+			if got := vectorCall(t, "sealAndMask", tx, plain, sealAndMask); !bytes.Equal(got, masked) {
+				t.Fatalf("sealAndMask:\n got  %x\n want %x", got, masked)
+			}
+
+			tx2 := vectorSession(t, &v)
+			defer closeSession(tx2)
+			if got := vectorCall(t, "mask", tx2, sealed, mask); !bytes.Equal(got, masked) {
+				t.Fatalf("mask(sealed):\n got  %x\n want %x", got, masked)
+			}
+
+			rx := vectorSession(t, &v)
+			defer closeSession(rx)
+			if got := vectorCall(t, "unmask", rx, masked, unmask); !bytes.Equal(got, sealed) {
+				t.Fatalf("unmask:\n got  %x\n want %x", got, sealed)
+			}
+
+			rx2 := vectorSession(t, &v)
+			defer closeSession(rx2)
+			if got := vectorCall(t, "unmaskAndOpen", rx2, masked, unmaskAndOpen); !bytes.Equal(got, plain) {
+				t.Fatalf("unmaskAndOpen:\n got  %x\n want %x", got, plain)
+			}
+
+			// Go 接口 (api.go) 与导出函数共用同一实现，输出须一致
+			tx3, err := NewSession(mustHex(t, v.Key), v.CipherType, v.LayoutType, v.GridProfile)
+			if err != nil {
+				t.Fatalf("NewSession: %v", err)
+			}
+			defer tx3.Close()
+			if pool := mustHex(t, v.PadPool); len(pool) > 0 {
+				if err := tx3.SetPaddingPolicy(v.PadPercent, pool); err != nil {
+					t.Fatalf("SetPaddingPolicy: %v", err)
+				}
+			}
+			got, err := tx3.SealAndMask(nil, plain)
+			if err != nil || !bytes.Equal(got, masked) {
+				t.Fatalf("Session.SealAndMask (%v):\n got  %x\n want %x", err, got, masked)
+			}
+			rx3, err := NewSession(mustHex(t, v.Key), v.CipherType, v.LayoutType, v.GridProfile)
+			if err != nil {
+				t.Fatalf("NewSession: %v", err)
+			}
+			defer rx3.Close()
+			if got, err := rx3.UnmaskAndOpen(nil, masked); err != nil || !bytes.Equal(got, plain) {
+				t.Fatalf("Session.UnmaskAndOpen (%v):\n got  %x\n want %x", err, got, plain)
+			}
+		})
+	}
+}
@@ -32,6 +32,13 @@ async function main() {
     const instance = await WebAssembly.instantiate(wasmModule, {
       env: {
         abort: () => { throw new Error('Wasm abort'); },
+        logEvent: (level, codePtr, codeLen) => {
+          const code = Buffer.from(instance.exports.memory.buffer, codePtr, codeLen).toString();
+          console.log(`   [WASM:${level}] ${code}`);
+        },
+        getRandom: (ptr, len) => {
+          require('crypto').randomFillSync(new Uint8Array(instance.exports.memory.buffer, ptr, len));
+        },
       },
     });
     